@@ -114,13 +114,15 @@ func (b0 ValidationError_builder) Build() *ValidationError {
 }
 
 type EntityError struct {
-	state                  protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_EntityName  *string                `protobuf:"bytes,1,opt,name=entity_name,json=entityName"`
-	xxx_hidden_Id          *string                `protobuf:"bytes,2,opt,name=id"`
-	XXX_raceDetectHookData protoimpl.RaceDetectHookData
-	XXX_presence           [1]uint32
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+	state                     protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_EntityName     *string                `protobuf:"bytes,1,opt,name=entity_name,json=entityName"`
+	xxx_hidden_Id             *string                `protobuf:"bytes,2,opt,name=id"`
+	xxx_hidden_Field          *string                `protobuf:"bytes,3,opt,name=field"`
+	xxx_hidden_ReplacementIds []string               `protobuf:"bytes,4,rep,name=replacement_ids,json=replacementIds"`
+	XXX_raceDetectHookData    protoimpl.RaceDetectHookData
+	XXX_presence              [1]uint32
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
 }
 
 func (x *EntityError) Reset() {
@@ -168,14 +170,40 @@ func (x *EntityError) GetId() string {
 	return ""
 }
 
+func (x *EntityError) GetField() string {
+	if x != nil {
+		if x.xxx_hidden_Field != nil {
+			return *x.xxx_hidden_Field
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *EntityError) GetReplacementIds() []string {
+	if x != nil {
+		return x.xxx_hidden_ReplacementIds
+	}
+	return nil
+}
+
 func (x *EntityError) SetEntityName(v string) {
 	x.xxx_hidden_EntityName = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 4)
 }
 
 func (x *EntityError) SetId(v string) {
 	x.xxx_hidden_Id = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 4)
+}
+
+func (x *EntityError) SetField(v string) {
+	x.xxx_hidden_Field = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 4)
+}
+
+func (x *EntityError) SetReplacementIds(v []string) {
+	x.xxx_hidden_ReplacementIds = v
 }
 
 func (x *EntityError) HasEntityName() bool {
@@ -192,6 +220,13 @@ func (x *EntityError) HasId() bool {
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
 }
 
+func (x *EntityError) HasField() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
 func (x *EntityError) ClearEntityName() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
 	x.xxx_hidden_EntityName = nil
@@ -202,11 +237,19 @@ func (x *EntityError) ClearId() {
 	x.xxx_hidden_Id = nil
 }
 
+func (x *EntityError) ClearField() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Field = nil
+}
+
 type EntityError_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
 	EntityName *string
 	Id         *string
+	Field      *string
+	// active products that replace an archived one, best first
+	ReplacementIds []string
 }
 
 func (b0 EntityError_builder) Build() *EntityError {
@@ -214,13 +257,18 @@ func (b0 EntityError_builder) Build() *EntityError {
 	b, x := &b0, m0
 	_, _ = b, x
 	if b.EntityName != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 4)
 		x.xxx_hidden_EntityName = b.EntityName
 	}
 	if b.Id != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 4)
 		x.xxx_hidden_Id = b.Id
 	}
+	if b.Field != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 4)
+		x.xxx_hidden_Field = b.Field
+	}
+	x.xxx_hidden_ReplacementIds = b.ReplacementIds
 	return m0
 }
 
@@ -371,11 +419,13 @@ const file_api_errors_proto_rawDesc = "" +
 	"\x10api/errors.proto\x12\x06errors\x1a!google/protobuf/go_features.proto\"E\n" +
 	"\x0fValidationError\x12\x18\n" +
 	"\amessage\x18\x01 \x01(\tR\amessage\x12\x18\n" +
-	"\amembers\x18\x02 \x03(\tR\amembers\">\n" +
+	"\amembers\x18\x02 \x03(\tR\amembers\"}\n" +
 	"\vEntityError\x12\x1f\n" +
 	"\ventity_name\x18\x01 \x01(\tR\n" +
 	"entityName\x12\x0e\n" +
-	"\x02id\x18\x02 \x01(\tR\x02id\"\xbb\x01\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x14\n" +
+	"\x05field\x18\x03 \x01(\tR\x05field\x12'\n" +
+	"\x0freplacement_ids\x18\x04 \x03(\tR\x0ereplacementIds\"\xbb\x01\n" +
 	"\vErrorDetail\x12\x12\n" +
 	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12D\n" +
@@ -10,6 +10,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	_ "google.golang.org/protobuf/types/gofeaturespb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	unsafe "unsafe"
 )
@@ -21,19 +22,73 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// What to do with lines that resolve to the same product
+type DuplicateMode int32
+
+const (
+	// handle every line on its own
+	DuplicateMode_DUPLICATE_MODE_UNSPECIFIED DuplicateMode = 0
+	// fail with error 53
+	DuplicateMode_DUPLICATE_MODE_REJECT DuplicateMode = 1
+	// keep the first line, adding up quantities
+	DuplicateMode_DUPLICATE_MODE_MERGE DuplicateMode = 2
+)
+
+// Enum value maps for DuplicateMode.
+var (
+	DuplicateMode_name = map[int32]string{
+		0: "DUPLICATE_MODE_UNSPECIFIED",
+		1: "DUPLICATE_MODE_REJECT",
+		2: "DUPLICATE_MODE_MERGE",
+	}
+	DuplicateMode_value = map[string]int32{
+		"DUPLICATE_MODE_UNSPECIFIED": 0,
+		"DUPLICATE_MODE_REJECT":      1,
+		"DUPLICATE_MODE_MERGE":       2,
+	}
+)
+
+func (x DuplicateMode) Enum() *DuplicateMode {
+	p := new(DuplicateMode)
+	*p = x
+	return p
+}
+
+func (x DuplicateMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DuplicateMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_product_proto_enumTypes[0].Descriptor()
+}
+
+func (DuplicateMode) Type() protoreflect.EnumType {
+	return &file_api_product_proto_enumTypes[0]
+}
+
+func (x DuplicateMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
 type ProductType int32
 
 const (
-	ProductType_PRODUCT_TYPE_UNKNOWN ProductType = 0
+	ProductType_PRODUCT_TYPE_UNKNOWN        ProductType = 0
+	ProductType_PRODUCT_TYPE_SKU            ProductType = 1
+	ProductType_PRODUCT_TYPE_MATERIAL_ASSET ProductType = 2
 )
 
 // Enum value maps for ProductType.
 var (
 	ProductType_name = map[int32]string{
 		0: "PRODUCT_TYPE_UNKNOWN",
+		1: "PRODUCT_TYPE_SKU",
+		2: "PRODUCT_TYPE_MATERIAL_ASSET",
 	}
 	ProductType_value = map[string]int32{
-		"PRODUCT_TYPE_UNKNOWN": 0,
+		"PRODUCT_TYPE_UNKNOWN":        0,
+		"PRODUCT_TYPE_SKU":            1,
+		"PRODUCT_TYPE_MATERIAL_ASSET": 2,
 	}
 )
 
@@ -48,11 +103,11 @@ func (x ProductType) String() string {
 }
 
 func (ProductType) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_product_proto_enumTypes[0].Descriptor()
+	return file_api_product_proto_enumTypes[1].Descriptor()
 }
 
 func (ProductType) Type() protoreflect.EnumType {
-	return &file_api_product_proto_enumTypes[0]
+	return &file_api_product_proto_enumTypes[1]
 }
 
 func (x ProductType) Number() protoreflect.EnumNumber {
@@ -92,11 +147,11 @@ func (x ProductionType) String() string {
 }
 
 func (ProductionType) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_product_proto_enumTypes[1].Descriptor()
+	return file_api_product_proto_enumTypes[2].Descriptor()
 }
 
 func (ProductionType) Type() protoreflect.EnumType {
-	return &file_api_product_proto_enumTypes[1]
+	return &file_api_product_proto_enumTypes[2]
 }
 
 func (x ProductionType) Number() protoreflect.EnumNumber {
@@ -316,25 +371,74 @@ func (x ProductGroup) String() string {
 }
 
 func (ProductGroup) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_product_proto_enumTypes[2].Descriptor()
+	return file_api_product_proto_enumTypes[3].Descriptor()
 }
 
 func (ProductGroup) Type() protoreflect.EnumType {
-	return &file_api_product_proto_enumTypes[2]
+	return &file_api_product_proto_enumTypes[3]
 }
 
 func (x ProductGroup) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
+type QualityIssueCategory int32
+
+const (
+	QualityIssueCategory_QUALITY_ISSUE_CATEGORY_UNSPECIFIED     QualityIssueCategory = 0
+	QualityIssueCategory_QUALITY_ISSUE_CATEGORY_ZERO_DIMENSIONS QualityIssueCategory = 1
+	// orders fail with error 11
+	QualityIssueCategory_QUALITY_ISSUE_CATEGORY_UNKNOWN_PRODUCTION_TYPE QualityIssueCategory = 2
+	QualityIssueCategory_QUALITY_ISSUE_CATEGORY_NO_GROUP                QualityIssueCategory = 3
+	// count_ma differs from the number of relation rows
+	QualityIssueCategory_QUALITY_ISSUE_CATEGORY_COUNT_MA_MISMATCH QualityIssueCategory = 4
+)
+
+// Enum value maps for QualityIssueCategory.
+var (
+	QualityIssueCategory_name = map[int32]string{
+		0: "QUALITY_ISSUE_CATEGORY_UNSPECIFIED",
+		1: "QUALITY_ISSUE_CATEGORY_ZERO_DIMENSIONS",
+		2: "QUALITY_ISSUE_CATEGORY_UNKNOWN_PRODUCTION_TYPE",
+		3: "QUALITY_ISSUE_CATEGORY_NO_GROUP",
+		4: "QUALITY_ISSUE_CATEGORY_COUNT_MA_MISMATCH",
+	}
+	QualityIssueCategory_value = map[string]int32{
+		"QUALITY_ISSUE_CATEGORY_UNSPECIFIED":             0,
+		"QUALITY_ISSUE_CATEGORY_ZERO_DIMENSIONS":         1,
+		"QUALITY_ISSUE_CATEGORY_UNKNOWN_PRODUCTION_TYPE": 2,
+		"QUALITY_ISSUE_CATEGORY_NO_GROUP":                3,
+		"QUALITY_ISSUE_CATEGORY_COUNT_MA_MISMATCH":       4,
+	}
+)
+
+func (x QualityIssueCategory) Enum() *QualityIssueCategory {
+	p := new(QualityIssueCategory)
+	*p = x
+	return p
+}
+
+func (x QualityIssueCategory) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (QualityIssueCategory) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_product_proto_enumTypes[4].Descriptor()
+}
+
+func (QualityIssueCategory) Type() protoreflect.EnumType {
+	return &file_api_product_proto_enumTypes[4]
+}
+
+func (x QualityIssueCategory) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
 type ProductRequest struct {
-	state                    protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_IntegrationId *string                `protobuf:"bytes,1,opt,name=integration_id,json=integrationId"`
-	xxx_hidden_Fnrec         *string                `protobuf:"bytes,2,opt,name=fnrec"`
-	XXX_raceDetectHookData   protoimpl.RaceDetectHookData
-	XXX_presence             [1]uint32
-	unknownFields            protoimpl.UnknownFields
-	sizeCache                protoimpl.SizeCache
+	state                 protoimpl.MessageState      `protogen:"opaque.v1"`
+	xxx_hidden_Identifier isProductRequest_Identifier `protobuf_oneof:"identifier"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
 func (x *ProductRequest) Reset() {
@@ -364,63 +468,125 @@ func (x *ProductRequest) ProtoReflect() protoreflect.Message {
 
 func (x *ProductRequest) GetIntegrationId() string {
 	if x != nil {
-		if x.xxx_hidden_IntegrationId != nil {
-			return *x.xxx_hidden_IntegrationId
+		if x, ok := x.xxx_hidden_Identifier.(*productRequest_IntegrationId); ok {
+			return x.IntegrationId
 		}
-		return ""
 	}
 	return ""
 }
 
 func (x *ProductRequest) GetFnrec() string {
 	if x != nil {
-		if x.xxx_hidden_Fnrec != nil {
-			return *x.xxx_hidden_Fnrec
+		if x, ok := x.xxx_hidden_Identifier.(*productRequest_Fnrec); ok {
+			return x.Fnrec
+		}
+	}
+	return ""
+}
+
+func (x *ProductRequest) GetId() string {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Identifier.(*productRequest_Id); ok {
+			return x.Id
 		}
-		return ""
 	}
 	return ""
 }
 
 func (x *ProductRequest) SetIntegrationId(v string) {
-	x.xxx_hidden_IntegrationId = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+	x.xxx_hidden_Identifier = &productRequest_IntegrationId{v}
 }
 
 func (x *ProductRequest) SetFnrec(v string) {
-	x.xxx_hidden_Fnrec = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+	x.xxx_hidden_Identifier = &productRequest_Fnrec{v}
+}
+
+func (x *ProductRequest) SetId(v string) {
+	x.xxx_hidden_Identifier = &productRequest_Id{v}
+}
+
+func (x *ProductRequest) HasIdentifier() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Identifier != nil
 }
 
 func (x *ProductRequest) HasIntegrationId() bool {
 	if x == nil {
 		return false
 	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+	_, ok := x.xxx_hidden_Identifier.(*productRequest_IntegrationId)
+	return ok
 }
 
 func (x *ProductRequest) HasFnrec() bool {
 	if x == nil {
 		return false
 	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+	_, ok := x.xxx_hidden_Identifier.(*productRequest_Fnrec)
+	return ok
+}
+
+func (x *ProductRequest) HasId() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Identifier.(*productRequest_Id)
+	return ok
+}
+
+func (x *ProductRequest) ClearIdentifier() {
+	x.xxx_hidden_Identifier = nil
 }
 
 func (x *ProductRequest) ClearIntegrationId() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
-	x.xxx_hidden_IntegrationId = nil
+	if _, ok := x.xxx_hidden_Identifier.(*productRequest_IntegrationId); ok {
+		x.xxx_hidden_Identifier = nil
+	}
 }
 
 func (x *ProductRequest) ClearFnrec() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
-	x.xxx_hidden_Fnrec = nil
+	if _, ok := x.xxx_hidden_Identifier.(*productRequest_Fnrec); ok {
+		x.xxx_hidden_Identifier = nil
+	}
+}
+
+func (x *ProductRequest) ClearId() {
+	if _, ok := x.xxx_hidden_Identifier.(*productRequest_Id); ok {
+		x.xxx_hidden_Identifier = nil
+	}
+}
+
+const ProductRequest_Identifier_not_set_case case_ProductRequest_Identifier = 0
+const ProductRequest_IntegrationId_case case_ProductRequest_Identifier = 1
+const ProductRequest_Fnrec_case case_ProductRequest_Identifier = 2
+const ProductRequest_Id_case case_ProductRequest_Identifier = 3
+
+func (x *ProductRequest) WhichIdentifier() case_ProductRequest_Identifier {
+	if x == nil {
+		return ProductRequest_Identifier_not_set_case
+	}
+	switch x.xxx_hidden_Identifier.(type) {
+	case *productRequest_IntegrationId:
+		return ProductRequest_IntegrationId_case
+	case *productRequest_Fnrec:
+		return ProductRequest_Fnrec_case
+	case *productRequest_Id:
+		return ProductRequest_Id_case
+	default:
+		return ProductRequest_Identifier_not_set_case
+	}
 }
 
 type ProductRequest_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
+	// Fields of oneof xxx_hidden_Identifier:
 	IntegrationId *string
 	Fnrec         *string
+	Id            *string
+	// -- end of xxx_hidden_Identifier
 }
 
 func (b0 ProductRequest_builder) Build() *ProductRequest {
@@ -428,21 +594,58 @@ func (b0 ProductRequest_builder) Build() *ProductRequest {
 	b, x := &b0, m0
 	_, _ = b, x
 	if b.IntegrationId != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
-		x.xxx_hidden_IntegrationId = b.IntegrationId
+		x.xxx_hidden_Identifier = &productRequest_IntegrationId{*b.IntegrationId}
 	}
 	if b.Fnrec != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
-		x.xxx_hidden_Fnrec = b.Fnrec
+		x.xxx_hidden_Identifier = &productRequest_Fnrec{*b.Fnrec}
+	}
+	if b.Id != nil {
+		x.xxx_hidden_Identifier = &productRequest_Id{*b.Id}
 	}
 	return m0
 }
 
+type case_ProductRequest_Identifier protoreflect.FieldNumber
+
+func (x case_ProductRequest_Identifier) String() string {
+	md := file_api_product_proto_msgTypes[0].Descriptor()
+	if x == 0 {
+		return "not set"
+	}
+	return protoimpl.X.MessageFieldStringOf(md, protoreflect.FieldNumber(x))
+}
+
+type isProductRequest_Identifier interface {
+	isProductRequest_Identifier()
+}
+
+type productRequest_IntegrationId struct {
+	IntegrationId string `protobuf:"bytes,1,opt,name=integration_id,json=integrationId,oneof"`
+}
+
+type productRequest_Fnrec struct {
+	Fnrec string `protobuf:"bytes,2,opt,name=fnrec,oneof"`
+}
+
+type productRequest_Id struct {
+	Id string `protobuf:"bytes,3,opt,name=id,oneof"`
+}
+
+func (*productRequest_IntegrationId) isProductRequest_Identifier() {}
+
+func (*productRequest_Fnrec) isProductRequest_Identifier() {}
+
+func (*productRequest_Id) isProductRequest_Identifier() {}
+
 type BatchProductRequest struct {
-	state               protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Requests *[]*ProductRequest     `protobuf:"bytes,1,rep,name=requests"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Requests    *[]*ProductRequest     `protobuf:"bytes,1,rep,name=requests"`
+	xxx_hidden_Partial     bool                   `protobuf:"varint,2,opt,name=partial"`
+	xxx_hidden_Duplicates  DuplicateMode          `protobuf:"varint,3,opt,name=duplicates,enum=products.DuplicateMode"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
 func (x *BatchProductRequest) Reset() {
@@ -479,14 +682,66 @@ func (x *BatchProductRequest) GetRequests() []*ProductRequest {
 	return nil
 }
 
+func (x *BatchProductRequest) GetPartial() bool {
+	if x != nil {
+		return x.xxx_hidden_Partial
+	}
+	return false
+}
+
+func (x *BatchProductRequest) GetDuplicates() DuplicateMode {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 2) {
+			return x.xxx_hidden_Duplicates
+		}
+	}
+	return DuplicateMode_DUPLICATE_MODE_UNSPECIFIED
+}
+
 func (x *BatchProductRequest) SetRequests(v []*ProductRequest) {
 	x.xxx_hidden_Requests = &v
 }
 
+func (x *BatchProductRequest) SetPartial(v bool) {
+	x.xxx_hidden_Partial = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 3)
+}
+
+func (x *BatchProductRequest) SetDuplicates(v DuplicateMode) {
+	x.xxx_hidden_Duplicates = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 3)
+}
+
+func (x *BatchProductRequest) HasPartial() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *BatchProductRequest) HasDuplicates() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *BatchProductRequest) ClearPartial() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Partial = false
+}
+
+func (x *BatchProductRequest) ClearDuplicates() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Duplicates = DuplicateMode_DUPLICATE_MODE_UNSPECIFIED
+}
+
 type BatchProductRequest_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Requests []*ProductRequest
+	Requests   []*ProductRequest
+	Partial    *bool
+	Duplicates *DuplicateMode
 }
 
 func (b0 BatchProductRequest_builder) Build() *BatchProductRequest {
@@ -494,6 +749,14 @@ func (b0 BatchProductRequest_builder) Build() *BatchProductRequest {
 	b, x := &b0, m0
 	_, _ = b, x
 	x.xxx_hidden_Requests = &b.Requests
+	if b.Partial != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 3)
+		x.xxx_hidden_Partial = *b.Partial
+	}
+	if b.Duplicates != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 3)
+		x.xxx_hidden_Duplicates = *b.Duplicates
+	}
 	return m0
 }
 
@@ -705,6 +968,10 @@ type Product struct {
 	xxx_hidden_AccountProvider       *string                `protobuf:"bytes,9,opt,name=account_provider,json=accountProvider"`
 	xxx_hidden_NonStandardCategoryId *string                `protobuf:"bytes,10,opt,name=non_standard_category_id,json=nonStandardCategoryId"`
 	xxx_hidden_Pack                  *Pack                  `protobuf:"bytes,11,opt,name=pack"`
+	xxx_hidden_Type                  ProductType            `protobuf:"varint,12,opt,name=type,enum=products.ProductType"`
+	xxx_hidden_IsArchive             bool                   `protobuf:"varint,13,opt,name=is_archive,json=isArchive"`
+	xxx_hidden_IntegrationId         *string                `protobuf:"bytes,14,opt,name=integration_id,json=integrationId"`
+	xxx_hidden_CountMa               int32                  `protobuf:"varint,15,opt,name=count_ma,json=countMa"`
 	XXX_raceDetectHookData           protoimpl.RaceDetectHookData
 	XXX_presence                     [1]uint32
 	unknownFields                    protoimpl.UnknownFields
@@ -838,60 +1105,113 @@ func (x *Product) GetPack() *Pack {
 	return nil
 }
 
+func (x *Product) GetType() ProductType {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 11) {
+			return x.xxx_hidden_Type
+		}
+	}
+	return ProductType_PRODUCT_TYPE_UNKNOWN
+}
+
+func (x *Product) GetIsArchive() bool {
+	if x != nil {
+		return x.xxx_hidden_IsArchive
+	}
+	return false
+}
+
+func (x *Product) GetIntegrationId() string {
+	if x != nil {
+		if x.xxx_hidden_IntegrationId != nil {
+			return *x.xxx_hidden_IntegrationId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Product) GetCountMa() int32 {
+	if x != nil {
+		return x.xxx_hidden_CountMa
+	}
+	return 0
+}
+
 func (x *Product) SetId(v string) {
 	x.xxx_hidden_Id = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 11)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 15)
 }
 
 func (x *Product) SetName(v string) {
 	x.xxx_hidden_Name = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 11)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 15)
 }
 
 func (x *Product) SetProductionType(v ProductionType) {
 	x.xxx_hidden_ProductionType = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 11)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 15)
 }
 
 func (x *Product) SetFnrec(v string) {
 	x.xxx_hidden_Fnrec = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 11)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 15)
 }
 
 func (x *Product) SetIsService(v bool) {
 	x.xxx_hidden_IsService = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 11)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 15)
 }
 
 func (x *Product) SetGroup(v ProductGroup) {
 	x.xxx_hidden_Group = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 11)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 15)
 }
 
 func (x *Product) SetSeriesId(v string) {
 	x.xxx_hidden_SeriesId = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 11)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 15)
 }
 
 func (x *Product) SetCategoryId(v string) {
 	x.xxx_hidden_CategoryId = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 11)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 15)
 }
 
 func (x *Product) SetAccountProvider(v string) {
 	x.xxx_hidden_AccountProvider = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 8, 11)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 8, 15)
 }
 
 func (x *Product) SetNonStandardCategoryId(v string) {
 	x.xxx_hidden_NonStandardCategoryId = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 9, 11)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 9, 15)
 }
 
 func (x *Product) SetPack(v *Pack) {
 	x.xxx_hidden_Pack = v
 }
 
+func (x *Product) SetType(v ProductType) {
+	x.xxx_hidden_Type = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 11, 15)
+}
+
+func (x *Product) SetIsArchive(v bool) {
+	x.xxx_hidden_IsArchive = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 12, 15)
+}
+
+func (x *Product) SetIntegrationId(v string) {
+	x.xxx_hidden_IntegrationId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 13, 15)
+}
+
+func (x *Product) SetCountMa(v int32) {
+	x.xxx_hidden_CountMa = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 14, 15)
+}
+
 func (x *Product) HasId() bool {
 	if x == nil {
 		return false
@@ -969,6 +1289,34 @@ func (x *Product) HasPack() bool {
 	return x.xxx_hidden_Pack != nil
 }
 
+func (x *Product) HasType() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 11)
+}
+
+func (x *Product) HasIsArchive() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 12)
+}
+
+func (x *Product) HasIntegrationId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 13)
+}
+
+func (x *Product) HasCountMa() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 14)
+}
+
 func (x *Product) ClearId() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
 	x.xxx_hidden_Id = nil
@@ -1023,6 +1371,26 @@ func (x *Product) ClearPack() {
 	x.xxx_hidden_Pack = nil
 }
 
+func (x *Product) ClearType() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 11)
+	x.xxx_hidden_Type = ProductType_PRODUCT_TYPE_UNKNOWN
+}
+
+func (x *Product) ClearIsArchive() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 12)
+	x.xxx_hidden_IsArchive = false
+}
+
+func (x *Product) ClearIntegrationId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 13)
+	x.xxx_hidden_IntegrationId = nil
+}
+
+func (x *Product) ClearCountMa() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 14)
+	x.xxx_hidden_CountMa = 0
+}
+
 type Product_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
@@ -1037,6 +1405,11 @@ type Product_builder struct {
 	AccountProvider       *string
 	NonStandardCategoryId *string
 	Pack                  *Pack
+	Type                  *ProductType
+	IsArchive             *bool
+	IntegrationId         *string
+	// number of material assets the sku is assembled from
+	CountMa *int32
 }
 
 func (b0 Product_builder) Build() *Product {
@@ -1044,71 +1417,3346 @@ func (b0 Product_builder) Build() *Product {
 	b, x := &b0, m0
 	_, _ = b, x
 	if b.Id != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 11)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 15)
 		x.xxx_hidden_Id = b.Id
 	}
 	if b.Name != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 11)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 15)
 		x.xxx_hidden_Name = b.Name
 	}
 	if b.ProductionType != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 11)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 15)
 		x.xxx_hidden_ProductionType = *b.ProductionType
 	}
 	if b.Fnrec != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 11)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 15)
 		x.xxx_hidden_Fnrec = b.Fnrec
 	}
 	if b.IsService != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 11)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 15)
 		x.xxx_hidden_IsService = *b.IsService
 	}
 	if b.Group != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 11)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 15)
 		x.xxx_hidden_Group = *b.Group
 	}
 	if b.SeriesId != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 11)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 15)
 		x.xxx_hidden_SeriesId = b.SeriesId
 	}
 	if b.CategoryId != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 11)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 15)
 		x.xxx_hidden_CategoryId = b.CategoryId
 	}
 	if b.AccountProvider != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 8, 11)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 8, 15)
 		x.xxx_hidden_AccountProvider = b.AccountProvider
 	}
 	if b.NonStandardCategoryId != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 9, 11)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 9, 15)
 		x.xxx_hidden_NonStandardCategoryId = b.NonStandardCategoryId
 	}
 	x.xxx_hidden_Pack = b.Pack
+	if b.Type != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 11, 15)
+		x.xxx_hidden_Type = *b.Type
+	}
+	if b.IsArchive != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 12, 15)
+		x.xxx_hidden_IsArchive = *b.IsArchive
+	}
+	if b.IntegrationId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 13, 15)
+		x.xxx_hidden_IntegrationId = b.IntegrationId
+	}
+	if b.CountMa != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 14, 15)
+		x.xxx_hidden_CountMa = *b.CountMa
+	}
+	return m0
+}
+
+type SearchProductsRequest struct {
+	state                       protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Group            ProductGroup           `protobuf:"varint,1,opt,name=group,enum=products.ProductGroup"`
+	xxx_hidden_SeriesId         *string                `protobuf:"bytes,2,opt,name=series_id,json=seriesId"`
+	xxx_hidden_CategoryId       *string                `protobuf:"bytes,3,opt,name=category_id,json=categoryId"`
+	xxx_hidden_ProductionType   ProductionType         `protobuf:"varint,4,opt,name=production_type,json=productionType,enum=products.ProductionType"`
+	xxx_hidden_IsService        bool                   `protobuf:"varint,5,opt,name=is_service,json=isService"`
+	xxx_hidden_IsArchive        bool                   `protobuf:"varint,6,opt,name=is_archive,json=isArchive"`
+	xxx_hidden_Name             *string                `protobuf:"bytes,7,opt,name=name"`
+	xxx_hidden_NamePrefix       bool                   `protobuf:"varint,8,opt,name=name_prefix,json=namePrefix"`
+	xxx_hidden_PageSize         int32                  `protobuf:"varint,9,opt,name=page_size,json=pageSize"`
+	xxx_hidden_Cursor           *string                `protobuf:"bytes,10,opt,name=cursor"`
+	xxx_hidden_IncludeSubgroups bool                   `protobuf:"varint,11,opt,name=include_subgroups,json=includeSubgroups"`
+	XXX_raceDetectHookData      protoimpl.RaceDetectHookData
+	XXX_presence                [1]uint32
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *SearchProductsRequest) Reset() {
+	*x = SearchProductsRequest{}
+	mi := &file_api_product_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchProductsRequest) ProtoMessage() {}
+
+func (x *SearchProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_product_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *SearchProductsRequest) GetGroup() ProductGroup {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 0) {
+			return x.xxx_hidden_Group
+		}
+	}
+	return ProductGroup_PRODUCT_GROUP_UNSPECIFIED
+}
+
+func (x *SearchProductsRequest) GetSeriesId() string {
+	if x != nil {
+		if x.xxx_hidden_SeriesId != nil {
+			return *x.xxx_hidden_SeriesId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *SearchProductsRequest) GetCategoryId() string {
+	if x != nil {
+		if x.xxx_hidden_CategoryId != nil {
+			return *x.xxx_hidden_CategoryId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *SearchProductsRequest) GetProductionType() ProductionType {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 3) {
+			return x.xxx_hidden_ProductionType
+		}
+	}
+	return ProductionType_PRODUCTION_TYPE_UNKNOWN
+}
+
+func (x *SearchProductsRequest) GetIsService() bool {
+	if x != nil {
+		return x.xxx_hidden_IsService
+	}
+	return false
+}
+
+func (x *SearchProductsRequest) GetIsArchive() bool {
+	if x != nil {
+		return x.xxx_hidden_IsArchive
+	}
+	return false
+}
+
+func (x *SearchProductsRequest) GetName() string {
+	if x != nil {
+		if x.xxx_hidden_Name != nil {
+			return *x.xxx_hidden_Name
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *SearchProductsRequest) GetNamePrefix() bool {
+	if x != nil {
+		return x.xxx_hidden_NamePrefix
+	}
+	return false
+}
+
+func (x *SearchProductsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.xxx_hidden_PageSize
+	}
+	return 0
+}
+
+func (x *SearchProductsRequest) GetCursor() string {
+	if x != nil {
+		if x.xxx_hidden_Cursor != nil {
+			return *x.xxx_hidden_Cursor
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *SearchProductsRequest) GetIncludeSubgroups() bool {
+	if x != nil {
+		return x.xxx_hidden_IncludeSubgroups
+	}
+	return false
+}
+
+func (x *SearchProductsRequest) SetGroup(v ProductGroup) {
+	x.xxx_hidden_Group = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 11)
+}
+
+func (x *SearchProductsRequest) SetSeriesId(v string) {
+	x.xxx_hidden_SeriesId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 11)
+}
+
+func (x *SearchProductsRequest) SetCategoryId(v string) {
+	x.xxx_hidden_CategoryId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 11)
+}
+
+func (x *SearchProductsRequest) SetProductionType(v ProductionType) {
+	x.xxx_hidden_ProductionType = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 11)
+}
+
+func (x *SearchProductsRequest) SetIsService(v bool) {
+	x.xxx_hidden_IsService = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 11)
+}
+
+func (x *SearchProductsRequest) SetIsArchive(v bool) {
+	x.xxx_hidden_IsArchive = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 11)
+}
+
+func (x *SearchProductsRequest) SetName(v string) {
+	x.xxx_hidden_Name = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 11)
+}
+
+func (x *SearchProductsRequest) SetNamePrefix(v bool) {
+	x.xxx_hidden_NamePrefix = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 11)
+}
+
+func (x *SearchProductsRequest) SetPageSize(v int32) {
+	x.xxx_hidden_PageSize = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 8, 11)
+}
+
+func (x *SearchProductsRequest) SetCursor(v string) {
+	x.xxx_hidden_Cursor = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 9, 11)
+}
+
+func (x *SearchProductsRequest) SetIncludeSubgroups(v bool) {
+	x.xxx_hidden_IncludeSubgroups = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 10, 11)
+}
+
+func (x *SearchProductsRequest) HasGroup() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *SearchProductsRequest) HasSeriesId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *SearchProductsRequest) HasCategoryId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *SearchProductsRequest) HasProductionType() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *SearchProductsRequest) HasIsService() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *SearchProductsRequest) HasIsArchive() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+}
+
+func (x *SearchProductsRequest) HasName() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 6)
+}
+
+func (x *SearchProductsRequest) HasNamePrefix() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 7)
+}
+
+func (x *SearchProductsRequest) HasPageSize() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 8)
+}
+
+func (x *SearchProductsRequest) HasCursor() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 9)
+}
+
+func (x *SearchProductsRequest) HasIncludeSubgroups() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 10)
+}
+
+func (x *SearchProductsRequest) ClearGroup() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Group = ProductGroup_PRODUCT_GROUP_UNSPECIFIED
+}
+
+func (x *SearchProductsRequest) ClearSeriesId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_SeriesId = nil
+}
+
+func (x *SearchProductsRequest) ClearCategoryId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_CategoryId = nil
+}
+
+func (x *SearchProductsRequest) ClearProductionType() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_ProductionType = ProductionType_PRODUCTION_TYPE_UNKNOWN
+}
+
+func (x *SearchProductsRequest) ClearIsService() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_IsService = false
+}
+
+func (x *SearchProductsRequest) ClearIsArchive() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_IsArchive = false
+}
+
+func (x *SearchProductsRequest) ClearName() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 6)
+	x.xxx_hidden_Name = nil
+}
+
+func (x *SearchProductsRequest) ClearNamePrefix() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 7)
+	x.xxx_hidden_NamePrefix = false
+}
+
+func (x *SearchProductsRequest) ClearPageSize() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 8)
+	x.xxx_hidden_PageSize = 0
+}
+
+func (x *SearchProductsRequest) ClearCursor() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 9)
+	x.xxx_hidden_Cursor = nil
+}
+
+func (x *SearchProductsRequest) ClearIncludeSubgroups() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 10)
+	x.xxx_hidden_IncludeSubgroups = false
+}
+
+type SearchProductsRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Group          *ProductGroup
+	SeriesId       *string
+	CategoryId     *string
+	ProductionType *ProductionType
+	IsService      *bool
+	IsArchive      *bool
+	Name           *string
+	NamePrefix     *bool
+	PageSize       *int32
+	Cursor         *string
+	// search the whole subtree below group
+	IncludeSubgroups *bool
+}
+
+func (b0 SearchProductsRequest_builder) Build() *SearchProductsRequest {
+	m0 := &SearchProductsRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Group != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 11)
+		x.xxx_hidden_Group = *b.Group
+	}
+	if b.SeriesId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 11)
+		x.xxx_hidden_SeriesId = b.SeriesId
+	}
+	if b.CategoryId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 11)
+		x.xxx_hidden_CategoryId = b.CategoryId
+	}
+	if b.ProductionType != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 11)
+		x.xxx_hidden_ProductionType = *b.ProductionType
+	}
+	if b.IsService != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 11)
+		x.xxx_hidden_IsService = *b.IsService
+	}
+	if b.IsArchive != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 11)
+		x.xxx_hidden_IsArchive = *b.IsArchive
+	}
+	if b.Name != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 11)
+		x.xxx_hidden_Name = b.Name
+	}
+	if b.NamePrefix != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 11)
+		x.xxx_hidden_NamePrefix = *b.NamePrefix
+	}
+	if b.PageSize != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 8, 11)
+		x.xxx_hidden_PageSize = *b.PageSize
+	}
+	if b.Cursor != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 9, 11)
+		x.xxx_hidden_Cursor = b.Cursor
+	}
+	if b.IncludeSubgroups != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 10, 11)
+		x.xxx_hidden_IncludeSubgroups = *b.IncludeSubgroups
+	}
+	return m0
+}
+
+type SearchProductsResponse struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Products    *[]*Product            `protobuf:"bytes,1,rep,name=products"`
+	xxx_hidden_NextCursor  *string                `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *SearchProductsResponse) Reset() {
+	*x = SearchProductsResponse{}
+	mi := &file_api_product_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchProductsResponse) ProtoMessage() {}
+
+func (x *SearchProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_product_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *SearchProductsResponse) GetProducts() []*Product {
+	if x != nil {
+		if x.xxx_hidden_Products != nil {
+			return *x.xxx_hidden_Products
+		}
+	}
+	return nil
+}
+
+func (x *SearchProductsResponse) GetNextCursor() string {
+	if x != nil {
+		if x.xxx_hidden_NextCursor != nil {
+			return *x.xxx_hidden_NextCursor
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *SearchProductsResponse) SetProducts(v []*Product) {
+	x.xxx_hidden_Products = &v
+}
+
+func (x *SearchProductsResponse) SetNextCursor(v string) {
+	x.xxx_hidden_NextCursor = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *SearchProductsResponse) HasNextCursor() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *SearchProductsResponse) ClearNextCursor() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_NextCursor = nil
+}
+
+type SearchProductsResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Products   []*Product
+	NextCursor *string
+}
+
+func (b0 SearchProductsResponse_builder) Build() *SearchProductsResponse {
+	m0 := &SearchProductsResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Products = &b.Products
+	if b.NextCursor != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_NextCursor = b.NextCursor
+	}
+	return m0
+}
+
+// ProductFilter holds the filters of SearchProducts without paging
+type ProductFilter struct {
+	state                       protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Group            ProductGroup           `protobuf:"varint,1,opt,name=group,enum=products.ProductGroup"`
+	xxx_hidden_SeriesId         *string                `protobuf:"bytes,2,opt,name=series_id,json=seriesId"`
+	xxx_hidden_CategoryId       *string                `protobuf:"bytes,3,opt,name=category_id,json=categoryId"`
+	xxx_hidden_ProductionType   ProductionType         `protobuf:"varint,4,opt,name=production_type,json=productionType,enum=products.ProductionType"`
+	xxx_hidden_IsService        bool                   `protobuf:"varint,5,opt,name=is_service,json=isService"`
+	xxx_hidden_IsArchive        bool                   `protobuf:"varint,6,opt,name=is_archive,json=isArchive"`
+	xxx_hidden_Name             *string                `protobuf:"bytes,7,opt,name=name"`
+	xxx_hidden_NamePrefix       bool                   `protobuf:"varint,8,opt,name=name_prefix,json=namePrefix"`
+	xxx_hidden_IncludeSubgroups bool                   `protobuf:"varint,9,opt,name=include_subgroups,json=includeSubgroups"`
+	XXX_raceDetectHookData      protoimpl.RaceDetectHookData
+	XXX_presence                [1]uint32
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *ProductFilter) Reset() {
+	*x = ProductFilter{}
+	mi := &file_api_product_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductFilter) ProtoMessage() {}
+
+func (x *ProductFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_api_product_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ProductFilter) GetGroup() ProductGroup {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 0) {
+			return x.xxx_hidden_Group
+		}
+	}
+	return ProductGroup_PRODUCT_GROUP_UNSPECIFIED
+}
+
+func (x *ProductFilter) GetSeriesId() string {
+	if x != nil {
+		if x.xxx_hidden_SeriesId != nil {
+			return *x.xxx_hidden_SeriesId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ProductFilter) GetCategoryId() string {
+	if x != nil {
+		if x.xxx_hidden_CategoryId != nil {
+			return *x.xxx_hidden_CategoryId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ProductFilter) GetProductionType() ProductionType {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 3) {
+			return x.xxx_hidden_ProductionType
+		}
+	}
+	return ProductionType_PRODUCTION_TYPE_UNKNOWN
+}
+
+func (x *ProductFilter) GetIsService() bool {
+	if x != nil {
+		return x.xxx_hidden_IsService
+	}
+	return false
+}
+
+func (x *ProductFilter) GetIsArchive() bool {
+	if x != nil {
+		return x.xxx_hidden_IsArchive
+	}
+	return false
+}
+
+func (x *ProductFilter) GetName() string {
+	if x != nil {
+		if x.xxx_hidden_Name != nil {
+			return *x.xxx_hidden_Name
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ProductFilter) GetNamePrefix() bool {
+	if x != nil {
+		return x.xxx_hidden_NamePrefix
+	}
+	return false
+}
+
+func (x *ProductFilter) GetIncludeSubgroups() bool {
+	if x != nil {
+		return x.xxx_hidden_IncludeSubgroups
+	}
+	return false
+}
+
+func (x *ProductFilter) SetGroup(v ProductGroup) {
+	x.xxx_hidden_Group = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 9)
+}
+
+func (x *ProductFilter) SetSeriesId(v string) {
+	x.xxx_hidden_SeriesId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 9)
+}
+
+func (x *ProductFilter) SetCategoryId(v string) {
+	x.xxx_hidden_CategoryId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 9)
+}
+
+func (x *ProductFilter) SetProductionType(v ProductionType) {
+	x.xxx_hidden_ProductionType = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 9)
+}
+
+func (x *ProductFilter) SetIsService(v bool) {
+	x.xxx_hidden_IsService = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 9)
+}
+
+func (x *ProductFilter) SetIsArchive(v bool) {
+	x.xxx_hidden_IsArchive = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 9)
+}
+
+func (x *ProductFilter) SetName(v string) {
+	x.xxx_hidden_Name = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 9)
+}
+
+func (x *ProductFilter) SetNamePrefix(v bool) {
+	x.xxx_hidden_NamePrefix = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 9)
+}
+
+func (x *ProductFilter) SetIncludeSubgroups(v bool) {
+	x.xxx_hidden_IncludeSubgroups = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 8, 9)
+}
+
+func (x *ProductFilter) HasGroup() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *ProductFilter) HasSeriesId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *ProductFilter) HasCategoryId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *ProductFilter) HasProductionType() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *ProductFilter) HasIsService() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *ProductFilter) HasIsArchive() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+}
+
+func (x *ProductFilter) HasName() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 6)
+}
+
+func (x *ProductFilter) HasNamePrefix() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 7)
+}
+
+func (x *ProductFilter) HasIncludeSubgroups() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 8)
+}
+
+func (x *ProductFilter) ClearGroup() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Group = ProductGroup_PRODUCT_GROUP_UNSPECIFIED
+}
+
+func (x *ProductFilter) ClearSeriesId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_SeriesId = nil
+}
+
+func (x *ProductFilter) ClearCategoryId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_CategoryId = nil
+}
+
+func (x *ProductFilter) ClearProductionType() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_ProductionType = ProductionType_PRODUCTION_TYPE_UNKNOWN
+}
+
+func (x *ProductFilter) ClearIsService() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_IsService = false
+}
+
+func (x *ProductFilter) ClearIsArchive() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_IsArchive = false
+}
+
+func (x *ProductFilter) ClearName() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 6)
+	x.xxx_hidden_Name = nil
+}
+
+func (x *ProductFilter) ClearNamePrefix() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 7)
+	x.xxx_hidden_NamePrefix = false
+}
+
+func (x *ProductFilter) ClearIncludeSubgroups() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 8)
+	x.xxx_hidden_IncludeSubgroups = false
+}
+
+type ProductFilter_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Group          *ProductGroup
+	SeriesId       *string
+	CategoryId     *string
+	ProductionType *ProductionType
+	IsService      *bool
+	IsArchive      *bool
+	Name           *string
+	NamePrefix     *bool
+	// search the whole subtree below group
+	IncludeSubgroups *bool
+}
+
+func (b0 ProductFilter_builder) Build() *ProductFilter {
+	m0 := &ProductFilter{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Group != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 9)
+		x.xxx_hidden_Group = *b.Group
+	}
+	if b.SeriesId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 9)
+		x.xxx_hidden_SeriesId = b.SeriesId
+	}
+	if b.CategoryId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 9)
+		x.xxx_hidden_CategoryId = b.CategoryId
+	}
+	if b.ProductionType != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 9)
+		x.xxx_hidden_ProductionType = *b.ProductionType
+	}
+	if b.IsService != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 9)
+		x.xxx_hidden_IsService = *b.IsService
+	}
+	if b.IsArchive != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 9)
+		x.xxx_hidden_IsArchive = *b.IsArchive
+	}
+	if b.Name != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 9)
+		x.xxx_hidden_Name = b.Name
+	}
+	if b.NamePrefix != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 9)
+		x.xxx_hidden_NamePrefix = *b.NamePrefix
+	}
+	if b.IncludeSubgroups != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 8, 9)
+		x.xxx_hidden_IncludeSubgroups = *b.IncludeSubgroups
+	}
+	return m0
+}
+
+type SearchProductsByNameRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Query       *string                `protobuf:"bytes,1,opt,name=query"`
+	xxx_hidden_Filter      *ProductFilter         `protobuf:"bytes,2,opt,name=filter"`
+	xxx_hidden_Limit       int32                  `protobuf:"varint,3,opt,name=limit"`
+	xxx_hidden_MinScore    float64                `protobuf:"fixed64,4,opt,name=min_score,json=minScore"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *SearchProductsByNameRequest) Reset() {
+	*x = SearchProductsByNameRequest{}
+	mi := &file_api_product_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchProductsByNameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchProductsByNameRequest) ProtoMessage() {}
+
+func (x *SearchProductsByNameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_product_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *SearchProductsByNameRequest) GetQuery() string {
+	if x != nil {
+		if x.xxx_hidden_Query != nil {
+			return *x.xxx_hidden_Query
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *SearchProductsByNameRequest) GetFilter() *ProductFilter {
+	if x != nil {
+		return x.xxx_hidden_Filter
+	}
+	return nil
+}
+
+func (x *SearchProductsByNameRequest) GetLimit() int32 {
+	if x != nil {
+		return x.xxx_hidden_Limit
+	}
+	return 0
+}
+
+func (x *SearchProductsByNameRequest) GetMinScore() float64 {
+	if x != nil {
+		return x.xxx_hidden_MinScore
+	}
+	return 0
+}
+
+func (x *SearchProductsByNameRequest) SetQuery(v string) {
+	x.xxx_hidden_Query = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 4)
+}
+
+func (x *SearchProductsByNameRequest) SetFilter(v *ProductFilter) {
+	x.xxx_hidden_Filter = v
+}
+
+func (x *SearchProductsByNameRequest) SetLimit(v int32) {
+	x.xxx_hidden_Limit = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 4)
+}
+
+func (x *SearchProductsByNameRequest) SetMinScore(v float64) {
+	x.xxx_hidden_MinScore = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 4)
+}
+
+func (x *SearchProductsByNameRequest) HasQuery() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *SearchProductsByNameRequest) HasFilter() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Filter != nil
+}
+
+func (x *SearchProductsByNameRequest) HasLimit() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *SearchProductsByNameRequest) HasMinScore() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *SearchProductsByNameRequest) ClearQuery() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Query = nil
+}
+
+func (x *SearchProductsByNameRequest) ClearFilter() {
+	x.xxx_hidden_Filter = nil
+}
+
+func (x *SearchProductsByNameRequest) ClearLimit() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Limit = 0
+}
+
+func (x *SearchProductsByNameRequest) ClearMinScore() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_MinScore = 0
+}
+
+type SearchProductsByNameRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// partial or misspelled name
+	Query  *string
+	Filter *ProductFilter
+	Limit  *int32
+	// 0 to 1, 0 uses the service default
+	MinScore *float64
+}
+
+func (b0 SearchProductsByNameRequest_builder) Build() *SearchProductsByNameRequest {
+	m0 := &SearchProductsByNameRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Query != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 4)
+		x.xxx_hidden_Query = b.Query
+	}
+	x.xxx_hidden_Filter = b.Filter
+	if b.Limit != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 4)
+		x.xxx_hidden_Limit = *b.Limit
+	}
+	if b.MinScore != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 4)
+		x.xxx_hidden_MinScore = *b.MinScore
+	}
+	return m0
+}
+
+type ScoredProduct struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Product     *Product               `protobuf:"bytes,1,opt,name=product"`
+	xxx_hidden_Score       float64                `protobuf:"fixed64,2,opt,name=score"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ScoredProduct) Reset() {
+	*x = ScoredProduct{}
+	mi := &file_api_product_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScoredProduct) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScoredProduct) ProtoMessage() {}
+
+func (x *ScoredProduct) ProtoReflect() protoreflect.Message {
+	mi := &file_api_product_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ScoredProduct) GetProduct() *Product {
+	if x != nil {
+		return x.xxx_hidden_Product
+	}
+	return nil
+}
+
+func (x *ScoredProduct) GetScore() float64 {
+	if x != nil {
+		return x.xxx_hidden_Score
+	}
+	return 0
+}
+
+func (x *ScoredProduct) SetProduct(v *Product) {
+	x.xxx_hidden_Product = v
+}
+
+func (x *ScoredProduct) SetScore(v float64) {
+	x.xxx_hidden_Score = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *ScoredProduct) HasProduct() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Product != nil
+}
+
+func (x *ScoredProduct) HasScore() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *ScoredProduct) ClearProduct() {
+	x.xxx_hidden_Product = nil
+}
+
+func (x *ScoredProduct) ClearScore() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Score = 0
+}
+
+type ScoredProduct_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Product *Product
+	Score   *float64
+}
+
+func (b0 ScoredProduct_builder) Build() *ScoredProduct {
+	m0 := &ScoredProduct{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Product = b.Product
+	if b.Score != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_Score = *b.Score
+	}
+	return m0
+}
+
+type SearchProductsByNameResponse struct {
+	state               protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Products *[]*ScoredProduct      `protobuf:"bytes,1,rep,name=products"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *SearchProductsByNameResponse) Reset() {
+	*x = SearchProductsByNameResponse{}
+	mi := &file_api_product_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchProductsByNameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchProductsByNameResponse) ProtoMessage() {}
+
+func (x *SearchProductsByNameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_product_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *SearchProductsByNameResponse) GetProducts() []*ScoredProduct {
+	if x != nil {
+		if x.xxx_hidden_Products != nil {
+			return *x.xxx_hidden_Products
+		}
+	}
+	return nil
+}
+
+func (x *SearchProductsByNameResponse) SetProducts(v []*ScoredProduct) {
+	x.xxx_hidden_Products = &v
+}
+
+type SearchProductsByNameResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// best first
+	Products []*ScoredProduct
+}
+
+func (b0 SearchProductsByNameResponse_builder) Build() *SearchProductsByNameResponse {
+	m0 := &SearchProductsByNameResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Products = &b.Products
+	return m0
+}
+
+type BatchItem struct {
+	state              protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Product *Product               `protobuf:"bytes,1,opt,name=product"`
+	xxx_hidden_Error   *ErrorDetail           `protobuf:"bytes,2,opt,name=error"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *BatchItem) Reset() {
+	*x = BatchItem{}
+	mi := &file_api_product_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchItem) ProtoMessage() {}
+
+func (x *BatchItem) ProtoReflect() protoreflect.Message {
+	mi := &file_api_product_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *BatchItem) GetProduct() *Product {
+	if x != nil {
+		return x.xxx_hidden_Product
+	}
+	return nil
+}
+
+func (x *BatchItem) GetError() *ErrorDetail {
+	if x != nil {
+		return x.xxx_hidden_Error
+	}
+	return nil
+}
+
+func (x *BatchItem) SetProduct(v *Product) {
+	x.xxx_hidden_Product = v
+}
+
+func (x *BatchItem) SetError(v *ErrorDetail) {
+	x.xxx_hidden_Error = v
+}
+
+func (x *BatchItem) HasProduct() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Product != nil
+}
+
+func (x *BatchItem) HasError() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Error != nil
+}
+
+func (x *BatchItem) ClearProduct() {
+	x.xxx_hidden_Product = nil
+}
+
+func (x *BatchItem) ClearError() {
+	x.xxx_hidden_Error = nil
+}
+
+type BatchItem_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Product *Product
+	Error   *ErrorDetail
+}
+
+func (b0 BatchItem_builder) Build() *BatchItem {
+	m0 := &BatchItem{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Product = b.Product
+	x.xxx_hidden_Error = b.Error
+	return m0
+}
+
+type BatchResponse struct {
+	state               protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Products *[]*Product            `protobuf:"bytes,1,rep,name=products"`
+	xxx_hidden_Items    *[]*BatchItem          `protobuf:"bytes,2,rep,name=items"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *BatchResponse) Reset() {
+	*x = BatchResponse{}
+	mi := &file_api_product_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchResponse) ProtoMessage() {}
+
+func (x *BatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_product_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *BatchResponse) GetProducts() []*Product {
+	if x != nil {
+		if x.xxx_hidden_Products != nil {
+			return *x.xxx_hidden_Products
+		}
+	}
+	return nil
+}
+
+func (x *BatchResponse) GetItems() []*BatchItem {
+	if x != nil {
+		if x.xxx_hidden_Items != nil {
+			return *x.xxx_hidden_Items
+		}
+	}
+	return nil
+}
+
+func (x *BatchResponse) SetProducts(v []*Product) {
+	x.xxx_hidden_Products = &v
+}
+
+func (x *BatchResponse) SetItems(v []*BatchItem) {
+	x.xxx_hidden_Items = &v
+}
+
+type BatchResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Products []*Product
+	Items    []*BatchItem
+}
+
+func (b0 BatchResponse_builder) Build() *BatchResponse {
+	m0 := &BatchResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Products = &b.Products
+	x.xxx_hidden_Items = &b.Items
+	return m0
+}
+
+type InvalidateCacheRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_ProductId   *string                `protobuf:"bytes,1,opt,name=product_id,json=productId"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *InvalidateCacheRequest) Reset() {
+	*x = InvalidateCacheRequest{}
+	mi := &file_api_product_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvalidateCacheRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvalidateCacheRequest) ProtoMessage() {}
+
+func (x *InvalidateCacheRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_product_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *InvalidateCacheRequest) GetProductId() string {
+	if x != nil {
+		if x.xxx_hidden_ProductId != nil {
+			return *x.xxx_hidden_ProductId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *InvalidateCacheRequest) SetProductId(v string) {
+	x.xxx_hidden_ProductId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+}
+
+func (x *InvalidateCacheRequest) HasProductId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *InvalidateCacheRequest) ClearProductId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_ProductId = nil
+}
+
+type InvalidateCacheRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// empty product_id drops everything
+	ProductId *string
+}
+
+func (b0 InvalidateCacheRequest_builder) Build() *InvalidateCacheRequest {
+	m0 := &InvalidateCacheRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.ProductId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_ProductId = b.ProductId
+	}
+	return m0
+}
+
+type InvalidateCacheResponse struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InvalidateCacheResponse) Reset() {
+	*x = InvalidateCacheResponse{}
+	mi := &file_api_product_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvalidateCacheResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvalidateCacheResponse) ProtoMessage() {}
+
+func (x *InvalidateCacheResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_product_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type InvalidateCacheResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 InvalidateCacheResponse_builder) Build() *InvalidateCacheResponse {
+	m0 := &InvalidateCacheResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type CacheStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CacheStatsRequest) Reset() {
+	*x = CacheStatsRequest{}
+	mi := &file_api_product_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CacheStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CacheStatsRequest) ProtoMessage() {}
+
+func (x *CacheStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_product_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type CacheStatsRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 CacheStatsRequest_builder) Build() *CacheStatsRequest {
+	m0 := &CacheStatsRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type CacheStats struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Name        *string                `protobuf:"bytes,1,opt,name=name"`
+	xxx_hidden_Hits        uint64                 `protobuf:"varint,2,opt,name=hits"`
+	xxx_hidden_Misses      uint64                 `protobuf:"varint,3,opt,name=misses"`
+	xxx_hidden_HitRatio    float64                `protobuf:"fixed64,4,opt,name=hit_ratio,json=hitRatio"`
+	xxx_hidden_Size        int32                  `protobuf:"varint,5,opt,name=size"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *CacheStats) Reset() {
+	*x = CacheStats{}
+	mi := &file_api_product_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CacheStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CacheStats) ProtoMessage() {}
+
+func (x *CacheStats) ProtoReflect() protoreflect.Message {
+	mi := &file_api_product_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CacheStats) GetName() string {
+	if x != nil {
+		if x.xxx_hidden_Name != nil {
+			return *x.xxx_hidden_Name
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *CacheStats) GetHits() uint64 {
+	if x != nil {
+		return x.xxx_hidden_Hits
+	}
+	return 0
+}
+
+func (x *CacheStats) GetMisses() uint64 {
+	if x != nil {
+		return x.xxx_hidden_Misses
+	}
+	return 0
+}
+
+func (x *CacheStats) GetHitRatio() float64 {
+	if x != nil {
+		return x.xxx_hidden_HitRatio
+	}
+	return 0
+}
+
+func (x *CacheStats) GetSize() int32 {
+	if x != nil {
+		return x.xxx_hidden_Size
+	}
+	return 0
+}
+
+func (x *CacheStats) SetName(v string) {
+	x.xxx_hidden_Name = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 5)
+}
+
+func (x *CacheStats) SetHits(v uint64) {
+	x.xxx_hidden_Hits = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 5)
+}
+
+func (x *CacheStats) SetMisses(v uint64) {
+	x.xxx_hidden_Misses = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 5)
+}
+
+func (x *CacheStats) SetHitRatio(v float64) {
+	x.xxx_hidden_HitRatio = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 5)
+}
+
+func (x *CacheStats) SetSize(v int32) {
+	x.xxx_hidden_Size = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 5)
+}
+
+func (x *CacheStats) HasName() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *CacheStats) HasHits() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *CacheStats) HasMisses() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *CacheStats) HasHitRatio() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *CacheStats) HasSize() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *CacheStats) ClearName() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Name = nil
+}
+
+func (x *CacheStats) ClearHits() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Hits = 0
+}
+
+func (x *CacheStats) ClearMisses() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Misses = 0
+}
+
+func (x *CacheStats) ClearHitRatio() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_HitRatio = 0
+}
+
+func (x *CacheStats) ClearSize() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_Size = 0
+}
+
+type CacheStats_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Name     *string
+	Hits     *uint64
+	Misses   *uint64
+	HitRatio *float64
+	Size     *int32
+}
+
+func (b0 CacheStats_builder) Build() *CacheStats {
+	m0 := &CacheStats{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Name != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 5)
+		x.xxx_hidden_Name = b.Name
+	}
+	if b.Hits != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 5)
+		x.xxx_hidden_Hits = *b.Hits
+	}
+	if b.Misses != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 5)
+		x.xxx_hidden_Misses = *b.Misses
+	}
+	if b.HitRatio != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 5)
+		x.xxx_hidden_HitRatio = *b.HitRatio
+	}
+	if b.Size != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 5)
+		x.xxx_hidden_Size = *b.Size
+	}
+	return m0
+}
+
+type CacheStatsResponse struct {
+	state             protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Caches *[]*CacheStats         `protobuf:"bytes,1,rep,name=caches"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CacheStatsResponse) Reset() {
+	*x = CacheStatsResponse{}
+	mi := &file_api_product_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CacheStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CacheStatsResponse) ProtoMessage() {}
+
+func (x *CacheStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_product_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CacheStatsResponse) GetCaches() []*CacheStats {
+	if x != nil {
+		if x.xxx_hidden_Caches != nil {
+			return *x.xxx_hidden_Caches
+		}
+	}
+	return nil
+}
+
+func (x *CacheStatsResponse) SetCaches(v []*CacheStats) {
+	x.xxx_hidden_Caches = &v
+}
+
+type CacheStatsResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Caches []*CacheStats
+}
+
+func (b0 CacheStatsResponse_builder) Build() *CacheStatsResponse {
+	m0 := &CacheStatsResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Caches = &b.Caches
+	return m0
+}
+
+type ProductGroupNode struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_Group       ProductGroup           `protobuf:"varint,2,opt,name=group,enum=products.ProductGroup"`
+	xxx_hidden_Name        *string                `protobuf:"bytes,3,opt,name=name"`
+	xxx_hidden_Children    *[]*ProductGroupNode   `protobuf:"bytes,4,rep,name=children"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ProductGroupNode) Reset() {
+	*x = ProductGroupNode{}
+	mi := &file_api_product_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductGroupNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductGroupNode) ProtoMessage() {}
+
+func (x *ProductGroupNode) ProtoReflect() protoreflect.Message {
+	mi := &file_api_product_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ProductGroupNode) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ProductGroupNode) GetGroup() ProductGroup {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 1) {
+			return x.xxx_hidden_Group
+		}
+	}
+	return ProductGroup_PRODUCT_GROUP_UNSPECIFIED
+}
+
+func (x *ProductGroupNode) GetName() string {
+	if x != nil {
+		if x.xxx_hidden_Name != nil {
+			return *x.xxx_hidden_Name
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ProductGroupNode) GetChildren() []*ProductGroupNode {
+	if x != nil {
+		if x.xxx_hidden_Children != nil {
+			return *x.xxx_hidden_Children
+		}
+	}
+	return nil
+}
+
+func (x *ProductGroupNode) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 4)
+}
+
+func (x *ProductGroupNode) SetGroup(v ProductGroup) {
+	x.xxx_hidden_Group = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 4)
+}
+
+func (x *ProductGroupNode) SetName(v string) {
+	x.xxx_hidden_Name = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 4)
+}
+
+func (x *ProductGroupNode) SetChildren(v []*ProductGroupNode) {
+	x.xxx_hidden_Children = &v
+}
+
+func (x *ProductGroupNode) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *ProductGroupNode) HasGroup() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *ProductGroupNode) HasName() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *ProductGroupNode) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+func (x *ProductGroupNode) ClearGroup() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Group = ProductGroup_PRODUCT_GROUP_UNSPECIFIED
+}
+
+func (x *ProductGroupNode) ClearName() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Name = nil
+}
+
+type ProductGroupNode_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id *string
+	// UNSPECIFIED for groups the enum doesn't know yet
+	Group    *ProductGroup
+	Name     *string
+	Children []*ProductGroupNode
+}
+
+func (b0 ProductGroupNode_builder) Build() *ProductGroupNode {
+	m0 := &ProductGroupNode{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 4)
+		x.xxx_hidden_Id = b.Id
+	}
+	if b.Group != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 4)
+		x.xxx_hidden_Group = *b.Group
+	}
+	if b.Name != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 4)
+		x.xxx_hidden_Name = b.Name
+	}
+	x.xxx_hidden_Children = &b.Children
+	return m0
+}
+
+type GroupTreeRequest struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupTreeRequest) Reset() {
+	*x = GroupTreeRequest{}
+	mi := &file_api_product_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupTreeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupTreeRequest) ProtoMessage() {}
+
+func (x *GroupTreeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_product_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type GroupTreeRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 GroupTreeRequest_builder) Build() *GroupTreeRequest {
+	m0 := &GroupTreeRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type GroupTreeResponse struct {
+	state            protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Roots *[]*ProductGroupNode   `protobuf:"bytes,1,rep,name=roots"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GroupTreeResponse) Reset() {
+	*x = GroupTreeResponse{}
+	mi := &file_api_product_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupTreeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupTreeResponse) ProtoMessage() {}
+
+func (x *GroupTreeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_product_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GroupTreeResponse) GetRoots() []*ProductGroupNode {
+	if x != nil {
+		if x.xxx_hidden_Roots != nil {
+			return *x.xxx_hidden_Roots
+		}
+	}
+	return nil
+}
+
+func (x *GroupTreeResponse) SetRoots(v []*ProductGroupNode) {
+	x.xxx_hidden_Roots = &v
+}
+
+type GroupTreeResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Roots []*ProductGroupNode
+}
+
+func (b0 GroupTreeResponse_builder) Build() *GroupTreeResponse {
+	m0 := &GroupTreeResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Roots = &b.Roots
+	return m0
+}
+
+type GroupRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Group       ProductGroup           `protobuf:"varint,1,opt,name=group,enum=products.ProductGroup"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *GroupRequest) Reset() {
+	*x = GroupRequest{}
+	mi := &file_api_product_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupRequest) ProtoMessage() {}
+
+func (x *GroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_product_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GroupRequest) GetGroup() ProductGroup {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 0) {
+			return x.xxx_hidden_Group
+		}
+	}
+	return ProductGroup_PRODUCT_GROUP_UNSPECIFIED
+}
+
+func (x *GroupRequest) SetGroup(v ProductGroup) {
+	x.xxx_hidden_Group = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+}
+
+func (x *GroupRequest) HasGroup() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *GroupRequest) ClearGroup() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Group = ProductGroup_PRODUCT_GROUP_UNSPECIFIED
+}
+
+type GroupRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Group *ProductGroup
+}
+
+func (b0 GroupRequest_builder) Build() *GroupRequest {
+	m0 := &GroupRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Group != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_Group = *b.Group
+	}
+	return m0
+}
+
+type GroupListResponse struct {
+	state             protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Groups *[]*ProductGroupNode   `protobuf:"bytes,1,rep,name=groups"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GroupListResponse) Reset() {
+	*x = GroupListResponse{}
+	mi := &file_api_product_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupListResponse) ProtoMessage() {}
+
+func (x *GroupListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_product_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GroupListResponse) GetGroups() []*ProductGroupNode {
+	if x != nil {
+		if x.xxx_hidden_Groups != nil {
+			return *x.xxx_hidden_Groups
+		}
+	}
+	return nil
+}
+
+func (x *GroupListResponse) SetGroups(v []*ProductGroupNode) {
+	x.xxx_hidden_Groups = &v
+}
+
+type GroupListResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Groups []*ProductGroupNode
+}
+
+func (b0 GroupListResponse_builder) Build() *GroupListResponse {
+	m0 := &GroupListResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Groups = &b.Groups
+	return m0
+}
+
+type SeriesRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_SeriesId    *string                `protobuf:"bytes,1,opt,name=series_id,json=seriesId"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *SeriesRequest) Reset() {
+	*x = SeriesRequest{}
+	mi := &file_api_product_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeriesRequest) ProtoMessage() {}
+
+func (x *SeriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_product_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *SeriesRequest) GetSeriesId() string {
+	if x != nil {
+		if x.xxx_hidden_SeriesId != nil {
+			return *x.xxx_hidden_SeriesId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *SeriesRequest) SetSeriesId(v string) {
+	x.xxx_hidden_SeriesId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+}
+
+func (x *SeriesRequest) HasSeriesId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *SeriesRequest) ClearSeriesId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_SeriesId = nil
+}
+
+type SeriesRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	SeriesId *string
+}
+
+func (b0 SeriesRequest_builder) Build() *SeriesRequest {
+	m0 := &SeriesRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.SeriesId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_SeriesId = b.SeriesId
+	}
+	return m0
+}
+
+type SeriesAsset struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Product     *Product               `protobuf:"bytes,1,opt,name=product"`
+	xxx_hidden_Amount      int32                  `protobuf:"varint,2,opt,name=amount"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *SeriesAsset) Reset() {
+	*x = SeriesAsset{}
+	mi := &file_api_product_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeriesAsset) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeriesAsset) ProtoMessage() {}
+
+func (x *SeriesAsset) ProtoReflect() protoreflect.Message {
+	mi := &file_api_product_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *SeriesAsset) GetProduct() *Product {
+	if x != nil {
+		return x.xxx_hidden_Product
+	}
+	return nil
+}
+
+func (x *SeriesAsset) GetAmount() int32 {
+	if x != nil {
+		return x.xxx_hidden_Amount
+	}
+	return 0
+}
+
+func (x *SeriesAsset) SetProduct(v *Product) {
+	x.xxx_hidden_Product = v
+}
+
+func (x *SeriesAsset) SetAmount(v int32) {
+	x.xxx_hidden_Amount = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *SeriesAsset) HasProduct() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Product != nil
+}
+
+func (x *SeriesAsset) HasAmount() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *SeriesAsset) ClearProduct() {
+	x.xxx_hidden_Product = nil
+}
+
+func (x *SeriesAsset) ClearAmount() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Amount = 0
+}
+
+type SeriesAsset_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Product *Product
+	Amount  *int32
+}
+
+func (b0 SeriesAsset_builder) Build() *SeriesAsset {
+	m0 := &SeriesAsset{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Product = b.Product
+	if b.Amount != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_Amount = *b.Amount
+	}
+	return m0
+}
+
+type SeriesProduct struct {
+	state              protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Product *Product               `protobuf:"bytes,1,opt,name=product"`
+	xxx_hidden_Assets  *[]*SeriesAsset        `protobuf:"bytes,2,rep,name=assets"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *SeriesProduct) Reset() {
+	*x = SeriesProduct{}
+	mi := &file_api_product_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeriesProduct) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeriesProduct) ProtoMessage() {}
+
+func (x *SeriesProduct) ProtoReflect() protoreflect.Message {
+	mi := &file_api_product_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *SeriesProduct) GetProduct() *Product {
+	if x != nil {
+		return x.xxx_hidden_Product
+	}
+	return nil
+}
+
+func (x *SeriesProduct) GetAssets() []*SeriesAsset {
+	if x != nil {
+		if x.xxx_hidden_Assets != nil {
+			return *x.xxx_hidden_Assets
+		}
+	}
+	return nil
+}
+
+func (x *SeriesProduct) SetProduct(v *Product) {
+	x.xxx_hidden_Product = v
+}
+
+func (x *SeriesProduct) SetAssets(v []*SeriesAsset) {
+	x.xxx_hidden_Assets = &v
+}
+
+func (x *SeriesProduct) HasProduct() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Product != nil
+}
+
+func (x *SeriesProduct) ClearProduct() {
+	x.xxx_hidden_Product = nil
+}
+
+type SeriesProduct_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Product *Product
+	// empty when the sku ships as itself
+	Assets []*SeriesAsset
+}
+
+func (b0 SeriesProduct_builder) Build() *SeriesProduct {
+	m0 := &SeriesProduct{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Product = b.Product
+	x.xxx_hidden_Assets = &b.Assets
+	return m0
+}
+
+type ListSeriesProductsResponse struct {
+	state               protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Products *[]*SeriesProduct      `protobuf:"bytes,1,rep,name=products"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ListSeriesProductsResponse) Reset() {
+	*x = ListSeriesProductsResponse{}
+	mi := &file_api_product_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSeriesProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSeriesProductsResponse) ProtoMessage() {}
+
+func (x *ListSeriesProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_product_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListSeriesProductsResponse) GetProducts() []*SeriesProduct {
+	if x != nil {
+		if x.xxx_hidden_Products != nil {
+			return *x.xxx_hidden_Products
+		}
+	}
+	return nil
+}
+
+func (x *ListSeriesProductsResponse) SetProducts(v []*SeriesProduct) {
+	x.xxx_hidden_Products = &v
+}
+
+type ListSeriesProductsResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Products []*SeriesProduct
+}
+
+func (b0 ListSeriesProductsResponse_builder) Build() *ListSeriesProductsResponse {
+	m0 := &ListSeriesProductsResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Products = &b.Products
+	return m0
+}
+
+type SeriesSummary struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_SeriesId    *string                `protobuf:"bytes,1,opt,name=series_id,json=seriesId"`
+	xxx_hidden_Products    int32                  `protobuf:"varint,2,opt,name=products"`
+	xxx_hidden_Packages    int32                  `protobuf:"varint,3,opt,name=packages"`
+	xxx_hidden_Volume      float64                `protobuf:"fixed64,4,opt,name=volume"`
+	xxx_hidden_Weight      float64                `protobuf:"fixed64,5,opt,name=weight"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *SeriesSummary) Reset() {
+	*x = SeriesSummary{}
+	mi := &file_api_product_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeriesSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeriesSummary) ProtoMessage() {}
+
+func (x *SeriesSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_api_product_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *SeriesSummary) GetSeriesId() string {
+	if x != nil {
+		if x.xxx_hidden_SeriesId != nil {
+			return *x.xxx_hidden_SeriesId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *SeriesSummary) GetProducts() int32 {
+	if x != nil {
+		return x.xxx_hidden_Products
+	}
+	return 0
+}
+
+func (x *SeriesSummary) GetPackages() int32 {
+	if x != nil {
+		return x.xxx_hidden_Packages
+	}
+	return 0
+}
+
+func (x *SeriesSummary) GetVolume() float64 {
+	if x != nil {
+		return x.xxx_hidden_Volume
+	}
+	return 0
+}
+
+func (x *SeriesSummary) GetWeight() float64 {
+	if x != nil {
+		return x.xxx_hidden_Weight
+	}
+	return 0
+}
+
+func (x *SeriesSummary) SetSeriesId(v string) {
+	x.xxx_hidden_SeriesId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 5)
+}
+
+func (x *SeriesSummary) SetProducts(v int32) {
+	x.xxx_hidden_Products = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 5)
+}
+
+func (x *SeriesSummary) SetPackages(v int32) {
+	x.xxx_hidden_Packages = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 5)
+}
+
+func (x *SeriesSummary) SetVolume(v float64) {
+	x.xxx_hidden_Volume = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 5)
+}
+
+func (x *SeriesSummary) SetWeight(v float64) {
+	x.xxx_hidden_Weight = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 5)
+}
+
+func (x *SeriesSummary) HasSeriesId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *SeriesSummary) HasProducts() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *SeriesSummary) HasPackages() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *SeriesSummary) HasVolume() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *SeriesSummary) HasWeight() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *SeriesSummary) ClearSeriesId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_SeriesId = nil
+}
+
+func (x *SeriesSummary) ClearProducts() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Products = 0
+}
+
+func (x *SeriesSummary) ClearPackages() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Packages = 0
+}
+
+func (x *SeriesSummary) ClearVolume() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Volume = 0
+}
+
+func (x *SeriesSummary) ClearWeight() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_Weight = 0
+}
+
+type SeriesSummary_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	SeriesId *string
+	Products *int32
+	Packages *int32
+	Volume   *float64
+	Weight   *float64
+}
+
+func (b0 SeriesSummary_builder) Build() *SeriesSummary {
+	m0 := &SeriesSummary{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.SeriesId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 5)
+		x.xxx_hidden_SeriesId = b.SeriesId
+	}
+	if b.Products != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 5)
+		x.xxx_hidden_Products = *b.Products
+	}
+	if b.Packages != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 5)
+		x.xxx_hidden_Packages = *b.Packages
+	}
+	if b.Volume != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 5)
+		x.xxx_hidden_Volume = *b.Volume
+	}
+	if b.Weight != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 5)
+		x.xxx_hidden_Weight = *b.Weight
+	}
+	return m0
+}
+
+type WatchProductsRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Cursor      *string                `protobuf:"bytes,1,opt,name=cursor"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *WatchProductsRequest) Reset() {
+	*x = WatchProductsRequest{}
+	mi := &file_api_product_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchProductsRequest) ProtoMessage() {}
+
+func (x *WatchProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_product_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *WatchProductsRequest) GetCursor() string {
+	if x != nil {
+		if x.xxx_hidden_Cursor != nil {
+			return *x.xxx_hidden_Cursor
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *WatchProductsRequest) SetCursor(v string) {
+	x.xxx_hidden_Cursor = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+}
+
+func (x *WatchProductsRequest) HasCursor() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *WatchProductsRequest) ClearCursor() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Cursor = nil
+}
+
+type WatchProductsRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// empty cursor streams only changes made after the call
+	Cursor *string
+}
+
+func (b0 WatchProductsRequest_builder) Build() *WatchProductsRequest {
+	m0 := &WatchProductsRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Cursor != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_Cursor = b.Cursor
+	}
+	return m0
+}
+
+type ProductDelta struct {
+	state                    protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Product       *Product               `protobuf:"bytes,1,opt,name=product"`
+	xxx_hidden_ChangedFields []string               `protobuf:"bytes,2,rep,name=changed_fields,json=changedFields"`
+	xxx_hidden_UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt"`
+	xxx_hidden_Cursor        *string                `protobuf:"bytes,4,opt,name=cursor"`
+	XXX_raceDetectHookData   protoimpl.RaceDetectHookData
+	XXX_presence             [1]uint32
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *ProductDelta) Reset() {
+	*x = ProductDelta{}
+	mi := &file_api_product_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductDelta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductDelta) ProtoMessage() {}
+
+func (x *ProductDelta) ProtoReflect() protoreflect.Message {
+	mi := &file_api_product_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ProductDelta) GetProduct() *Product {
+	if x != nil {
+		return x.xxx_hidden_Product
+	}
+	return nil
+}
+
+func (x *ProductDelta) GetChangedFields() []string {
+	if x != nil {
+		return x.xxx_hidden_ChangedFields
+	}
+	return nil
+}
+
+func (x *ProductDelta) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_UpdatedAt
+	}
+	return nil
+}
+
+func (x *ProductDelta) GetCursor() string {
+	if x != nil {
+		if x.xxx_hidden_Cursor != nil {
+			return *x.xxx_hidden_Cursor
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ProductDelta) SetProduct(v *Product) {
+	x.xxx_hidden_Product = v
+}
+
+func (x *ProductDelta) SetChangedFields(v []string) {
+	x.xxx_hidden_ChangedFields = v
+}
+
+func (x *ProductDelta) SetUpdatedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_UpdatedAt = v
+}
+
+func (x *ProductDelta) SetCursor(v string) {
+	x.xxx_hidden_Cursor = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 4)
+}
+
+func (x *ProductDelta) HasProduct() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Product != nil
+}
+
+func (x *ProductDelta) HasUpdatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_UpdatedAt != nil
+}
+
+func (x *ProductDelta) HasCursor() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *ProductDelta) ClearProduct() {
+	x.xxx_hidden_Product = nil
+}
+
+func (x *ProductDelta) ClearUpdatedAt() {
+	x.xxx_hidden_UpdatedAt = nil
+}
+
+func (x *ProductDelta) ClearCursor() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Cursor = nil
+}
+
+type ProductDelta_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Product *Product
+	// empty the first time a product is sent on the stream
+	ChangedFields []string
+	UpdatedAt     *timestamppb.Timestamp
+	Cursor        *string
+}
+
+func (b0 ProductDelta_builder) Build() *ProductDelta {
+	m0 := &ProductDelta{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Product = b.Product
+	x.xxx_hidden_ChangedFields = b.ChangedFields
+	x.xxx_hidden_UpdatedAt = b.UpdatedAt
+	if b.Cursor != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 4)
+		x.xxx_hidden_Cursor = b.Cursor
+	}
+	return m0
+}
+
+type ReplacementsResponse struct {
+	state                   protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Product      *Product               `protobuf:"bytes,1,opt,name=product"`
+	xxx_hidden_Replacements *[]*Product            `protobuf:"bytes,2,rep,name=replacements"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *ReplacementsResponse) Reset() {
+	*x = ReplacementsResponse{}
+	mi := &file_api_product_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplacementsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplacementsResponse) ProtoMessage() {}
+
+func (x *ReplacementsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_product_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ReplacementsResponse) GetProduct() *Product {
+	if x != nil {
+		return x.xxx_hidden_Product
+	}
+	return nil
+}
+
+func (x *ReplacementsResponse) GetReplacements() []*Product {
+	if x != nil {
+		if x.xxx_hidden_Replacements != nil {
+			return *x.xxx_hidden_Replacements
+		}
+	}
+	return nil
+}
+
+func (x *ReplacementsResponse) SetProduct(v *Product) {
+	x.xxx_hidden_Product = v
+}
+
+func (x *ReplacementsResponse) SetReplacements(v []*Product) {
+	x.xxx_hidden_Replacements = &v
+}
+
+func (x *ReplacementsResponse) HasProduct() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Product != nil
+}
+
+func (x *ReplacementsResponse) ClearProduct() {
+	x.xxx_hidden_Product = nil
+}
+
+type ReplacementsResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Product *Product
+	// active products to use instead, best first
+	Replacements []*Product
+}
+
+func (b0 ReplacementsResponse_builder) Build() *ReplacementsResponse {
+	m0 := &ReplacementsResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Product = b.Product
+	x.xxx_hidden_Replacements = &b.Replacements
+	return m0
+}
+
+type QualityReportRequest struct {
+	state                 protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Categories []QualityIssueCategory `protobuf:"varint,1,rep,packed,name=categories,enum=products.QualityIssueCategory"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *QualityReportRequest) Reset() {
+	*x = QualityReportRequest{}
+	mi := &file_api_product_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QualityReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QualityReportRequest) ProtoMessage() {}
+
+func (x *QualityReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_product_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *QualityReportRequest) GetCategories() []QualityIssueCategory {
+	if x != nil {
+		return x.xxx_hidden_Categories
+	}
+	return nil
+}
+
+func (x *QualityReportRequest) SetCategories(v []QualityIssueCategory) {
+	x.xxx_hidden_Categories = v
+}
+
+type QualityReportRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// empty reports every category
+	Categories []QualityIssueCategory
+}
+
+func (b0 QualityReportRequest_builder) Build() *QualityReportRequest {
+	m0 := &QualityReportRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Categories = b.Categories
+	return m0
+}
+
+type QualityIssue struct {
+	state                    protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Category      QualityIssueCategory   `protobuf:"varint,1,opt,name=category,enum=products.QualityIssueCategory"`
+	xxx_hidden_ProductId     *string                `protobuf:"bytes,2,opt,name=product_id,json=productId"`
+	xxx_hidden_Fnrec         *string                `protobuf:"bytes,3,opt,name=fnrec"`
+	xxx_hidden_IntegrationId *string                `protobuf:"bytes,4,opt,name=integration_id,json=integrationId"`
+	xxx_hidden_Name          *string                `protobuf:"bytes,5,opt,name=name"`
+	xxx_hidden_Detail        *string                `protobuf:"bytes,6,opt,name=detail"`
+	XXX_raceDetectHookData   protoimpl.RaceDetectHookData
+	XXX_presence             [1]uint32
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *QualityIssue) Reset() {
+	*x = QualityIssue{}
+	mi := &file_api_product_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QualityIssue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QualityIssue) ProtoMessage() {}
+
+func (x *QualityIssue) ProtoReflect() protoreflect.Message {
+	mi := &file_api_product_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *QualityIssue) GetCategory() QualityIssueCategory {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 0) {
+			return x.xxx_hidden_Category
+		}
+	}
+	return QualityIssueCategory_QUALITY_ISSUE_CATEGORY_UNSPECIFIED
+}
+
+func (x *QualityIssue) GetProductId() string {
+	if x != nil {
+		if x.xxx_hidden_ProductId != nil {
+			return *x.xxx_hidden_ProductId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *QualityIssue) GetFnrec() string {
+	if x != nil {
+		if x.xxx_hidden_Fnrec != nil {
+			return *x.xxx_hidden_Fnrec
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *QualityIssue) GetIntegrationId() string {
+	if x != nil {
+		if x.xxx_hidden_IntegrationId != nil {
+			return *x.xxx_hidden_IntegrationId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *QualityIssue) GetName() string {
+	if x != nil {
+		if x.xxx_hidden_Name != nil {
+			return *x.xxx_hidden_Name
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *QualityIssue) GetDetail() string {
+	if x != nil {
+		if x.xxx_hidden_Detail != nil {
+			return *x.xxx_hidden_Detail
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *QualityIssue) SetCategory(v QualityIssueCategory) {
+	x.xxx_hidden_Category = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 6)
+}
+
+func (x *QualityIssue) SetProductId(v string) {
+	x.xxx_hidden_ProductId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 6)
+}
+
+func (x *QualityIssue) SetFnrec(v string) {
+	x.xxx_hidden_Fnrec = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 6)
+}
+
+func (x *QualityIssue) SetIntegrationId(v string) {
+	x.xxx_hidden_IntegrationId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 6)
+}
+
+func (x *QualityIssue) SetName(v string) {
+	x.xxx_hidden_Name = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 6)
+}
+
+func (x *QualityIssue) SetDetail(v string) {
+	x.xxx_hidden_Detail = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 6)
+}
+
+func (x *QualityIssue) HasCategory() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *QualityIssue) HasProductId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *QualityIssue) HasFnrec() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *QualityIssue) HasIntegrationId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *QualityIssue) HasName() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *QualityIssue) HasDetail() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+}
+
+func (x *QualityIssue) ClearCategory() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Category = QualityIssueCategory_QUALITY_ISSUE_CATEGORY_UNSPECIFIED
+}
+
+func (x *QualityIssue) ClearProductId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_ProductId = nil
+}
+
+func (x *QualityIssue) ClearFnrec() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Fnrec = nil
+}
+
+func (x *QualityIssue) ClearIntegrationId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_IntegrationId = nil
+}
+
+func (x *QualityIssue) ClearName() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_Name = nil
+}
+
+func (x *QualityIssue) ClearDetail() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_Detail = nil
+}
+
+type QualityIssue_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Category      *QualityIssueCategory
+	ProductId     *string
+	Fnrec         *string
+	IntegrationId *string
+	Name          *string
+	Detail        *string
+}
+
+func (b0 QualityIssue_builder) Build() *QualityIssue {
+	m0 := &QualityIssue{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Category != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 6)
+		x.xxx_hidden_Category = *b.Category
+	}
+	if b.ProductId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 6)
+		x.xxx_hidden_ProductId = b.ProductId
+	}
+	if b.Fnrec != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 6)
+		x.xxx_hidden_Fnrec = b.Fnrec
+	}
+	if b.IntegrationId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 6)
+		x.xxx_hidden_IntegrationId = b.IntegrationId
+	}
+	if b.Name != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 6)
+		x.xxx_hidden_Name = b.Name
+	}
+	if b.Detail != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 6)
+		x.xxx_hidden_Detail = b.Detail
+	}
 	return m0
 }
 
-type BatchResponse struct {
-	state               protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Products *[]*Product            `protobuf:"bytes,1,rep,name=products"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+type QualityCategoryCount struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Category    QualityIssueCategory   `protobuf:"varint,1,opt,name=category,enum=products.QualityIssueCategory"`
+	xxx_hidden_Count       int32                  `protobuf:"varint,2,opt,name=count"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
-func (x *BatchResponse) Reset() {
-	*x = BatchResponse{}
-	mi := &file_api_product_proto_msgTypes[4]
+func (x *QualityCategoryCount) Reset() {
+	*x = QualityCategoryCount{}
+	mi := &file_api_product_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BatchResponse) String() string {
+func (x *QualityCategoryCount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BatchResponse) ProtoMessage() {}
+func (*QualityCategoryCount) ProtoMessage() {}
 
-func (x *BatchResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_product_proto_msgTypes[4]
+func (x *QualityCategoryCount) ProtoReflect() protoreflect.Message {
+	mi := &file_api_product_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1119,30 +4767,184 @@ func (x *BatchResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *BatchResponse) GetProducts() []*Product {
+func (x *QualityCategoryCount) GetCategory() QualityIssueCategory {
 	if x != nil {
-		if x.xxx_hidden_Products != nil {
-			return *x.xxx_hidden_Products
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 0) {
+			return x.xxx_hidden_Category
+		}
+	}
+	return QualityIssueCategory_QUALITY_ISSUE_CATEGORY_UNSPECIFIED
+}
+
+func (x *QualityCategoryCount) GetCount() int32 {
+	if x != nil {
+		return x.xxx_hidden_Count
+	}
+	return 0
+}
+
+func (x *QualityCategoryCount) SetCategory(v QualityIssueCategory) {
+	x.xxx_hidden_Category = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *QualityCategoryCount) SetCount(v int32) {
+	x.xxx_hidden_Count = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *QualityCategoryCount) HasCategory() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *QualityCategoryCount) HasCount() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *QualityCategoryCount) ClearCategory() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Category = QualityIssueCategory_QUALITY_ISSUE_CATEGORY_UNSPECIFIED
+}
+
+func (x *QualityCategoryCount) ClearCount() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Count = 0
+}
+
+type QualityCategoryCount_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Category *QualityIssueCategory
+	Count    *int32
+}
+
+func (b0 QualityCategoryCount_builder) Build() *QualityCategoryCount {
+	m0 := &QualityCategoryCount{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Category != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_Category = *b.Category
+	}
+	if b.Count != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_Count = *b.Count
+	}
+	return m0
+}
+
+// A report is streamed in parts of at most a thousand issues. Only the first
+// part has scanned and counts set.
+type QualityReport struct {
+	state                  protoimpl.MessageState   `protogen:"opaque.v1"`
+	xxx_hidden_Scanned     int32                    `protobuf:"varint,1,opt,name=scanned"`
+	xxx_hidden_Counts      *[]*QualityCategoryCount `protobuf:"bytes,2,rep,name=counts"`
+	xxx_hidden_Issues      *[]*QualityIssue         `protobuf:"bytes,3,rep,name=issues"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *QualityReport) Reset() {
+	*x = QualityReport{}
+	mi := &file_api_product_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QualityReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QualityReport) ProtoMessage() {}
+
+func (x *QualityReport) ProtoReflect() protoreflect.Message {
+	mi := &file_api_product_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *QualityReport) GetScanned() int32 {
+	if x != nil {
+		return x.xxx_hidden_Scanned
+	}
+	return 0
+}
+
+func (x *QualityReport) GetCounts() []*QualityCategoryCount {
+	if x != nil {
+		if x.xxx_hidden_Counts != nil {
+			return *x.xxx_hidden_Counts
 		}
 	}
 	return nil
 }
 
-func (x *BatchResponse) SetProducts(v []*Product) {
-	x.xxx_hidden_Products = &v
+func (x *QualityReport) GetIssues() []*QualityIssue {
+	if x != nil {
+		if x.xxx_hidden_Issues != nil {
+			return *x.xxx_hidden_Issues
+		}
+	}
+	return nil
 }
 
-type BatchResponse_builder struct {
+func (x *QualityReport) SetScanned(v int32) {
+	x.xxx_hidden_Scanned = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
+}
+
+func (x *QualityReport) SetCounts(v []*QualityCategoryCount) {
+	x.xxx_hidden_Counts = &v
+}
+
+func (x *QualityReport) SetIssues(v []*QualityIssue) {
+	x.xxx_hidden_Issues = &v
+}
+
+func (x *QualityReport) HasScanned() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *QualityReport) ClearScanned() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Scanned = 0
+}
+
+type QualityReport_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Products []*Product
+	Scanned *int32
+	Counts  []*QualityCategoryCount
+	Issues  []*QualityIssue
 }
 
-func (b0 BatchResponse_builder) Build() *BatchResponse {
-	m0 := &BatchResponse{}
+func (b0 QualityReport_builder) Build() *QualityReport {
+	m0 := &QualityReport{}
 	b, x := &b0, m0
 	_, _ = b, x
-	x.xxx_hidden_Products = &b.Products
+	if b.Scanned != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
+		x.xxx_hidden_Scanned = *b.Scanned
+	}
+	x.xxx_hidden_Counts = &b.Counts
+	x.xxx_hidden_Issues = &b.Issues
 	return m0
 }
 
@@ -1150,18 +4952,25 @@ var File_api_product_proto protoreflect.FileDescriptor
 
 const file_api_product_proto_rawDesc = "" +
 	"\n" +
-	"\x11api/product.proto\x12\bproducts\x1a!google/protobuf/go_features.proto\x1a\x10api/errors.proto\"M\n" +
-	"\x0eProductRequest\x12%\n" +
-	"\x0eintegration_id\x18\x01 \x01(\tR\rintegrationId\x12\x14\n" +
-	"\x05fnrec\x18\x02 \x01(\tR\x05fnrec\"K\n" +
+	"\x11api/product.proto\x12\bproducts\x1a!google/protobuf/go_features.proto\x1a\x10api/errors.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"q\n" +
+	"\x0eProductRequest\x12'\n" +
+	"\x0eintegration_id\x18\x01 \x01(\tH\x00R\rintegrationId\x12\x16\n" +
+	"\x05fnrec\x18\x02 \x01(\tH\x00R\x05fnrec\x12\x10\n" +
+	"\x02id\x18\x03 \x01(\tH\x00R\x02idB\f\n" +
+	"\n" +
+	"identifier\"\x9e\x01\n" +
 	"\x13BatchProductRequest\x124\n" +
-	"\brequests\x18\x01 \x03(\v2\x18.products.ProductRequestR\brequests\"|\n" +
+	"\brequests\x18\x01 \x03(\v2\x18.products.ProductRequestR\brequests\x12\x18\n" +
+	"\apartial\x18\x02 \x01(\bR\apartial\x127\n" +
+	"\n" +
+	"duplicates\x18\x03 \x01(\x0e2\x17.products.DuplicateModeR\n" +
+	"duplicates\"|\n" +
 	"\x04Pack\x12\x16\n" +
 	"\x06volume\x18\x01 \x01(\x01R\x06volume\x12\x16\n" +
 	"\x06length\x18\x02 \x01(\x01R\x06length\x12\x14\n" +
 	"\x05width\x18\x03 \x01(\x01R\x05width\x12\x16\n" +
 	"\x06height\x18\x04 \x01(\x01R\x06height\x12\x16\n" +
-	"\x06weight\x18\x05 \x01(\x01R\x06weight\"\x99\x03\n" +
+	"\x06weight\x18\x05 \x01(\x01R\x06weight\"\xa5\x04\n" +
 	"\aProduct\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12A\n" +
@@ -1176,11 +4985,143 @@ const file_api_product_proto_rawDesc = "" +
 	"\x10account_provider\x18\t \x01(\tR\x0faccountProvider\x127\n" +
 	"\x18non_standard_category_id\x18\n" +
 	" \x01(\tR\x15nonStandardCategoryId\x12\"\n" +
-	"\x04pack\x18\v \x01(\v2\x0e.products.PackR\x04pack\">\n" +
+	"\x04pack\x18\v \x01(\v2\x0e.products.PackR\x04pack\x12)\n" +
+	"\x04type\x18\f \x01(\x0e2\x15.products.ProductTypeR\x04type\x12\x1d\n" +
+	"\n" +
+	"is_archive\x18\r \x01(\bR\tisArchive\x12%\n" +
+	"\x0eintegration_id\x18\x0e \x01(\tR\rintegrationId\x12\x19\n" +
+	"\bcount_ma\x18\x0f \x01(\x05R\acountMa\"\x9b\x03\n" +
+	"\x15SearchProductsRequest\x12,\n" +
+	"\x05group\x18\x01 \x01(\x0e2\x16.products.ProductGroupR\x05group\x12\x1b\n" +
+	"\tseries_id\x18\x02 \x01(\tR\bseriesId\x12\x1f\n" +
+	"\vcategory_id\x18\x03 \x01(\tR\n" +
+	"categoryId\x12A\n" +
+	"\x0fproduction_type\x18\x04 \x01(\x0e2\x18.products.ProductionTypeR\x0eproductionType\x12\x1d\n" +
+	"\n" +
+	"is_service\x18\x05 \x01(\bR\tisService\x12\x1d\n" +
+	"\n" +
+	"is_archive\x18\x06 \x01(\bR\tisArchive\x12\x12\n" +
+	"\x04name\x18\a \x01(\tR\x04name\x12\x1f\n" +
+	"\vname_prefix\x18\b \x01(\bR\n" +
+	"namePrefix\x12\x1b\n" +
+	"\tpage_size\x18\t \x01(\x05R\bpageSize\x12\x16\n" +
+	"\x06cursor\x18\n" +
+	" \x01(\tR\x06cursor\x12+\n" +
+	"\x11include_subgroups\x18\v \x01(\bR\x10includeSubgroups\"h\n" +
+	"\x16SearchProductsResponse\x12-\n" +
+	"\bproducts\x18\x01 \x03(\v2\x11.products.ProductR\bproducts\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"\xde\x02\n" +
+	"\rProductFilter\x12,\n" +
+	"\x05group\x18\x01 \x01(\x0e2\x16.products.ProductGroupR\x05group\x12\x1b\n" +
+	"\tseries_id\x18\x02 \x01(\tR\bseriesId\x12\x1f\n" +
+	"\vcategory_id\x18\x03 \x01(\tR\n" +
+	"categoryId\x12A\n" +
+	"\x0fproduction_type\x18\x04 \x01(\x0e2\x18.products.ProductionTypeR\x0eproductionType\x12\x1d\n" +
+	"\n" +
+	"is_service\x18\x05 \x01(\bR\tisService\x12\x1d\n" +
+	"\n" +
+	"is_archive\x18\x06 \x01(\bR\tisArchive\x12\x12\n" +
+	"\x04name\x18\a \x01(\tR\x04name\x12\x1f\n" +
+	"\vname_prefix\x18\b \x01(\bR\n" +
+	"namePrefix\x12+\n" +
+	"\x11include_subgroups\x18\t \x01(\bR\x10includeSubgroups\"\x97\x01\n" +
+	"\x1bSearchProductsByNameRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12/\n" +
+	"\x06filter\x18\x02 \x01(\v2\x17.products.ProductFilterR\x06filter\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x1b\n" +
+	"\tmin_score\x18\x04 \x01(\x01R\bminScore\"R\n" +
+	"\rScoredProduct\x12+\n" +
+	"\aproduct\x18\x01 \x01(\v2\x11.products.ProductR\aproduct\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x01R\x05score\"S\n" +
+	"\x1cSearchProductsByNameResponse\x123\n" +
+	"\bproducts\x18\x01 \x03(\v2\x17.products.ScoredProductR\bproducts\"c\n" +
+	"\tBatchItem\x12+\n" +
+	"\aproduct\x18\x01 \x01(\v2\x11.products.ProductR\aproduct\x12)\n" +
+	"\x05error\x18\x02 \x01(\v2\x13.errors.ErrorDetailR\x05error\"i\n" +
 	"\rBatchResponse\x12-\n" +
-	"\bproducts\x18\x01 \x03(\v2\x11.products.ProductR\bproducts*'\n" +
+	"\bproducts\x18\x01 \x03(\v2\x11.products.ProductR\bproducts\x12)\n" +
+	"\x05items\x18\x02 \x03(\v2\x13.products.BatchItemR\x05items\"7\n" +
+	"\x16InvalidateCacheRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\"\x19\n" +
+	"\x17InvalidateCacheResponse\"\x13\n" +
+	"\x11CacheStatsRequest\"}\n" +
+	"\n" +
+	"CacheStats\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04hits\x18\x02 \x01(\x04R\x04hits\x12\x16\n" +
+	"\x06misses\x18\x03 \x01(\x04R\x06misses\x12\x1b\n" +
+	"\thit_ratio\x18\x04 \x01(\x01R\bhitRatio\x12\x12\n" +
+	"\x04size\x18\x05 \x01(\x05R\x04size\"B\n" +
+	"\x12CacheStatsResponse\x12,\n" +
+	"\x06caches\x18\x01 \x03(\v2\x14.products.CacheStatsR\x06caches\"\x9c\x01\n" +
+	"\x10ProductGroupNode\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12,\n" +
+	"\x05group\x18\x02 \x01(\x0e2\x16.products.ProductGroupR\x05group\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x126\n" +
+	"\bchildren\x18\x04 \x03(\v2\x1a.products.ProductGroupNodeR\bchildren\"\x12\n" +
+	"\x10GroupTreeRequest\"E\n" +
+	"\x11GroupTreeResponse\x120\n" +
+	"\x05roots\x18\x01 \x03(\v2\x1a.products.ProductGroupNodeR\x05roots\"<\n" +
+	"\fGroupRequest\x12,\n" +
+	"\x05group\x18\x01 \x01(\x0e2\x16.products.ProductGroupR\x05group\"G\n" +
+	"\x11GroupListResponse\x122\n" +
+	"\x06groups\x18\x01 \x03(\v2\x1a.products.ProductGroupNodeR\x06groups\",\n" +
+	"\rSeriesRequest\x12\x1b\n" +
+	"\tseries_id\x18\x01 \x01(\tR\bseriesId\"R\n" +
+	"\vSeriesAsset\x12+\n" +
+	"\aproduct\x18\x01 \x01(\v2\x11.products.ProductR\aproduct\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x05R\x06amount\"k\n" +
+	"\rSeriesProduct\x12+\n" +
+	"\aproduct\x18\x01 \x01(\v2\x11.products.ProductR\aproduct\x12-\n" +
+	"\x06assets\x18\x02 \x03(\v2\x15.products.SeriesAssetR\x06assets\"Q\n" +
+	"\x1aListSeriesProductsResponse\x123\n" +
+	"\bproducts\x18\x01 \x03(\v2\x17.products.SeriesProductR\bproducts\"\x94\x01\n" +
+	"\rSeriesSummary\x12\x1b\n" +
+	"\tseries_id\x18\x01 \x01(\tR\bseriesId\x12\x1a\n" +
+	"\bproducts\x18\x02 \x01(\x05R\bproducts\x12\x1a\n" +
+	"\bpackages\x18\x03 \x01(\x05R\bpackages\x12\x16\n" +
+	"\x06volume\x18\x04 \x01(\x01R\x06volume\x12\x16\n" +
+	"\x06weight\x18\x05 \x01(\x01R\x06weight\".\n" +
+	"\x14WatchProductsRequest\x12\x16\n" +
+	"\x06cursor\x18\x01 \x01(\tR\x06cursor\"\xb5\x01\n" +
+	"\fProductDelta\x12+\n" +
+	"\aproduct\x18\x01 \x01(\v2\x11.products.ProductR\aproduct\x12%\n" +
+	"\x0echanged_fields\x18\x02 \x03(\tR\rchangedFields\x129\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x16\n" +
+	"\x06cursor\x18\x04 \x01(\tR\x06cursor\"z\n" +
+	"\x14ReplacementsResponse\x12+\n" +
+	"\aproduct\x18\x01 \x01(\v2\x11.products.ProductR\aproduct\x125\n" +
+	"\freplacements\x18\x02 \x03(\v2\x11.products.ProductR\freplacements\"V\n" +
+	"\x14QualityReportRequest\x12>\n" +
+	"\n" +
+	"categories\x18\x01 \x03(\x0e2\x1e.products.QualityIssueCategoryR\n" +
+	"categories\"\xd2\x01\n" +
+	"\fQualityIssue\x12:\n" +
+	"\bcategory\x18\x01 \x01(\x0e2\x1e.products.QualityIssueCategoryR\bcategory\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12\x14\n" +
+	"\x05fnrec\x18\x03 \x01(\tR\x05fnrec\x12%\n" +
+	"\x0eintegration_id\x18\x04 \x01(\tR\rintegrationId\x12\x12\n" +
+	"\x04name\x18\x05 \x01(\tR\x04name\x12\x16\n" +
+	"\x06detail\x18\x06 \x01(\tR\x06detail\"h\n" +
+	"\x14QualityCategoryCount\x12:\n" +
+	"\bcategory\x18\x01 \x01(\x0e2\x1e.products.QualityIssueCategoryR\bcategory\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\"\x91\x01\n" +
+	"\rQualityReport\x12\x18\n" +
+	"\ascanned\x18\x01 \x01(\x05R\ascanned\x126\n" +
+	"\x06counts\x18\x02 \x03(\v2\x1e.products.QualityCategoryCountR\x06counts\x12.\n" +
+	"\x06issues\x18\x03 \x03(\v2\x16.products.QualityIssueR\x06issues*d\n" +
+	"\rDuplicateMode\x12\x1e\n" +
+	"\x1aDUPLICATE_MODE_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15DUPLICATE_MODE_REJECT\x10\x01\x12\x18\n" +
+	"\x14DUPLICATE_MODE_MERGE\x10\x02*^\n" +
 	"\vProductType\x12\x18\n" +
-	"\x14PRODUCT_TYPE_UNKNOWN\x10\x00*l\n" +
+	"\x14PRODUCT_TYPE_UNKNOWN\x10\x00\x12\x14\n" +
+	"\x10PRODUCT_TYPE_SKU\x10\x01\x12\x1f\n" +
+	"\x1bPRODUCT_TYPE_MATERIAL_ASSET\x10\x02*l\n" +
 	"\x0eProductionType\x12\x1b\n" +
 	"\x17PRODUCTION_TYPE_UNKNOWN\x10\x00\x12\x1d\n" +
 	"\x19PRODUCTION_TYPE_PRODUCING\x10\x01\x12\x1e\n" +
@@ -1249,35 +5190,149 @@ const file_api_product_proto_rawDesc = "" +
 	"\x15PRODUCT_GROUP_STORAGE\x10;\x12\x1a\n" +
 	"\x16PRODUCT_GROUP_INTERIOR\x10<\x12#\n" +
 	"\x1fPRODUCT_GROUP_SEASONAL_PRODUCTS\x10=\x12\x1c\n" +
-	"\x18PRODUCT_GROUP_FRAGRANCES\x10>2X\n" +
+	"\x18PRODUCT_GROUP_FRAGRANCES\x10>*\xf1\x01\n" +
+	"\x14QualityIssueCategory\x12&\n" +
+	"\"QUALITY_ISSUE_CATEGORY_UNSPECIFIED\x10\x00\x12*\n" +
+	"&QUALITY_ISSUE_CATEGORY_ZERO_DIMENSIONS\x10\x01\x122\n" +
+	".QUALITY_ISSUE_CATEGORY_UNKNOWN_PRODUCTION_TYPE\x10\x02\x12#\n" +
+	"\x1fQUALITY_ISSUE_CATEGORY_NO_GROUP\x10\x03\x12,\n" +
+	"(QUALITY_ISSUE_CATEGORY_COUNT_MA_MISMATCH\x10\x042\xe0\x03\n" +
 	"\x0eProductService\x12F\n" +
-	"\fBatchRequest\x12\x1d.products.BatchProductRequest\x1a\x17.products.BatchResponseB\x10Z\x06/proto\x92\x03\x05\xd2>\x02\x10\x03b\beditionsp\xe8\a"
-
-var file_api_product_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_api_product_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+	"\fBatchRequest\x12\x1d.products.BatchProductRequest\x1a\x17.products.BatchResponse\x122\n" +
+	"\x03Get\x12\x18.products.ProductRequest\x1a\x11.products.Product\x12S\n" +
+	"\x0eSearchProducts\x12\x1f.products.SearchProductsRequest\x1a .products.SearchProductsResponse\x12e\n" +
+	"\x14SearchProductsByName\x12%.products.SearchProductsByNameRequest\x1a&.products.SearchProductsByNameResponse\x12I\n" +
+	"\rWatchProducts\x12\x1e.products.WatchProductsRequest\x1a\x16.products.ProductDelta0\x01\x12K\n" +
+	"\x0fGetReplacements\x12\x18.products.ProductRequest\x1a\x1e.products.ReplacementsResponse2\xb2\x01\n" +
+	"\fCacheService\x12V\n" +
+	"\x0fInvalidateCache\x12 .products.InvalidateCacheRequest\x1a!.products.InvalidateCacheResponse\x12J\n" +
+	"\rGetCacheStats\x12\x1b.products.CacheStatsRequest\x1a\x1c.products.CacheStatsResponse2\xe4\x01\n" +
+	"\x0fTaxonomyService\x12G\n" +
+	"\fGetGroupTree\x12\x1a.products.GroupTreeRequest\x1a\x1b.products.GroupTreeResponse\x12B\n" +
+	"\vAncestorsOf\x12\x16.products.GroupRequest\x1a\x1b.products.GroupListResponse\x12D\n" +
+	"\rDescendantsOf\x12\x16.products.GroupRequest\x1a\x1b.products.GroupListResponse2\xaa\x01\n" +
+	"\rSeriesService\x12S\n" +
+	"\x12ListSeriesProducts\x12\x17.products.SeriesRequest\x1a$.products.ListSeriesProductsResponse\x12D\n" +
+	"\x10GetSeriesSummary\x12\x17.products.SeriesRequest\x1a\x17.products.SeriesSummary2_\n" +
+	"\x0eQualityService\x12M\n" +
+	"\x10GetQualityReport\x12\x1e.products.QualityReportRequest\x1a\x17.products.QualityReport0\x01B\x10Z\x06/proto\x92\x03\x05\xd2>\x02\x10\x03b\beditionsp\xe8\a"
+
+var file_api_product_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_api_product_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
 var file_api_product_proto_goTypes = []any{
-	(ProductType)(0),            // 0: products.ProductType
-	(ProductionType)(0),         // 1: products.ProductionType
-	(ProductGroup)(0),           // 2: products.ProductGroup
-	(*ProductRequest)(nil),      // 3: products.ProductRequest
-	(*BatchProductRequest)(nil), // 4: products.BatchProductRequest
-	(*Pack)(nil),                // 5: products.Pack
-	(*Product)(nil),             // 6: products.Product
-	(*BatchResponse)(nil),       // 7: products.BatchResponse
+	(DuplicateMode)(0),                   // 0: products.DuplicateMode
+	(ProductType)(0),                     // 1: products.ProductType
+	(ProductionType)(0),                  // 2: products.ProductionType
+	(ProductGroup)(0),                    // 3: products.ProductGroup
+	(QualityIssueCategory)(0),            // 4: products.QualityIssueCategory
+	(*ProductRequest)(nil),               // 5: products.ProductRequest
+	(*BatchProductRequest)(nil),          // 6: products.BatchProductRequest
+	(*Pack)(nil),                         // 7: products.Pack
+	(*Product)(nil),                      // 8: products.Product
+	(*SearchProductsRequest)(nil),        // 9: products.SearchProductsRequest
+	(*SearchProductsResponse)(nil),       // 10: products.SearchProductsResponse
+	(*ProductFilter)(nil),                // 11: products.ProductFilter
+	(*SearchProductsByNameRequest)(nil),  // 12: products.SearchProductsByNameRequest
+	(*ScoredProduct)(nil),                // 13: products.ScoredProduct
+	(*SearchProductsByNameResponse)(nil), // 14: products.SearchProductsByNameResponse
+	(*BatchItem)(nil),                    // 15: products.BatchItem
+	(*BatchResponse)(nil),                // 16: products.BatchResponse
+	(*InvalidateCacheRequest)(nil),       // 17: products.InvalidateCacheRequest
+	(*InvalidateCacheResponse)(nil),      // 18: products.InvalidateCacheResponse
+	(*CacheStatsRequest)(nil),            // 19: products.CacheStatsRequest
+	(*CacheStats)(nil),                   // 20: products.CacheStats
+	(*CacheStatsResponse)(nil),           // 21: products.CacheStatsResponse
+	(*ProductGroupNode)(nil),             // 22: products.ProductGroupNode
+	(*GroupTreeRequest)(nil),             // 23: products.GroupTreeRequest
+	(*GroupTreeResponse)(nil),            // 24: products.GroupTreeResponse
+	(*GroupRequest)(nil),                 // 25: products.GroupRequest
+	(*GroupListResponse)(nil),            // 26: products.GroupListResponse
+	(*SeriesRequest)(nil),                // 27: products.SeriesRequest
+	(*SeriesAsset)(nil),                  // 28: products.SeriesAsset
+	(*SeriesProduct)(nil),                // 29: products.SeriesProduct
+	(*ListSeriesProductsResponse)(nil),   // 30: products.ListSeriesProductsResponse
+	(*SeriesSummary)(nil),                // 31: products.SeriesSummary
+	(*WatchProductsRequest)(nil),         // 32: products.WatchProductsRequest
+	(*ProductDelta)(nil),                 // 33: products.ProductDelta
+	(*ReplacementsResponse)(nil),         // 34: products.ReplacementsResponse
+	(*QualityReportRequest)(nil),         // 35: products.QualityReportRequest
+	(*QualityIssue)(nil),                 // 36: products.QualityIssue
+	(*QualityCategoryCount)(nil),         // 37: products.QualityCategoryCount
+	(*QualityReport)(nil),                // 38: products.QualityReport
+	(*ErrorDetail)(nil),                  // 39: errors.ErrorDetail
+	(*timestamppb.Timestamp)(nil),        // 40: google.protobuf.Timestamp
 }
 var file_api_product_proto_depIdxs = []int32{
-	3, // 0: products.BatchProductRequest.requests:type_name -> products.ProductRequest
-	1, // 1: products.Product.production_type:type_name -> products.ProductionType
-	2, // 2: products.Product.group:type_name -> products.ProductGroup
-	5, // 3: products.Product.pack:type_name -> products.Pack
-	6, // 4: products.BatchResponse.products:type_name -> products.Product
-	4, // 5: products.ProductService.BatchRequest:input_type -> products.BatchProductRequest
-	7, // 6: products.ProductService.BatchRequest:output_type -> products.BatchResponse
-	6, // [6:7] is the sub-list for method output_type
-	5, // [5:6] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	5,  // 0: products.BatchProductRequest.requests:type_name -> products.ProductRequest
+	0,  // 1: products.BatchProductRequest.duplicates:type_name -> products.DuplicateMode
+	2,  // 2: products.Product.production_type:type_name -> products.ProductionType
+	3,  // 3: products.Product.group:type_name -> products.ProductGroup
+	7,  // 4: products.Product.pack:type_name -> products.Pack
+	1,  // 5: products.Product.type:type_name -> products.ProductType
+	3,  // 6: products.SearchProductsRequest.group:type_name -> products.ProductGroup
+	2,  // 7: products.SearchProductsRequest.production_type:type_name -> products.ProductionType
+	8,  // 8: products.SearchProductsResponse.products:type_name -> products.Product
+	3,  // 9: products.ProductFilter.group:type_name -> products.ProductGroup
+	2,  // 10: products.ProductFilter.production_type:type_name -> products.ProductionType
+	11, // 11: products.SearchProductsByNameRequest.filter:type_name -> products.ProductFilter
+	8,  // 12: products.ScoredProduct.product:type_name -> products.Product
+	13, // 13: products.SearchProductsByNameResponse.products:type_name -> products.ScoredProduct
+	8,  // 14: products.BatchItem.product:type_name -> products.Product
+	39, // 15: products.BatchItem.error:type_name -> errors.ErrorDetail
+	8,  // 16: products.BatchResponse.products:type_name -> products.Product
+	15, // 17: products.BatchResponse.items:type_name -> products.BatchItem
+	20, // 18: products.CacheStatsResponse.caches:type_name -> products.CacheStats
+	3,  // 19: products.ProductGroupNode.group:type_name -> products.ProductGroup
+	22, // 20: products.ProductGroupNode.children:type_name -> products.ProductGroupNode
+	22, // 21: products.GroupTreeResponse.roots:type_name -> products.ProductGroupNode
+	3,  // 22: products.GroupRequest.group:type_name -> products.ProductGroup
+	22, // 23: products.GroupListResponse.groups:type_name -> products.ProductGroupNode
+	8,  // 24: products.SeriesAsset.product:type_name -> products.Product
+	8,  // 25: products.SeriesProduct.product:type_name -> products.Product
+	28, // 26: products.SeriesProduct.assets:type_name -> products.SeriesAsset
+	29, // 27: products.ListSeriesProductsResponse.products:type_name -> products.SeriesProduct
+	8,  // 28: products.ProductDelta.product:type_name -> products.Product
+	40, // 29: products.ProductDelta.updated_at:type_name -> google.protobuf.Timestamp
+	8,  // 30: products.ReplacementsResponse.product:type_name -> products.Product
+	8,  // 31: products.ReplacementsResponse.replacements:type_name -> products.Product
+	4,  // 32: products.QualityReportRequest.categories:type_name -> products.QualityIssueCategory
+	4,  // 33: products.QualityIssue.category:type_name -> products.QualityIssueCategory
+	4,  // 34: products.QualityCategoryCount.category:type_name -> products.QualityIssueCategory
+	37, // 35: products.QualityReport.counts:type_name -> products.QualityCategoryCount
+	36, // 36: products.QualityReport.issues:type_name -> products.QualityIssue
+	6,  // 37: products.ProductService.BatchRequest:input_type -> products.BatchProductRequest
+	5,  // 38: products.ProductService.Get:input_type -> products.ProductRequest
+	9,  // 39: products.ProductService.SearchProducts:input_type -> products.SearchProductsRequest
+	12, // 40: products.ProductService.SearchProductsByName:input_type -> products.SearchProductsByNameRequest
+	32, // 41: products.ProductService.WatchProducts:input_type -> products.WatchProductsRequest
+	5,  // 42: products.ProductService.GetReplacements:input_type -> products.ProductRequest
+	17, // 43: products.CacheService.InvalidateCache:input_type -> products.InvalidateCacheRequest
+	19, // 44: products.CacheService.GetCacheStats:input_type -> products.CacheStatsRequest
+	23, // 45: products.TaxonomyService.GetGroupTree:input_type -> products.GroupTreeRequest
+	25, // 46: products.TaxonomyService.AncestorsOf:input_type -> products.GroupRequest
+	25, // 47: products.TaxonomyService.DescendantsOf:input_type -> products.GroupRequest
+	27, // 48: products.SeriesService.ListSeriesProducts:input_type -> products.SeriesRequest
+	27, // 49: products.SeriesService.GetSeriesSummary:input_type -> products.SeriesRequest
+	35, // 50: products.QualityService.GetQualityReport:input_type -> products.QualityReportRequest
+	16, // 51: products.ProductService.BatchRequest:output_type -> products.BatchResponse
+	8,  // 52: products.ProductService.Get:output_type -> products.Product
+	10, // 53: products.ProductService.SearchProducts:output_type -> products.SearchProductsResponse
+	14, // 54: products.ProductService.SearchProductsByName:output_type -> products.SearchProductsByNameResponse
+	33, // 55: products.ProductService.WatchProducts:output_type -> products.ProductDelta
+	34, // 56: products.ProductService.GetReplacements:output_type -> products.ReplacementsResponse
+	18, // 57: products.CacheService.InvalidateCache:output_type -> products.InvalidateCacheResponse
+	21, // 58: products.CacheService.GetCacheStats:output_type -> products.CacheStatsResponse
+	24, // 59: products.TaxonomyService.GetGroupTree:output_type -> products.GroupTreeResponse
+	26, // 60: products.TaxonomyService.AncestorsOf:output_type -> products.GroupListResponse
+	26, // 61: products.TaxonomyService.DescendantsOf:output_type -> products.GroupListResponse
+	30, // 62: products.SeriesService.ListSeriesProducts:output_type -> products.ListSeriesProductsResponse
+	31, // 63: products.SeriesService.GetSeriesSummary:output_type -> products.SeriesSummary
+	38, // 64: products.QualityService.GetQualityReport:output_type -> products.QualityReport
+	51, // [51:65] is the sub-list for method output_type
+	37, // [37:51] is the sub-list for method input_type
+	37, // [37:37] is the sub-list for extension type_name
+	37, // [37:37] is the sub-list for extension extendee
+	0,  // [0:37] is the sub-list for field type_name
 }
 
 func init() { file_api_product_proto_init() }
@@ -1286,15 +5341,20 @@ func file_api_product_proto_init() {
 		return
 	}
 	file_api_errors_proto_init()
+	file_api_product_proto_msgTypes[0].OneofWrappers = []any{
+		(*productRequest_IntegrationId)(nil),
+		(*productRequest_Fnrec)(nil),
+		(*productRequest_Id)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_product_proto_rawDesc), len(file_api_product_proto_rawDesc)),
-			NumEnums:      3,
-			NumMessages:   5,
+			NumEnums:      5,
+			NumMessages:   34,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   5,
 		},
 		GoTypes:           file_api_product_proto_goTypes,
 		DependencyIndexes: file_api_product_proto_depIdxs,
@@ -19,7 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ProductService_BatchRequest_FullMethodName = "/products.ProductService/BatchRequest"
+	ProductService_BatchRequest_FullMethodName         = "/products.ProductService/BatchRequest"
+	ProductService_Get_FullMethodName                  = "/products.ProductService/Get"
+	ProductService_SearchProducts_FullMethodName       = "/products.ProductService/SearchProducts"
+	ProductService_SearchProductsByName_FullMethodName = "/products.ProductService/SearchProductsByName"
+	ProductService_WatchProducts_FullMethodName        = "/products.ProductService/WatchProducts"
+	ProductService_GetReplacements_FullMethodName      = "/products.ProductService/GetReplacements"
 )
 
 // ProductServiceClient is the client API for ProductService service.
@@ -27,6 +32,11 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type ProductServiceClient interface {
 	BatchRequest(ctx context.Context, in *BatchProductRequest, opts ...grpc.CallOption) (*BatchResponse, error)
+	Get(ctx context.Context, in *ProductRequest, opts ...grpc.CallOption) (*Product, error)
+	SearchProducts(ctx context.Context, in *SearchProductsRequest, opts ...grpc.CallOption) (*SearchProductsResponse, error)
+	SearchProductsByName(ctx context.Context, in *SearchProductsByNameRequest, opts ...grpc.CallOption) (*SearchProductsByNameResponse, error)
+	WatchProducts(ctx context.Context, in *WatchProductsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ProductDelta], error)
+	GetReplacements(ctx context.Context, in *ProductRequest, opts ...grpc.CallOption) (*ReplacementsResponse, error)
 }
 
 type productServiceClient struct {
@@ -47,11 +57,75 @@ func (c *productServiceClient) BatchRequest(ctx context.Context, in *BatchProduc
 	return out, nil
 }
 
+func (c *productServiceClient) Get(ctx context.Context, in *ProductRequest, opts ...grpc.CallOption) (*Product, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Product)
+	err := c.cc.Invoke(ctx, ProductService_Get_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SearchProducts(ctx context.Context, in *SearchProductsRequest, opts ...grpc.CallOption) (*SearchProductsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchProductsResponse)
+	err := c.cc.Invoke(ctx, ProductService_SearchProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SearchProductsByName(ctx context.Context, in *SearchProductsByNameRequest, opts ...grpc.CallOption) (*SearchProductsByNameResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchProductsByNameResponse)
+	err := c.cc.Invoke(ctx, ProductService_SearchProductsByName_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) WatchProducts(ctx context.Context, in *WatchProductsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ProductDelta], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ProductService_ServiceDesc.Streams[0], ProductService_WatchProducts_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchProductsRequest, ProductDelta]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ProductService_WatchProductsClient = grpc.ServerStreamingClient[ProductDelta]
+
+func (c *productServiceClient) GetReplacements(ctx context.Context, in *ProductRequest, opts ...grpc.CallOption) (*ReplacementsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReplacementsResponse)
+	err := c.cc.Invoke(ctx, ProductService_GetReplacements_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProductServiceServer is the server API for ProductService service.
 // All implementations must embed UnimplementedProductServiceServer
 // for forward compatibility.
 type ProductServiceServer interface {
 	BatchRequest(context.Context, *BatchProductRequest) (*BatchResponse, error)
+	Get(context.Context, *ProductRequest) (*Product, error)
+	SearchProducts(context.Context, *SearchProductsRequest) (*SearchProductsResponse, error)
+	SearchProductsByName(context.Context, *SearchProductsByNameRequest) (*SearchProductsByNameResponse, error)
+	WatchProducts(*WatchProductsRequest, grpc.ServerStreamingServer[ProductDelta]) error
+	GetReplacements(context.Context, *ProductRequest) (*ReplacementsResponse, error)
 	mustEmbedUnimplementedProductServiceServer()
 }
 
@@ -65,6 +139,21 @@ type UnimplementedProductServiceServer struct{}
 func (UnimplementedProductServiceServer) BatchRequest(context.Context, *BatchProductRequest) (*BatchResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BatchRequest not implemented")
 }
+func (UnimplementedProductServiceServer) Get(context.Context, *ProductRequest) (*Product, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedProductServiceServer) SearchProducts(context.Context, *SearchProductsRequest) (*SearchProductsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchProducts not implemented")
+}
+func (UnimplementedProductServiceServer) SearchProductsByName(context.Context, *SearchProductsByNameRequest) (*SearchProductsByNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchProductsByName not implemented")
+}
+func (UnimplementedProductServiceServer) WatchProducts(*WatchProductsRequest, grpc.ServerStreamingServer[ProductDelta]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchProducts not implemented")
+}
+func (UnimplementedProductServiceServer) GetReplacements(context.Context, *ProductRequest) (*ReplacementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReplacements not implemented")
+}
 func (UnimplementedProductServiceServer) mustEmbedUnimplementedProductServiceServer() {}
 func (UnimplementedProductServiceServer) testEmbeddedByValue()                        {}
 
@@ -104,6 +193,89 @@ func _ProductService_BatchRequest_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProductService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).Get(ctx, req.(*ProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SearchProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SearchProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SearchProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SearchProducts(ctx, req.(*SearchProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SearchProductsByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchProductsByNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SearchProductsByName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SearchProductsByName_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SearchProductsByName(ctx, req.(*SearchProductsByNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_WatchProducts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchProductsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ProductServiceServer).WatchProducts(m, &grpc.GenericServerStream[WatchProductsRequest, ProductDelta]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ProductService_WatchProductsServer = grpc.ServerStreamingServer[ProductDelta]
+
+func _ProductService_GetReplacements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetReplacements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetReplacements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetReplacements(ctx, req.(*ProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ProductService_ServiceDesc is the grpc.ServiceDesc for ProductService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -115,7 +287,592 @@ var ProductService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BatchRequest",
 			Handler:    _ProductService_BatchRequest_Handler,
 		},
+		{
+			MethodName: "Get",
+			Handler:    _ProductService_Get_Handler,
+		},
+		{
+			MethodName: "SearchProducts",
+			Handler:    _ProductService_SearchProducts_Handler,
+		},
+		{
+			MethodName: "SearchProductsByName",
+			Handler:    _ProductService_SearchProductsByName_Handler,
+		},
+		{
+			MethodName: "GetReplacements",
+			Handler:    _ProductService_GetReplacements_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchProducts",
+			Handler:       _ProductService_WatchProducts_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "api/product.proto",
+}
+
+const (
+	CacheService_InvalidateCache_FullMethodName = "/products.CacheService/InvalidateCache"
+	CacheService_GetCacheStats_FullMethodName   = "/products.CacheService/GetCacheStats"
+)
+
+// CacheServiceClient is the client API for CacheService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CacheServiceClient interface {
+	InvalidateCache(ctx context.Context, in *InvalidateCacheRequest, opts ...grpc.CallOption) (*InvalidateCacheResponse, error)
+	GetCacheStats(ctx context.Context, in *CacheStatsRequest, opts ...grpc.CallOption) (*CacheStatsResponse, error)
+}
+
+type cacheServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCacheServiceClient(cc grpc.ClientConnInterface) CacheServiceClient {
+	return &cacheServiceClient{cc}
+}
+
+func (c *cacheServiceClient) InvalidateCache(ctx context.Context, in *InvalidateCacheRequest, opts ...grpc.CallOption) (*InvalidateCacheResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InvalidateCacheResponse)
+	err := c.cc.Invoke(ctx, CacheService_InvalidateCache_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) GetCacheStats(ctx context.Context, in *CacheStatsRequest, opts ...grpc.CallOption) (*CacheStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CacheStatsResponse)
+	err := c.cc.Invoke(ctx, CacheService_GetCacheStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CacheServiceServer is the server API for CacheService service.
+// All implementations must embed UnimplementedCacheServiceServer
+// for forward compatibility.
+type CacheServiceServer interface {
+	InvalidateCache(context.Context, *InvalidateCacheRequest) (*InvalidateCacheResponse, error)
+	GetCacheStats(context.Context, *CacheStatsRequest) (*CacheStatsResponse, error)
+	mustEmbedUnimplementedCacheServiceServer()
+}
+
+// UnimplementedCacheServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCacheServiceServer struct{}
+
+func (UnimplementedCacheServiceServer) InvalidateCache(context.Context, *InvalidateCacheRequest) (*InvalidateCacheResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InvalidateCache not implemented")
+}
+func (UnimplementedCacheServiceServer) GetCacheStats(context.Context, *CacheStatsRequest) (*CacheStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCacheStats not implemented")
+}
+func (UnimplementedCacheServiceServer) mustEmbedUnimplementedCacheServiceServer() {}
+func (UnimplementedCacheServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeCacheServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CacheServiceServer will
+// result in compilation errors.
+type UnsafeCacheServiceServer interface {
+	mustEmbedUnimplementedCacheServiceServer()
+}
+
+func RegisterCacheServiceServer(s grpc.ServiceRegistrar, srv CacheServiceServer) {
+	// If the following call pancis, it indicates UnimplementedCacheServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CacheService_ServiceDesc, srv)
+}
+
+func _CacheService_InvalidateCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InvalidateCacheRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).InvalidateCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CacheService_InvalidateCache_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).InvalidateCache(ctx, req.(*InvalidateCacheRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_GetCacheStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CacheStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).GetCacheStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CacheService_GetCacheStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).GetCacheStats(ctx, req.(*CacheStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CacheService_ServiceDesc is the grpc.ServiceDesc for CacheService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CacheService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "products.CacheService",
+	HandlerType: (*CacheServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "InvalidateCache",
+			Handler:    _CacheService_InvalidateCache_Handler,
+		},
+		{
+			MethodName: "GetCacheStats",
+			Handler:    _CacheService_GetCacheStats_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/product.proto",
 }
+
+const (
+	TaxonomyService_GetGroupTree_FullMethodName  = "/products.TaxonomyService/GetGroupTree"
+	TaxonomyService_AncestorsOf_FullMethodName   = "/products.TaxonomyService/AncestorsOf"
+	TaxonomyService_DescendantsOf_FullMethodName = "/products.TaxonomyService/DescendantsOf"
+)
+
+// TaxonomyServiceClient is the client API for TaxonomyService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TaxonomyServiceClient interface {
+	GetGroupTree(ctx context.Context, in *GroupTreeRequest, opts ...grpc.CallOption) (*GroupTreeResponse, error)
+	AncestorsOf(ctx context.Context, in *GroupRequest, opts ...grpc.CallOption) (*GroupListResponse, error)
+	DescendantsOf(ctx context.Context, in *GroupRequest, opts ...grpc.CallOption) (*GroupListResponse, error)
+}
+
+type taxonomyServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTaxonomyServiceClient(cc grpc.ClientConnInterface) TaxonomyServiceClient {
+	return &taxonomyServiceClient{cc}
+}
+
+func (c *taxonomyServiceClient) GetGroupTree(ctx context.Context, in *GroupTreeRequest, opts ...grpc.CallOption) (*GroupTreeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GroupTreeResponse)
+	err := c.cc.Invoke(ctx, TaxonomyService_GetGroupTree_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taxonomyServiceClient) AncestorsOf(ctx context.Context, in *GroupRequest, opts ...grpc.CallOption) (*GroupListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GroupListResponse)
+	err := c.cc.Invoke(ctx, TaxonomyService_AncestorsOf_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taxonomyServiceClient) DescendantsOf(ctx context.Context, in *GroupRequest, opts ...grpc.CallOption) (*GroupListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GroupListResponse)
+	err := c.cc.Invoke(ctx, TaxonomyService_DescendantsOf_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TaxonomyServiceServer is the server API for TaxonomyService service.
+// All implementations must embed UnimplementedTaxonomyServiceServer
+// for forward compatibility.
+type TaxonomyServiceServer interface {
+	GetGroupTree(context.Context, *GroupTreeRequest) (*GroupTreeResponse, error)
+	AncestorsOf(context.Context, *GroupRequest) (*GroupListResponse, error)
+	DescendantsOf(context.Context, *GroupRequest) (*GroupListResponse, error)
+	mustEmbedUnimplementedTaxonomyServiceServer()
+}
+
+// UnimplementedTaxonomyServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTaxonomyServiceServer struct{}
+
+func (UnimplementedTaxonomyServiceServer) GetGroupTree(context.Context, *GroupTreeRequest) (*GroupTreeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupTree not implemented")
+}
+func (UnimplementedTaxonomyServiceServer) AncestorsOf(context.Context, *GroupRequest) (*GroupListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AncestorsOf not implemented")
+}
+func (UnimplementedTaxonomyServiceServer) DescendantsOf(context.Context, *GroupRequest) (*GroupListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescendantsOf not implemented")
+}
+func (UnimplementedTaxonomyServiceServer) mustEmbedUnimplementedTaxonomyServiceServer() {}
+func (UnimplementedTaxonomyServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeTaxonomyServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TaxonomyServiceServer will
+// result in compilation errors.
+type UnsafeTaxonomyServiceServer interface {
+	mustEmbedUnimplementedTaxonomyServiceServer()
+}
+
+func RegisterTaxonomyServiceServer(s grpc.ServiceRegistrar, srv TaxonomyServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTaxonomyServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TaxonomyService_ServiceDesc, srv)
+}
+
+func _TaxonomyService_GetGroupTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupTreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaxonomyServiceServer).GetGroupTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaxonomyService_GetGroupTree_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaxonomyServiceServer).GetGroupTree(ctx, req.(*GroupTreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaxonomyService_AncestorsOf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaxonomyServiceServer).AncestorsOf(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaxonomyService_AncestorsOf_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaxonomyServiceServer).AncestorsOf(ctx, req.(*GroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaxonomyService_DescendantsOf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaxonomyServiceServer).DescendantsOf(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaxonomyService_DescendantsOf_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaxonomyServiceServer).DescendantsOf(ctx, req.(*GroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TaxonomyService_ServiceDesc is the grpc.ServiceDesc for TaxonomyService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TaxonomyService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "products.TaxonomyService",
+	HandlerType: (*TaxonomyServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetGroupTree",
+			Handler:    _TaxonomyService_GetGroupTree_Handler,
+		},
+		{
+			MethodName: "AncestorsOf",
+			Handler:    _TaxonomyService_AncestorsOf_Handler,
+		},
+		{
+			MethodName: "DescendantsOf",
+			Handler:    _TaxonomyService_DescendantsOf_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/product.proto",
+}
+
+const (
+	SeriesService_ListSeriesProducts_FullMethodName = "/products.SeriesService/ListSeriesProducts"
+	SeriesService_GetSeriesSummary_FullMethodName   = "/products.SeriesService/GetSeriesSummary"
+)
+
+// SeriesServiceClient is the client API for SeriesService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SeriesServiceClient interface {
+	ListSeriesProducts(ctx context.Context, in *SeriesRequest, opts ...grpc.CallOption) (*ListSeriesProductsResponse, error)
+	GetSeriesSummary(ctx context.Context, in *SeriesRequest, opts ...grpc.CallOption) (*SeriesSummary, error)
+}
+
+type seriesServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSeriesServiceClient(cc grpc.ClientConnInterface) SeriesServiceClient {
+	return &seriesServiceClient{cc}
+}
+
+func (c *seriesServiceClient) ListSeriesProducts(ctx context.Context, in *SeriesRequest, opts ...grpc.CallOption) (*ListSeriesProductsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSeriesProductsResponse)
+	err := c.cc.Invoke(ctx, SeriesService_ListSeriesProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *seriesServiceClient) GetSeriesSummary(ctx context.Context, in *SeriesRequest, opts ...grpc.CallOption) (*SeriesSummary, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SeriesSummary)
+	err := c.cc.Invoke(ctx, SeriesService_GetSeriesSummary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SeriesServiceServer is the server API for SeriesService service.
+// All implementations must embed UnimplementedSeriesServiceServer
+// for forward compatibility.
+type SeriesServiceServer interface {
+	ListSeriesProducts(context.Context, *SeriesRequest) (*ListSeriesProductsResponse, error)
+	GetSeriesSummary(context.Context, *SeriesRequest) (*SeriesSummary, error)
+	mustEmbedUnimplementedSeriesServiceServer()
+}
+
+// UnimplementedSeriesServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSeriesServiceServer struct{}
+
+func (UnimplementedSeriesServiceServer) ListSeriesProducts(context.Context, *SeriesRequest) (*ListSeriesProductsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSeriesProducts not implemented")
+}
+func (UnimplementedSeriesServiceServer) GetSeriesSummary(context.Context, *SeriesRequest) (*SeriesSummary, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSeriesSummary not implemented")
+}
+func (UnimplementedSeriesServiceServer) mustEmbedUnimplementedSeriesServiceServer() {}
+func (UnimplementedSeriesServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeSeriesServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SeriesServiceServer will
+// result in compilation errors.
+type UnsafeSeriesServiceServer interface {
+	mustEmbedUnimplementedSeriesServiceServer()
+}
+
+func RegisterSeriesServiceServer(s grpc.ServiceRegistrar, srv SeriesServiceServer) {
+	// If the following call pancis, it indicates UnimplementedSeriesServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SeriesService_ServiceDesc, srv)
+}
+
+func _SeriesService_ListSeriesProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeriesServiceServer).ListSeriesProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SeriesService_ListSeriesProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeriesServiceServer).ListSeriesProducts(ctx, req.(*SeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SeriesService_GetSeriesSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SeriesServiceServer).GetSeriesSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SeriesService_GetSeriesSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SeriesServiceServer).GetSeriesSummary(ctx, req.(*SeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SeriesService_ServiceDesc is the grpc.ServiceDesc for SeriesService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SeriesService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "products.SeriesService",
+	HandlerType: (*SeriesServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListSeriesProducts",
+			Handler:    _SeriesService_ListSeriesProducts_Handler,
+		},
+		{
+			MethodName: "GetSeriesSummary",
+			Handler:    _SeriesService_GetSeriesSummary_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/product.proto",
+}
+
+const (
+	QualityService_GetQualityReport_FullMethodName = "/products.QualityService/GetQualityReport"
+)
+
+// QualityServiceClient is the client API for QualityService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type QualityServiceClient interface {
+	GetQualityReport(ctx context.Context, in *QualityReportRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[QualityReport], error)
+}
+
+type qualityServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQualityServiceClient(cc grpc.ClientConnInterface) QualityServiceClient {
+	return &qualityServiceClient{cc}
+}
+
+func (c *qualityServiceClient) GetQualityReport(ctx context.Context, in *QualityReportRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[QualityReport], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &QualityService_ServiceDesc.Streams[0], QualityService_GetQualityReport_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[QualityReportRequest, QualityReport]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type QualityService_GetQualityReportClient = grpc.ServerStreamingClient[QualityReport]
+
+// QualityServiceServer is the server API for QualityService service.
+// All implementations must embed UnimplementedQualityServiceServer
+// for forward compatibility.
+type QualityServiceServer interface {
+	GetQualityReport(*QualityReportRequest, grpc.ServerStreamingServer[QualityReport]) error
+	mustEmbedUnimplementedQualityServiceServer()
+}
+
+// UnimplementedQualityServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedQualityServiceServer struct{}
+
+func (UnimplementedQualityServiceServer) GetQualityReport(*QualityReportRequest, grpc.ServerStreamingServer[QualityReport]) error {
+	return status.Errorf(codes.Unimplemented, "method GetQualityReport not implemented")
+}
+func (UnimplementedQualityServiceServer) mustEmbedUnimplementedQualityServiceServer() {}
+func (UnimplementedQualityServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeQualityServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QualityServiceServer will
+// result in compilation errors.
+type UnsafeQualityServiceServer interface {
+	mustEmbedUnimplementedQualityServiceServer()
+}
+
+func RegisterQualityServiceServer(s grpc.ServiceRegistrar, srv QualityServiceServer) {
+	// If the following call pancis, it indicates UnimplementedQualityServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&QualityService_ServiceDesc, srv)
+}
+
+func _QualityService_GetQualityReport_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(QualityReportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QualityServiceServer).GetQualityReport(m, &grpc.GenericServerStream[QualityReportRequest, QualityReport]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type QualityService_GetQualityReportServer = grpc.ServerStreamingServer[QualityReport]
+
+// QualityService_ServiceDesc is the grpc.ServiceDesc for QualityService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var QualityService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "products.QualityService",
+	HandlerType: (*QualityServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GetQualityReport",
+			Handler:       _QualityService_GetQualityReport_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "api/product.proto",
+}
@@ -250,6 +250,9 @@ func (b0 SpecificationLine_builder) Build() *SpecificationLine {
 type SpecificationRequest struct {
 	state                         protoimpl.MessageState `protogen:"opaque.v1"`
 	xxx_hidden_SpecificationLines *[]*SpecificationLine  `protobuf:"bytes,1,rep,name=specification_lines,json=specificationLines"`
+	xxx_hidden_Duplicates         DuplicateMode          `protobuf:"varint,2,opt,name=duplicates,enum=products.DuplicateMode"`
+	XXX_raceDetectHookData        protoimpl.RaceDetectHookData
+	XXX_presence                  [1]uint32
 	unknownFields                 protoimpl.UnknownFields
 	sizeCache                     protoimpl.SizeCache
 }
@@ -288,14 +291,41 @@ func (x *SpecificationRequest) GetSpecificationLines() []*SpecificationLine {
 	return nil
 }
 
+func (x *SpecificationRequest) GetDuplicates() DuplicateMode {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 1) {
+			return x.xxx_hidden_Duplicates
+		}
+	}
+	return DuplicateMode_DUPLICATE_MODE_REJECT
+}
+
 func (x *SpecificationRequest) SetSpecificationLines(v []*SpecificationLine) {
 	x.xxx_hidden_SpecificationLines = &v
 }
 
+func (x *SpecificationRequest) SetDuplicates(v DuplicateMode) {
+	x.xxx_hidden_Duplicates = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *SpecificationRequest) HasDuplicates() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *SpecificationRequest) ClearDuplicates() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Duplicates = DuplicateMode_DUPLICATE_MODE_REJECT
+}
+
 type SpecificationRequest_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
 	SpecificationLines []*SpecificationLine
+	Duplicates         *DuplicateMode
 }
 
 func (b0 SpecificationRequest_builder) Build() *SpecificationRequest {
@@ -303,6 +333,10 @@ func (b0 SpecificationRequest_builder) Build() *SpecificationRequest {
 	b, x := &b0, m0
 	_, _ = b, x
 	x.xxx_hidden_SpecificationLines = &b.SpecificationLines
+	if b.Duplicates != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_Duplicates = *b.Duplicates
+	}
 	return m0
 }
 
@@ -438,12 +472,180 @@ func (b0 Line_builder) Build() *Line {
 	return m0
 }
 
+// Totals of the lines that ship, used to pick the vehicle class.
+type Totals struct {
+	state                   protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Packages     int32                  `protobuf:"varint,1,opt,name=packages"`
+	xxx_hidden_Volume       float64                `protobuf:"fixed64,2,opt,name=volume"`
+	xxx_hidden_Weight       float64                `protobuf:"fixed64,3,opt,name=weight"`
+	xxx_hidden_MaxDimension float64                `protobuf:"fixed64,4,opt,name=max_dimension,json=maxDimension"`
+	XXX_raceDetectHookData  protoimpl.RaceDetectHookData
+	XXX_presence            [1]uint32
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *Totals) Reset() {
+	*x = Totals{}
+	mi := &file_api_specification_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Totals) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Totals) ProtoMessage() {}
+
+func (x *Totals) ProtoReflect() protoreflect.Message {
+	mi := &file_api_specification_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Totals) GetPackages() int32 {
+	if x != nil {
+		return x.xxx_hidden_Packages
+	}
+	return 0
+}
+
+func (x *Totals) GetVolume() float64 {
+	if x != nil {
+		return x.xxx_hidden_Volume
+	}
+	return 0
+}
+
+func (x *Totals) GetWeight() float64 {
+	if x != nil {
+		return x.xxx_hidden_Weight
+	}
+	return 0
+}
+
+func (x *Totals) GetMaxDimension() float64 {
+	if x != nil {
+		return x.xxx_hidden_MaxDimension
+	}
+	return 0
+}
+
+func (x *Totals) SetPackages(v int32) {
+	x.xxx_hidden_Packages = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 4)
+}
+
+func (x *Totals) SetVolume(v float64) {
+	x.xxx_hidden_Volume = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 4)
+}
+
+func (x *Totals) SetWeight(v float64) {
+	x.xxx_hidden_Weight = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 4)
+}
+
+func (x *Totals) SetMaxDimension(v float64) {
+	x.xxx_hidden_MaxDimension = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 4)
+}
+
+func (x *Totals) HasPackages() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *Totals) HasVolume() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *Totals) HasWeight() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *Totals) HasMaxDimension() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *Totals) ClearPackages() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Packages = 0
+}
+
+func (x *Totals) ClearVolume() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Volume = 0
+}
+
+func (x *Totals) ClearWeight() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Weight = 0
+}
+
+func (x *Totals) ClearMaxDimension() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_MaxDimension = 0
+}
+
+type Totals_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Packages *int32
+	Volume   *float64
+	Weight   *float64
+	// longest side of the largest package
+	MaxDimension *float64
+}
+
+func (b0 Totals_builder) Build() *Totals {
+	m0 := &Totals{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Packages != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 4)
+		x.xxx_hidden_Packages = *b.Packages
+	}
+	if b.Volume != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 4)
+		x.xxx_hidden_Volume = *b.Volume
+	}
+	if b.Weight != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 4)
+		x.xxx_hidden_Weight = *b.Weight
+	}
+	if b.MaxDimension != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 4)
+		x.xxx_hidden_MaxDimension = *b.MaxDimension
+	}
+	return m0
+}
+
 type Specification struct {
 	state                   protoimpl.MessageState `protogen:"opaque.v1"`
 	xxx_hidden_Product      *Line                  `protobuf:"bytes,1,opt,name=product"`
 	xxx_hidden_Type         SpecificationType      `protobuf:"varint,2,opt,name=type,enum=products.SpecificationType"`
 	xxx_hidden_ChildProduct *[]*Line               `protobuf:"bytes,3,rep,name=child_product,json=childProduct"`
 	xxx_hidden_Strategy     PickupStrategy         `protobuf:"varint,4,opt,name=strategy,enum=products.PickupStrategy"`
+	xxx_hidden_Totals       *Totals                `protobuf:"bytes,5,opt,name=totals"`
 	XXX_raceDetectHookData  protoimpl.RaceDetectHookData
 	XXX_presence            [1]uint32
 	unknownFields           protoimpl.UnknownFields
@@ -452,7 +654,7 @@ type Specification struct {
 
 func (x *Specification) Reset() {
 	*x = Specification{}
-	mi := &file_api_specification_proto_msgTypes[3]
+	mi := &file_api_specification_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -464,7 +666,7 @@ func (x *Specification) String() string {
 func (*Specification) ProtoMessage() {}
 
 func (x *Specification) ProtoReflect() protoreflect.Message {
-	mi := &file_api_specification_proto_msgTypes[3]
+	mi := &file_api_specification_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -509,13 +711,20 @@ func (x *Specification) GetStrategy() PickupStrategy {
 	return PickupStrategy_NEAREST
 }
 
+func (x *Specification) GetTotals() *Totals {
+	if x != nil {
+		return x.xxx_hidden_Totals
+	}
+	return nil
+}
+
 func (x *Specification) SetProduct(v *Line) {
 	x.xxx_hidden_Product = v
 }
 
 func (x *Specification) SetType(v SpecificationType) {
 	x.xxx_hidden_Type = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 4)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 5)
 }
 
 func (x *Specification) SetChildProduct(v []*Line) {
@@ -524,7 +733,11 @@ func (x *Specification) SetChildProduct(v []*Line) {
 
 func (x *Specification) SetStrategy(v PickupStrategy) {
 	x.xxx_hidden_Strategy = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 4)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 5)
+}
+
+func (x *Specification) SetTotals(v *Totals) {
+	x.xxx_hidden_Totals = v
 }
 
 func (x *Specification) HasProduct() bool {
@@ -548,6 +761,13 @@ func (x *Specification) HasStrategy() bool {
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
 }
 
+func (x *Specification) HasTotals() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Totals != nil
+}
+
 func (x *Specification) ClearProduct() {
 	x.xxx_hidden_Product = nil
 }
@@ -562,6 +782,10 @@ func (x *Specification) ClearStrategy() {
 	x.xxx_hidden_Strategy = PickupStrategy_NEAREST
 }
 
+func (x *Specification) ClearTotals() {
+	x.xxx_hidden_Totals = nil
+}
+
 type Specification_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
@@ -569,6 +793,7 @@ type Specification_builder struct {
 	Type         *SpecificationType
 	ChildProduct []*Line
 	Strategy     *PickupStrategy
+	Totals       *Totals
 }
 
 func (b0 Specification_builder) Build() *Specification {
@@ -577,27 +802,29 @@ func (b0 Specification_builder) Build() *Specification {
 	_, _ = b, x
 	x.xxx_hidden_Product = b.Product
 	if b.Type != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 4)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 5)
 		x.xxx_hidden_Type = *b.Type
 	}
 	x.xxx_hidden_ChildProduct = &b.ChildProduct
 	if b.Strategy != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 4)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 5)
 		x.xxx_hidden_Strategy = *b.Strategy
 	}
+	x.xxx_hidden_Totals = b.Totals
 	return m0
 }
 
 type SpecificationResponse struct {
 	state                     protoimpl.MessageState `protogen:"opaque.v1"`
 	xxx_hidden_Specifications *[]*Specification      `protobuf:"bytes,1,rep,name=specifications"`
+	xxx_hidden_Totals         *Totals                `protobuf:"bytes,2,opt,name=totals"`
 	unknownFields             protoimpl.UnknownFields
 	sizeCache                 protoimpl.SizeCache
 }
 
 func (x *SpecificationResponse) Reset() {
 	*x = SpecificationResponse{}
-	mi := &file_api_specification_proto_msgTypes[4]
+	mi := &file_api_specification_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -609,7 +836,7 @@ func (x *SpecificationResponse) String() string {
 func (*SpecificationResponse) ProtoMessage() {}
 
 func (x *SpecificationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_specification_proto_msgTypes[4]
+	mi := &file_api_specification_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -629,14 +856,37 @@ func (x *SpecificationResponse) GetSpecifications() []*Specification {
 	return nil
 }
 
+func (x *SpecificationResponse) GetTotals() *Totals {
+	if x != nil {
+		return x.xxx_hidden_Totals
+	}
+	return nil
+}
+
 func (x *SpecificationResponse) SetSpecifications(v []*Specification) {
 	x.xxx_hidden_Specifications = &v
 }
 
+func (x *SpecificationResponse) SetTotals(v *Totals) {
+	x.xxx_hidden_Totals = v
+}
+
+func (x *SpecificationResponse) HasTotals() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Totals != nil
+}
+
+func (x *SpecificationResponse) ClearTotals() {
+	x.xxx_hidden_Totals = nil
+}
+
 type SpecificationResponse_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
 	Specifications []*Specification
+	Totals         *Totals
 }
 
 func (b0 SpecificationResponse_builder) Build() *SpecificationResponse {
@@ -644,6 +894,7 @@ func (b0 SpecificationResponse_builder) Build() *SpecificationResponse {
 	b, x := &b0, m0
 	_, _ = b, x
 	x.xxx_hidden_Specifications = &b.Specifications
+	x.xxx_hidden_Totals = b.Totals
 	return m0
 }
 
@@ -655,20 +906,30 @@ const file_api_specification_proto_rawDesc = "" +
 	"\x11SpecificationLine\x12\x14\n" +
 	"\x05fnrec\x18\x01 \x01(\tR\x05fnrec\x12 \n" +
 	"\vintegration\x18\x02 \x01(\tR\vintegration\x12\x1a\n" +
-	"\bquantity\x18\x03 \x01(\x05R\bquantity\"d\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\"\x9d\x01\n" +
 	"\x14SpecificationRequest\x12L\n" +
-	"\x13specification_lines\x18\x01 \x03(\v2\x1b.products.SpecificationLineR\x12specificationLines\"\x85\x01\n" +
+	"\x13specification_lines\x18\x01 \x03(\v2\x1b.products.SpecificationLineR\x12specificationLines\x127\n" +
+	"\n" +
+	"duplicates\x18\x02 \x01(\x0e2\x17.products.DuplicateModeR\n" +
+	"duplicates\"\x85\x01\n" +
 	"\x04Line\x12+\n" +
 	"\aproduct\x18\x01 \x01(\v2\x11.products.ProductR\aproduct\x12\x1a\n" +
 	"\bquantity\x18\x02 \x01(\x05R\bquantity\x124\n" +
-	"\bstrategy\x18\x03 \x01(\x0e2\x18.products.PickupStrategyR\bstrategy\"\xd5\x01\n" +
+	"\bstrategy\x18\x03 \x01(\x0e2\x18.products.PickupStrategyR\bstrategy\"y\n" +
+	"\x06Totals\x12\x1a\n" +
+	"\bpackages\x18\x01 \x01(\x05R\bpackages\x12\x16\n" +
+	"\x06volume\x18\x02 \x01(\x01R\x06volume\x12\x16\n" +
+	"\x06weight\x18\x03 \x01(\x01R\x06weight\x12#\n" +
+	"\rmax_dimension\x18\x04 \x01(\x01R\fmaxDimension\"\xff\x01\n" +
 	"\rSpecification\x12(\n" +
 	"\aproduct\x18\x01 \x01(\v2\x0e.products.LineR\aproduct\x12/\n" +
 	"\x04type\x18\x02 \x01(\x0e2\x1b.products.SpecificationTypeR\x04type\x123\n" +
 	"\rchild_product\x18\x03 \x03(\v2\x0e.products.LineR\fchildProduct\x124\n" +
-	"\bstrategy\x18\x04 \x01(\x0e2\x18.products.PickupStrategyR\bstrategy\"X\n" +
+	"\bstrategy\x18\x04 \x01(\x0e2\x18.products.PickupStrategyR\bstrategy\x12(\n" +
+	"\x06totals\x18\x05 \x01(\v2\x10.products.TotalsR\x06totals\"\x82\x01\n" +
 	"\x15SpecificationResponse\x12?\n" +
-	"\x0especifications\x18\x01 \x03(\v2\x17.products.SpecificationR\x0especifications*U\n" +
+	"\x0especifications\x18\x01 \x03(\v2\x17.products.SpecificationR\x0especifications\x12(\n" +
+	"\x06totals\x18\x02 \x01(\v2\x10.products.TotalsR\x06totals*U\n" +
 	"\x11SpecificationType\x12\v\n" +
 	"\aDEFAULT\x10\x00\x12\x18\n" +
 	"\x14DIRECT_SPECIFICATION\x10\x01\x12\x19\n" +
@@ -680,33 +941,38 @@ const file_api_specification_proto_rawDesc = "" +
 	"\aExecute\x12\x1e.products.SpecificationRequest\x1a\x1f.products.SpecificationResponseB\x10Z\x06/proto\x92\x03\x05\xd2>\x02\x10\x03b\beditionsp\xe8\a"
 
 var file_api_specification_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_api_specification_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_api_specification_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_api_specification_proto_goTypes = []any{
 	(SpecificationType)(0),        // 0: products.SpecificationType
 	(PickupStrategy)(0),           // 1: products.PickupStrategy
 	(*SpecificationLine)(nil),     // 2: products.SpecificationLine
 	(*SpecificationRequest)(nil),  // 3: products.SpecificationRequest
 	(*Line)(nil),                  // 4: products.Line
-	(*Specification)(nil),         // 5: products.Specification
-	(*SpecificationResponse)(nil), // 6: products.SpecificationResponse
-	(*Product)(nil),               // 7: products.Product
+	(*Totals)(nil),                // 5: products.Totals
+	(*Specification)(nil),         // 6: products.Specification
+	(*SpecificationResponse)(nil), // 7: products.SpecificationResponse
+	(DuplicateMode)(0),            // 8: products.DuplicateMode
+	(*Product)(nil),               // 9: products.Product
 }
 var file_api_specification_proto_depIdxs = []int32{
-	2, // 0: products.SpecificationRequest.specification_lines:type_name -> products.SpecificationLine
-	7, // 1: products.Line.product:type_name -> products.Product
-	1, // 2: products.Line.strategy:type_name -> products.PickupStrategy
-	4, // 3: products.Specification.product:type_name -> products.Line
-	0, // 4: products.Specification.type:type_name -> products.SpecificationType
-	4, // 5: products.Specification.child_product:type_name -> products.Line
-	1, // 6: products.Specification.strategy:type_name -> products.PickupStrategy
-	5, // 7: products.SpecificationResponse.specifications:type_name -> products.Specification
-	3, // 8: products.SpecificationService.Execute:input_type -> products.SpecificationRequest
-	6, // 9: products.SpecificationService.Execute:output_type -> products.SpecificationResponse
-	9, // [9:10] is the sub-list for method output_type
-	8, // [8:9] is the sub-list for method input_type
-	8, // [8:8] is the sub-list for extension type_name
-	8, // [8:8] is the sub-list for extension extendee
-	0, // [0:8] is the sub-list for field type_name
+	2,  // 0: products.SpecificationRequest.specification_lines:type_name -> products.SpecificationLine
+	8,  // 1: products.SpecificationRequest.duplicates:type_name -> products.DuplicateMode
+	9,  // 2: products.Line.product:type_name -> products.Product
+	1,  // 3: products.Line.strategy:type_name -> products.PickupStrategy
+	4,  // 4: products.Specification.product:type_name -> products.Line
+	0,  // 5: products.Specification.type:type_name -> products.SpecificationType
+	4,  // 6: products.Specification.child_product:type_name -> products.Line
+	1,  // 7: products.Specification.strategy:type_name -> products.PickupStrategy
+	5,  // 8: products.Specification.totals:type_name -> products.Totals
+	6,  // 9: products.SpecificationResponse.specifications:type_name -> products.Specification
+	5,  // 10: products.SpecificationResponse.totals:type_name -> products.Totals
+	3,  // 11: products.SpecificationService.Execute:input_type -> products.SpecificationRequest
+	7,  // 12: products.SpecificationService.Execute:output_type -> products.SpecificationResponse
+	12, // [12:13] is the sub-list for method output_type
+	11, // [11:12] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_api_specification_proto_init() }
@@ -721,7 +987,7 @@ func file_api_specification_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_specification_proto_rawDesc), len(file_api_specification_proto_rawDesc)),
 			NumEnums:      2,
-			NumMessages:   5,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
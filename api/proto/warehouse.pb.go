@@ -10,6 +10,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	_ "google.golang.org/protobuf/types/gofeaturespb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	unsafe "unsafe"
 )
@@ -134,6 +135,496 @@ func (x WarehouseType) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
+type RejectReason int32
+
+const (
+	RejectReason_REJECT_REASON_UNSPECIFIED              RejectReason = 0
+	RejectReason_REJECT_REASON_NOT_FOUND                RejectReason = 1
+	RejectReason_REJECT_REASON_DESTINATION_NOT_IN_CHAIN RejectReason = 2
+	RejectReason_REJECT_REASON_OUTSIDE_GROUP            RejectReason = 3
+	RejectReason_REJECT_REASON_OVERSIZE                 RejectReason = 4
+)
+
+// Enum value maps for RejectReason.
+var (
+	RejectReason_name = map[int32]string{
+		0: "REJECT_REASON_UNSPECIFIED",
+		1: "REJECT_REASON_NOT_FOUND",
+		2: "REJECT_REASON_DESTINATION_NOT_IN_CHAIN",
+		3: "REJECT_REASON_OUTSIDE_GROUP",
+		4: "REJECT_REASON_OVERSIZE",
+	}
+	RejectReason_value = map[string]int32{
+		"REJECT_REASON_UNSPECIFIED":              0,
+		"REJECT_REASON_NOT_FOUND":                1,
+		"REJECT_REASON_DESTINATION_NOT_IN_CHAIN": 2,
+		"REJECT_REASON_OUTSIDE_GROUP":            3,
+		"REJECT_REASON_OVERSIZE":                 4,
+	}
+)
+
+func (x RejectReason) Enum() *RejectReason {
+	p := new(RejectReason)
+	*p = x
+	return p
+}
+
+func (x RejectReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RejectReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_warehouse_proto_enumTypes[1].Descriptor()
+}
+
+func (RejectReason) Type() protoreflect.EnumType {
+	return &file_api_warehouse_proto_enumTypes[1]
+}
+
+func (x RejectReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+type Objective int32
+
+const (
+	Objective_OBJECTIVE_UNSPECIFIED Objective = 0
+	Objective_OBJECTIVE_FASTEST     Objective = 1
+	Objective_OBJECTIVE_CHEAPEST    Objective = 2
+	Objective_OBJECTIVE_FEWEST_HOPS Objective = 3
+	Objective_OBJECTIVE_BALANCED    Objective = 4
+)
+
+// Enum value maps for Objective.
+var (
+	Objective_name = map[int32]string{
+		0: "OBJECTIVE_UNSPECIFIED",
+		1: "OBJECTIVE_FASTEST",
+		2: "OBJECTIVE_CHEAPEST",
+		3: "OBJECTIVE_FEWEST_HOPS",
+		4: "OBJECTIVE_BALANCED",
+	}
+	Objective_value = map[string]int32{
+		"OBJECTIVE_UNSPECIFIED": 0,
+		"OBJECTIVE_FASTEST":     1,
+		"OBJECTIVE_CHEAPEST":    2,
+		"OBJECTIVE_FEWEST_HOPS": 3,
+		"OBJECTIVE_BALANCED":    4,
+	}
+)
+
+func (x Objective) Enum() *Objective {
+	p := new(Objective)
+	*p = x
+	return p
+}
+
+func (x Objective) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Objective) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_warehouse_proto_enumTypes[2].Descriptor()
+}
+
+func (Objective) Type() protoreflect.EnumType {
+	return &file_api_warehouse_proto_enumTypes[2]
+}
+
+func (x Objective) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+type HandlingLimits struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_MaxLength   float64                `protobuf:"fixed64,1,opt,name=max_length,json=maxLength"`
+	xxx_hidden_MaxWidth    float64                `protobuf:"fixed64,2,opt,name=max_width,json=maxWidth"`
+	xxx_hidden_MaxHeight   float64                `protobuf:"fixed64,3,opt,name=max_height,json=maxHeight"`
+	xxx_hidden_MaxWeight   float64                `protobuf:"fixed64,4,opt,name=max_weight,json=maxWeight"`
+	xxx_hidden_MaxVolume   float64                `protobuf:"fixed64,5,opt,name=max_volume,json=maxVolume"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *HandlingLimits) Reset() {
+	*x = HandlingLimits{}
+	mi := &file_api_warehouse_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HandlingLimits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandlingLimits) ProtoMessage() {}
+
+func (x *HandlingLimits) ProtoReflect() protoreflect.Message {
+	mi := &file_api_warehouse_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *HandlingLimits) GetMaxLength() float64 {
+	if x != nil {
+		return x.xxx_hidden_MaxLength
+	}
+	return 0
+}
+
+func (x *HandlingLimits) GetMaxWidth() float64 {
+	if x != nil {
+		return x.xxx_hidden_MaxWidth
+	}
+	return 0
+}
+
+func (x *HandlingLimits) GetMaxHeight() float64 {
+	if x != nil {
+		return x.xxx_hidden_MaxHeight
+	}
+	return 0
+}
+
+func (x *HandlingLimits) GetMaxWeight() float64 {
+	if x != nil {
+		return x.xxx_hidden_MaxWeight
+	}
+	return 0
+}
+
+func (x *HandlingLimits) GetMaxVolume() float64 {
+	if x != nil {
+		return x.xxx_hidden_MaxVolume
+	}
+	return 0
+}
+
+func (x *HandlingLimits) SetMaxLength(v float64) {
+	x.xxx_hidden_MaxLength = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 5)
+}
+
+func (x *HandlingLimits) SetMaxWidth(v float64) {
+	x.xxx_hidden_MaxWidth = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 5)
+}
+
+func (x *HandlingLimits) SetMaxHeight(v float64) {
+	x.xxx_hidden_MaxHeight = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 5)
+}
+
+func (x *HandlingLimits) SetMaxWeight(v float64) {
+	x.xxx_hidden_MaxWeight = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 5)
+}
+
+func (x *HandlingLimits) SetMaxVolume(v float64) {
+	x.xxx_hidden_MaxVolume = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 5)
+}
+
+func (x *HandlingLimits) HasMaxLength() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *HandlingLimits) HasMaxWidth() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *HandlingLimits) HasMaxHeight() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *HandlingLimits) HasMaxWeight() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *HandlingLimits) HasMaxVolume() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *HandlingLimits) ClearMaxLength() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_MaxLength = 0
+}
+
+func (x *HandlingLimits) ClearMaxWidth() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_MaxWidth = 0
+}
+
+func (x *HandlingLimits) ClearMaxHeight() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_MaxHeight = 0
+}
+
+func (x *HandlingLimits) ClearMaxWeight() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_MaxWeight = 0
+}
+
+func (x *HandlingLimits) ClearMaxVolume() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_MaxVolume = 0
+}
+
+type HandlingLimits_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	MaxLength *float64
+	MaxWidth  *float64
+	MaxHeight *float64
+	MaxWeight *float64
+	MaxVolume *float64
+}
+
+func (b0 HandlingLimits_builder) Build() *HandlingLimits {
+	m0 := &HandlingLimits{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.MaxLength != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 5)
+		x.xxx_hidden_MaxLength = *b.MaxLength
+	}
+	if b.MaxWidth != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 5)
+		x.xxx_hidden_MaxWidth = *b.MaxWidth
+	}
+	if b.MaxHeight != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 5)
+		x.xxx_hidden_MaxHeight = *b.MaxHeight
+	}
+	if b.MaxWeight != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 5)
+		x.xxx_hidden_MaxWeight = *b.MaxWeight
+	}
+	if b.MaxVolume != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 5)
+		x.xxx_hidden_MaxVolume = *b.MaxVolume
+	}
+	return m0
+}
+
+type PackDimensions struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Length      float64                `protobuf:"fixed64,1,opt,name=length"`
+	xxx_hidden_Width       float64                `protobuf:"fixed64,2,opt,name=width"`
+	xxx_hidden_Height      float64                `protobuf:"fixed64,3,opt,name=height"`
+	xxx_hidden_Weight      float64                `protobuf:"fixed64,4,opt,name=weight"`
+	xxx_hidden_Volume      float64                `protobuf:"fixed64,5,opt,name=volume"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *PackDimensions) Reset() {
+	*x = PackDimensions{}
+	mi := &file_api_warehouse_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PackDimensions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PackDimensions) ProtoMessage() {}
+
+func (x *PackDimensions) ProtoReflect() protoreflect.Message {
+	mi := &file_api_warehouse_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *PackDimensions) GetLength() float64 {
+	if x != nil {
+		return x.xxx_hidden_Length
+	}
+	return 0
+}
+
+func (x *PackDimensions) GetWidth() float64 {
+	if x != nil {
+		return x.xxx_hidden_Width
+	}
+	return 0
+}
+
+func (x *PackDimensions) GetHeight() float64 {
+	if x != nil {
+		return x.xxx_hidden_Height
+	}
+	return 0
+}
+
+func (x *PackDimensions) GetWeight() float64 {
+	if x != nil {
+		return x.xxx_hidden_Weight
+	}
+	return 0
+}
+
+func (x *PackDimensions) GetVolume() float64 {
+	if x != nil {
+		return x.xxx_hidden_Volume
+	}
+	return 0
+}
+
+func (x *PackDimensions) SetLength(v float64) {
+	x.xxx_hidden_Length = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 5)
+}
+
+func (x *PackDimensions) SetWidth(v float64) {
+	x.xxx_hidden_Width = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 5)
+}
+
+func (x *PackDimensions) SetHeight(v float64) {
+	x.xxx_hidden_Height = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 5)
+}
+
+func (x *PackDimensions) SetWeight(v float64) {
+	x.xxx_hidden_Weight = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 5)
+}
+
+func (x *PackDimensions) SetVolume(v float64) {
+	x.xxx_hidden_Volume = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 5)
+}
+
+func (x *PackDimensions) HasLength() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *PackDimensions) HasWidth() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *PackDimensions) HasHeight() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *PackDimensions) HasWeight() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *PackDimensions) HasVolume() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *PackDimensions) ClearLength() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Length = 0
+}
+
+func (x *PackDimensions) ClearWidth() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Width = 0
+}
+
+func (x *PackDimensions) ClearHeight() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Height = 0
+}
+
+func (x *PackDimensions) ClearWeight() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Weight = 0
+}
+
+func (x *PackDimensions) ClearVolume() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_Volume = 0
+}
+
+type PackDimensions_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Length *float64
+	Width  *float64
+	Height *float64
+	Weight *float64
+	Volume *float64
+}
+
+func (b0 PackDimensions_builder) Build() *PackDimensions {
+	m0 := &PackDimensions{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Length != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 5)
+		x.xxx_hidden_Length = *b.Length
+	}
+	if b.Width != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 5)
+		x.xxx_hidden_Width = *b.Width
+	}
+	if b.Height != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 5)
+		x.xxx_hidden_Height = *b.Height
+	}
+	if b.Weight != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 5)
+		x.xxx_hidden_Weight = *b.Weight
+	}
+	if b.Volume != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 5)
+		x.xxx_hidden_Volume = *b.Volume
+	}
+	return m0
+}
+
 type Warehouse struct {
 	state                             protoimpl.MessageState `protogen:"opaque.v1"`
 	xxx_hidden_Id                     *string                `protobuf:"bytes,1,opt,name=id"`
@@ -145,27 +636,2721 @@ type Warehouse struct {
 	xxx_hidden_Address                *string                `protobuf:"bytes,7,opt,name=address"`
 	xxx_hidden_OnlyStockPickupAllowed bool                   `protobuf:"varint,8,opt,name=only_stock_pickup_allowed,json=onlyStockPickupAllowed"`
 	xxx_hidden_DescriptorGroup        *string                `protobuf:"bytes,9,opt,name=DescriptorGroup"`
+	xxx_hidden_Latitude               float64                `protobuf:"fixed64,10,opt,name=latitude"`
+	xxx_hidden_Longitude              float64                `protobuf:"fixed64,11,opt,name=longitude"`
+	xxx_hidden_HandlingLimits         *HandlingLimits        `protobuf:"bytes,12,opt,name=handling_limits,json=handlingLimits"`
 	XXX_raceDetectHookData            protoimpl.RaceDetectHookData
 	XXX_presence                      [1]uint32
 	unknownFields                     protoimpl.UnknownFields
 	sizeCache                         protoimpl.SizeCache
 }
 
-func (x *Warehouse) Reset() {
-	*x = Warehouse{}
-	mi := &file_api_warehouse_proto_msgTypes[0]
+func (x *Warehouse) Reset() {
+	*x = Warehouse{}
+	mi := &file_api_warehouse_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Warehouse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Warehouse) ProtoMessage() {}
+
+func (x *Warehouse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_warehouse_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Warehouse) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Warehouse) GetName() string {
+	if x != nil {
+		if x.xxx_hidden_Name != nil {
+			return *x.xxx_hidden_Name
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Warehouse) GetType() WarehouseType {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 2) {
+			return x.xxx_hidden_Type
+		}
+	}
+	return WarehouseType_UNRECOGNIZED
+}
+
+func (x *Warehouse) GetTimeZone() string {
+	if x != nil {
+		if x.xxx_hidden_TimeZone != nil {
+			return *x.xxx_hidden_TimeZone
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Warehouse) GetAvailableRest() bool {
+	if x != nil {
+		return x.xxx_hidden_AvailableRest
+	}
+	return false
+}
+
+func (x *Warehouse) GetLevel() int32 {
+	if x != nil {
+		return x.xxx_hidden_Level
+	}
+	return 0
+}
+
+func (x *Warehouse) GetAddress() string {
+	if x != nil {
+		if x.xxx_hidden_Address != nil {
+			return *x.xxx_hidden_Address
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Warehouse) GetOnlyStockPickupAllowed() bool {
+	if x != nil {
+		return x.xxx_hidden_OnlyStockPickupAllowed
+	}
+	return false
+}
+
+func (x *Warehouse) GetDescriptorGroup() string {
+	if x != nil {
+		if x.xxx_hidden_DescriptorGroup != nil {
+			return *x.xxx_hidden_DescriptorGroup
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Warehouse) GetLatitude() float64 {
+	if x != nil {
+		return x.xxx_hidden_Latitude
+	}
+	return 0
+}
+
+func (x *Warehouse) GetLongitude() float64 {
+	if x != nil {
+		return x.xxx_hidden_Longitude
+	}
+	return 0
+}
+
+func (x *Warehouse) GetHandlingLimits() *HandlingLimits {
+	if x != nil {
+		return x.xxx_hidden_HandlingLimits
+	}
+	return nil
+}
+
+func (x *Warehouse) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 12)
+}
+
+func (x *Warehouse) SetName(v string) {
+	x.xxx_hidden_Name = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 12)
+}
+
+func (x *Warehouse) SetType(v WarehouseType) {
+	x.xxx_hidden_Type = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 12)
+}
+
+func (x *Warehouse) SetTimeZone(v string) {
+	x.xxx_hidden_TimeZone = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 12)
+}
+
+func (x *Warehouse) SetAvailableRest(v bool) {
+	x.xxx_hidden_AvailableRest = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 12)
+}
+
+func (x *Warehouse) SetLevel(v int32) {
+	x.xxx_hidden_Level = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 12)
+}
+
+func (x *Warehouse) SetAddress(v string) {
+	x.xxx_hidden_Address = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 12)
+}
+
+func (x *Warehouse) SetOnlyStockPickupAllowed(v bool) {
+	x.xxx_hidden_OnlyStockPickupAllowed = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 12)
+}
+
+func (x *Warehouse) SetDescriptorGroup(v string) {
+	x.xxx_hidden_DescriptorGroup = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 8, 12)
+}
+
+func (x *Warehouse) SetLatitude(v float64) {
+	x.xxx_hidden_Latitude = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 9, 12)
+}
+
+func (x *Warehouse) SetLongitude(v float64) {
+	x.xxx_hidden_Longitude = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 10, 12)
+}
+
+func (x *Warehouse) SetHandlingLimits(v *HandlingLimits) {
+	x.xxx_hidden_HandlingLimits = v
+}
+
+func (x *Warehouse) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *Warehouse) HasName() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *Warehouse) HasType() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *Warehouse) HasTimeZone() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *Warehouse) HasAvailableRest() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *Warehouse) HasLevel() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+}
+
+func (x *Warehouse) HasAddress() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 6)
+}
+
+func (x *Warehouse) HasOnlyStockPickupAllowed() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 7)
+}
+
+func (x *Warehouse) HasDescriptorGroup() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 8)
+}
+
+func (x *Warehouse) HasLatitude() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 9)
+}
+
+func (x *Warehouse) HasLongitude() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 10)
+}
+
+func (x *Warehouse) HasHandlingLimits() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_HandlingLimits != nil
+}
+
+func (x *Warehouse) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+func (x *Warehouse) ClearName() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Name = nil
+}
+
+func (x *Warehouse) ClearType() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Type = WarehouseType_UNRECOGNIZED
+}
+
+func (x *Warehouse) ClearTimeZone() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_TimeZone = nil
+}
+
+func (x *Warehouse) ClearAvailableRest() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_AvailableRest = false
+}
+
+func (x *Warehouse) ClearLevel() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_Level = 0
+}
+
+func (x *Warehouse) ClearAddress() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 6)
+	x.xxx_hidden_Address = nil
+}
+
+func (x *Warehouse) ClearOnlyStockPickupAllowed() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 7)
+	x.xxx_hidden_OnlyStockPickupAllowed = false
+}
+
+func (x *Warehouse) ClearDescriptorGroup() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 8)
+	x.xxx_hidden_DescriptorGroup = nil
+}
+
+func (x *Warehouse) ClearLatitude() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 9)
+	x.xxx_hidden_Latitude = 0
+}
+
+func (x *Warehouse) ClearLongitude() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 10)
+	x.xxx_hidden_Longitude = 0
+}
+
+func (x *Warehouse) ClearHandlingLimits() {
+	x.xxx_hidden_HandlingLimits = nil
+}
+
+type Warehouse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id                     *string
+	Name                   *string
+	Type                   *WarehouseType
+	TimeZone               *string
+	AvailableRest          *bool
+	Level                  *int32
+	Address                *string
+	OnlyStockPickupAllowed *bool
+	DescriptorGroup        *string
+	Latitude               *float64
+	Longitude              *float64
+	HandlingLimits         *HandlingLimits
+}
+
+func (b0 Warehouse_builder) Build() *Warehouse {
+	m0 := &Warehouse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 12)
+		x.xxx_hidden_Id = b.Id
+	}
+	if b.Name != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 12)
+		x.xxx_hidden_Name = b.Name
+	}
+	if b.Type != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 12)
+		x.xxx_hidden_Type = *b.Type
+	}
+	if b.TimeZone != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 12)
+		x.xxx_hidden_TimeZone = b.TimeZone
+	}
+	if b.AvailableRest != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 12)
+		x.xxx_hidden_AvailableRest = *b.AvailableRest
+	}
+	if b.Level != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 12)
+		x.xxx_hidden_Level = *b.Level
+	}
+	if b.Address != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 12)
+		x.xxx_hidden_Address = b.Address
+	}
+	if b.OnlyStockPickupAllowed != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 12)
+		x.xxx_hidden_OnlyStockPickupAllowed = *b.OnlyStockPickupAllowed
+	}
+	if b.DescriptorGroup != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 8, 12)
+		x.xxx_hidden_DescriptorGroup = b.DescriptorGroup
+	}
+	if b.Latitude != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 9, 12)
+		x.xxx_hidden_Latitude = *b.Latitude
+	}
+	if b.Longitude != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 10, 12)
+		x.xxx_hidden_Longitude = *b.Longitude
+	}
+	x.xxx_hidden_HandlingLimits = b.HandlingLimits
+	return m0
+}
+
+type RejectedWarehouse struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_Reason      RejectReason           `protobuf:"varint,2,opt,name=reason,enum=warehouses.RejectReason"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *RejectedWarehouse) Reset() {
+	*x = RejectedWarehouse{}
+	mi := &file_api_warehouse_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectedWarehouse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectedWarehouse) ProtoMessage() {}
+
+func (x *RejectedWarehouse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_warehouse_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *RejectedWarehouse) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *RejectedWarehouse) GetReason() RejectReason {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 1) {
+			return x.xxx_hidden_Reason
+		}
+	}
+	return RejectReason_REJECT_REASON_UNSPECIFIED
+}
+
+func (x *RejectedWarehouse) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *RejectedWarehouse) SetReason(v RejectReason) {
+	x.xxx_hidden_Reason = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *RejectedWarehouse) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *RejectedWarehouse) HasReason() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *RejectedWarehouse) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+func (x *RejectedWarehouse) ClearReason() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Reason = RejectReason_REJECT_REASON_UNSPECIFIED
+}
+
+type RejectedWarehouse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id     *string
+	Reason *RejectReason
+}
+
+func (b0 RejectedWarehouse_builder) Build() *RejectedWarehouse {
+	m0 := &RejectedWarehouse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_Id = b.Id
+	}
+	if b.Reason != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_Reason = *b.Reason
+	}
+	return m0
+}
+
+type Path struct {
+	state                      protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Nodes           *[]*Warehouse          `protobuf:"bytes,1,rep,name=nodes"`
+	xxx_hidden_UsedWarehouseId *string                `protobuf:"bytes,2,opt,name=used_warehouse_id,json=usedWarehouseId"`
+	xxx_hidden_Rejected        *[]*RejectedWarehouse  `protobuf:"bytes,3,rep,name=rejected"`
+	xxx_hidden_Oversized       *[]*Warehouse          `protobuf:"bytes,4,rep,name=oversized"`
+	XXX_raceDetectHookData     protoimpl.RaceDetectHookData
+	XXX_presence               [1]uint32
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *Path) Reset() {
+	*x = Path{}
+	mi := &file_api_warehouse_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Path) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Path) ProtoMessage() {}
+
+func (x *Path) ProtoReflect() protoreflect.Message {
+	mi := &file_api_warehouse_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Path) GetNodes() []*Warehouse {
+	if x != nil {
+		if x.xxx_hidden_Nodes != nil {
+			return *x.xxx_hidden_Nodes
+		}
+	}
+	return nil
+}
+
+func (x *Path) GetUsedWarehouseId() string {
+	if x != nil {
+		if x.xxx_hidden_UsedWarehouseId != nil {
+			return *x.xxx_hidden_UsedWarehouseId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Path) GetRejected() []*RejectedWarehouse {
+	if x != nil {
+		if x.xxx_hidden_Rejected != nil {
+			return *x.xxx_hidden_Rejected
+		}
+	}
+	return nil
+}
+
+func (x *Path) GetOversized() []*Warehouse {
+	if x != nil {
+		if x.xxx_hidden_Oversized != nil {
+			return *x.xxx_hidden_Oversized
+		}
+	}
+	return nil
+}
+
+func (x *Path) SetNodes(v []*Warehouse) {
+	x.xxx_hidden_Nodes = &v
+}
+
+func (x *Path) SetUsedWarehouseId(v string) {
+	x.xxx_hidden_UsedWarehouseId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 4)
+}
+
+func (x *Path) SetRejected(v []*RejectedWarehouse) {
+	x.xxx_hidden_Rejected = &v
+}
+
+func (x *Path) SetOversized(v []*Warehouse) {
+	x.xxx_hidden_Oversized = &v
+}
+
+func (x *Path) HasUsedWarehouseId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *Path) ClearUsedWarehouseId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_UsedWarehouseId = nil
+}
+
+type Path_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Nodes           []*Warehouse
+	UsedWarehouseId *string
+	Rejected        []*RejectedWarehouse
+	Oversized       []*Warehouse
+}
+
+func (b0 Path_builder) Build() *Path {
+	m0 := &Path{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Nodes = &b.Nodes
+	if b.UsedWarehouseId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 4)
+		x.xxx_hidden_UsedWarehouseId = b.UsedWarehouseId
+	}
+	x.xxx_hidden_Rejected = &b.Rejected
+	x.xxx_hidden_Oversized = &b.Oversized
+	return m0
+}
+
+type GetPath struct {
+	state                           protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id                   *string                `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_DefaultWarehouseId   *string                `protobuf:"bytes,2,opt,name=default_warehouse_id,json=defaultWarehouseId"`
+	xxx_hidden_FallbackWarehouseIds []string               `protobuf:"bytes,3,rep,name=fallback_warehouse_ids,json=fallbackWarehouseIds"`
+	xxx_hidden_DescriptorGroup      *string                `protobuf:"bytes,4,opt,name=descriptor_group,json=descriptorGroup"`
+	xxx_hidden_AllowCrossGroup      bool                   `protobuf:"varint,5,opt,name=allow_cross_group,json=allowCrossGroup"`
+	xxx_hidden_Pack                 *PackDimensions        `protobuf:"bytes,6,opt,name=pack"`
+	XXX_raceDetectHookData          protoimpl.RaceDetectHookData
+	XXX_presence                    [1]uint32
+	unknownFields                   protoimpl.UnknownFields
+	sizeCache                       protoimpl.SizeCache
+}
+
+func (x *GetPath) Reset() {
+	*x = GetPath{}
+	mi := &file_api_warehouse_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPath) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPath) ProtoMessage() {}
+
+func (x *GetPath) ProtoReflect() protoreflect.Message {
+	mi := &file_api_warehouse_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetPath) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *GetPath) GetDefaultWarehouseId() string {
+	if x != nil {
+		if x.xxx_hidden_DefaultWarehouseId != nil {
+			return *x.xxx_hidden_DefaultWarehouseId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *GetPath) GetFallbackWarehouseIds() []string {
+	if x != nil {
+		return x.xxx_hidden_FallbackWarehouseIds
+	}
+	return nil
+}
+
+func (x *GetPath) GetDescriptorGroup() string {
+	if x != nil {
+		if x.xxx_hidden_DescriptorGroup != nil {
+			return *x.xxx_hidden_DescriptorGroup
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *GetPath) GetAllowCrossGroup() bool {
+	if x != nil {
+		return x.xxx_hidden_AllowCrossGroup
+	}
+	return false
+}
+
+func (x *GetPath) GetPack() *PackDimensions {
+	if x != nil {
+		return x.xxx_hidden_Pack
+	}
+	return nil
+}
+
+func (x *GetPath) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 6)
+}
+
+func (x *GetPath) SetDefaultWarehouseId(v string) {
+	x.xxx_hidden_DefaultWarehouseId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 6)
+}
+
+func (x *GetPath) SetFallbackWarehouseIds(v []string) {
+	x.xxx_hidden_FallbackWarehouseIds = v
+}
+
+func (x *GetPath) SetDescriptorGroup(v string) {
+	x.xxx_hidden_DescriptorGroup = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 6)
+}
+
+func (x *GetPath) SetAllowCrossGroup(v bool) {
+	x.xxx_hidden_AllowCrossGroup = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 6)
+}
+
+func (x *GetPath) SetPack(v *PackDimensions) {
+	x.xxx_hidden_Pack = v
+}
+
+func (x *GetPath) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *GetPath) HasDefaultWarehouseId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *GetPath) HasDescriptorGroup() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *GetPath) HasAllowCrossGroup() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *GetPath) HasPack() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Pack != nil
+}
+
+func (x *GetPath) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+func (x *GetPath) ClearDefaultWarehouseId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_DefaultWarehouseId = nil
+}
+
+func (x *GetPath) ClearDescriptorGroup() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_DescriptorGroup = nil
+}
+
+func (x *GetPath) ClearAllowCrossGroup() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_AllowCrossGroup = false
+}
+
+func (x *GetPath) ClearPack() {
+	x.xxx_hidden_Pack = nil
+}
+
+type GetPath_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id                   *string
+	DefaultWarehouseId   *string
+	FallbackWarehouseIds []string
+	DescriptorGroup      *string
+	AllowCrossGroup      *bool
+	Pack                 *PackDimensions
+}
+
+func (b0 GetPath_builder) Build() *GetPath {
+	m0 := &GetPath{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 6)
+		x.xxx_hidden_Id = b.Id
+	}
+	if b.DefaultWarehouseId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 6)
+		x.xxx_hidden_DefaultWarehouseId = b.DefaultWarehouseId
+	}
+	x.xxx_hidden_FallbackWarehouseIds = b.FallbackWarehouseIds
+	if b.DescriptorGroup != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 6)
+		x.xxx_hidden_DescriptorGroup = b.DescriptorGroup
+	}
+	if b.AllowCrossGroup != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 6)
+		x.xxx_hidden_AllowCrossGroup = *b.AllowCrossGroup
+	}
+	x.xxx_hidden_Pack = b.Pack
+	return m0
+}
+
+type DeliveryPathRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Node        *[]*Warehouse          `protobuf:"bytes,1,rep,name=node"`
+	xxx_hidden_From        *Warehouse             `protobuf:"bytes,2,opt,name=from"`
+	xxx_hidden_To          *Warehouse             `protobuf:"bytes,3,opt,name=to"`
+	xxx_hidden_Objective   Objective              `protobuf:"varint,4,opt,name=objective,enum=warehouses.Objective"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *DeliveryPathRequest) Reset() {
+	*x = DeliveryPathRequest{}
+	mi := &file_api_warehouse_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeliveryPathRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeliveryPathRequest) ProtoMessage() {}
+
+func (x *DeliveryPathRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_warehouse_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DeliveryPathRequest) GetNode() []*Warehouse {
+	if x != nil {
+		if x.xxx_hidden_Node != nil {
+			return *x.xxx_hidden_Node
+		}
+	}
+	return nil
+}
+
+func (x *DeliveryPathRequest) GetFrom() *Warehouse {
+	if x != nil {
+		return x.xxx_hidden_From
+	}
+	return nil
+}
+
+func (x *DeliveryPathRequest) GetTo() *Warehouse {
+	if x != nil {
+		return x.xxx_hidden_To
+	}
+	return nil
+}
+
+func (x *DeliveryPathRequest) GetObjective() Objective {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 3) {
+			return x.xxx_hidden_Objective
+		}
+	}
+	return Objective_OBJECTIVE_UNSPECIFIED
+}
+
+func (x *DeliveryPathRequest) SetNode(v []*Warehouse) {
+	x.xxx_hidden_Node = &v
+}
+
+func (x *DeliveryPathRequest) SetFrom(v *Warehouse) {
+	x.xxx_hidden_From = v
+}
+
+func (x *DeliveryPathRequest) SetTo(v *Warehouse) {
+	x.xxx_hidden_To = v
+}
+
+func (x *DeliveryPathRequest) SetObjective(v Objective) {
+	x.xxx_hidden_Objective = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 4)
+}
+
+func (x *DeliveryPathRequest) HasFrom() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_From != nil
+}
+
+func (x *DeliveryPathRequest) HasTo() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_To != nil
+}
+
+func (x *DeliveryPathRequest) HasObjective() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *DeliveryPathRequest) ClearFrom() {
+	x.xxx_hidden_From = nil
+}
+
+func (x *DeliveryPathRequest) ClearTo() {
+	x.xxx_hidden_To = nil
+}
+
+func (x *DeliveryPathRequest) ClearObjective() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Objective = Objective_OBJECTIVE_UNSPECIFIED
+}
+
+type DeliveryPathRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Node      []*Warehouse
+	From      *Warehouse
+	To        *Warehouse
+	Objective *Objective
+}
+
+func (b0 DeliveryPathRequest_builder) Build() *DeliveryPathRequest {
+	m0 := &DeliveryPathRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Node = &b.Node
+	x.xxx_hidden_From = b.From
+	x.xxx_hidden_To = b.To
+	if b.Objective != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 4)
+		x.xxx_hidden_Objective = *b.Objective
+	}
+	return m0
+}
+
+type DeliveryPath struct {
+	state                   protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_First        *Warehouse             `protobuf:"bytes,1,opt,name=first"`
+	xxx_hidden_Last         *Warehouse             `protobuf:"bytes,2,opt,name=last"`
+	xxx_hidden_Path         *Path                  `protobuf:"bytes,3,opt,name=path"`
+	xxx_hidden_TransitHours float64                `protobuf:"fixed64,4,opt,name=transit_hours,json=transitHours"`
+	xxx_hidden_Cost         float64                `protobuf:"fixed64,5,opt,name=cost"`
+	xxx_hidden_Hops         int32                  `protobuf:"varint,6,opt,name=hops"`
+	XXX_raceDetectHookData  protoimpl.RaceDetectHookData
+	XXX_presence            [1]uint32
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *DeliveryPath) Reset() {
+	*x = DeliveryPath{}
+	mi := &file_api_warehouse_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeliveryPath) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeliveryPath) ProtoMessage() {}
+
+func (x *DeliveryPath) ProtoReflect() protoreflect.Message {
+	mi := &file_api_warehouse_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DeliveryPath) GetFirst() *Warehouse {
+	if x != nil {
+		return x.xxx_hidden_First
+	}
+	return nil
+}
+
+func (x *DeliveryPath) GetLast() *Warehouse {
+	if x != nil {
+		return x.xxx_hidden_Last
+	}
+	return nil
+}
+
+func (x *DeliveryPath) GetPath() *Path {
+	if x != nil {
+		return x.xxx_hidden_Path
+	}
+	return nil
+}
+
+func (x *DeliveryPath) GetTransitHours() float64 {
+	if x != nil {
+		return x.xxx_hidden_TransitHours
+	}
+	return 0
+}
+
+func (x *DeliveryPath) GetCost() float64 {
+	if x != nil {
+		return x.xxx_hidden_Cost
+	}
+	return 0
+}
+
+func (x *DeliveryPath) GetHops() int32 {
+	if x != nil {
+		return x.xxx_hidden_Hops
+	}
+	return 0
+}
+
+func (x *DeliveryPath) SetFirst(v *Warehouse) {
+	x.xxx_hidden_First = v
+}
+
+func (x *DeliveryPath) SetLast(v *Warehouse) {
+	x.xxx_hidden_Last = v
+}
+
+func (x *DeliveryPath) SetPath(v *Path) {
+	x.xxx_hidden_Path = v
+}
+
+func (x *DeliveryPath) SetTransitHours(v float64) {
+	x.xxx_hidden_TransitHours = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 6)
+}
+
+func (x *DeliveryPath) SetCost(v float64) {
+	x.xxx_hidden_Cost = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 6)
+}
+
+func (x *DeliveryPath) SetHops(v int32) {
+	x.xxx_hidden_Hops = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 6)
+}
+
+func (x *DeliveryPath) HasFirst() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_First != nil
+}
+
+func (x *DeliveryPath) HasLast() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Last != nil
+}
+
+func (x *DeliveryPath) HasPath() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Path != nil
+}
+
+func (x *DeliveryPath) HasTransitHours() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *DeliveryPath) HasCost() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *DeliveryPath) HasHops() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+}
+
+func (x *DeliveryPath) ClearFirst() {
+	x.xxx_hidden_First = nil
+}
+
+func (x *DeliveryPath) ClearLast() {
+	x.xxx_hidden_Last = nil
+}
+
+func (x *DeliveryPath) ClearPath() {
+	x.xxx_hidden_Path = nil
+}
+
+func (x *DeliveryPath) ClearTransitHours() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_TransitHours = 0
+}
+
+func (x *DeliveryPath) ClearCost() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_Cost = 0
+}
+
+func (x *DeliveryPath) ClearHops() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_Hops = 0
+}
+
+type DeliveryPath_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	First        *Warehouse
+	Last         *Warehouse
+	Path         *Path
+	TransitHours *float64
+	Cost         *float64
+	Hops         *int32
+}
+
+func (b0 DeliveryPath_builder) Build() *DeliveryPath {
+	m0 := &DeliveryPath{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_First = b.First
+	x.xxx_hidden_Last = b.Last
+	x.xxx_hidden_Path = b.Path
+	if b.TransitHours != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 6)
+		x.xxx_hidden_TransitHours = *b.TransitHours
+	}
+	if b.Cost != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 6)
+		x.xxx_hidden_Cost = *b.Cost
+	}
+	if b.Hops != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 6)
+		x.xxx_hidden_Hops = *b.Hops
+	}
+	return m0
+}
+
+type DeliveryPathResult struct {
+	state                   protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Success      bool                   `protobuf:"varint,1,opt,name=success"`
+	xxx_hidden_DeliveryPath *DeliveryPath          `protobuf:"bytes,2,opt,name=delivery_path,json=deliveryPath"`
+	xxx_hidden_Alternatives *[]*DeliveryPath       `protobuf:"bytes,3,rep,name=alternatives"`
+	XXX_raceDetectHookData  protoimpl.RaceDetectHookData
+	XXX_presence            [1]uint32
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *DeliveryPathResult) Reset() {
+	*x = DeliveryPathResult{}
+	mi := &file_api_warehouse_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeliveryPathResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeliveryPathResult) ProtoMessage() {}
+
+func (x *DeliveryPathResult) ProtoReflect() protoreflect.Message {
+	mi := &file_api_warehouse_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DeliveryPathResult) GetSuccess() bool {
+	if x != nil {
+		return x.xxx_hidden_Success
+	}
+	return false
+}
+
+func (x *DeliveryPathResult) GetDeliveryPath() *DeliveryPath {
+	if x != nil {
+		return x.xxx_hidden_DeliveryPath
+	}
+	return nil
+}
+
+func (x *DeliveryPathResult) GetAlternatives() []*DeliveryPath {
+	if x != nil {
+		if x.xxx_hidden_Alternatives != nil {
+			return *x.xxx_hidden_Alternatives
+		}
+	}
+	return nil
+}
+
+func (x *DeliveryPathResult) SetSuccess(v bool) {
+	x.xxx_hidden_Success = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
+}
+
+func (x *DeliveryPathResult) SetDeliveryPath(v *DeliveryPath) {
+	x.xxx_hidden_DeliveryPath = v
+}
+
+func (x *DeliveryPathResult) SetAlternatives(v []*DeliveryPath) {
+	x.xxx_hidden_Alternatives = &v
+}
+
+func (x *DeliveryPathResult) HasSuccess() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *DeliveryPathResult) HasDeliveryPath() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_DeliveryPath != nil
+}
+
+func (x *DeliveryPathResult) ClearSuccess() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Success = false
+}
+
+func (x *DeliveryPathResult) ClearDeliveryPath() {
+	x.xxx_hidden_DeliveryPath = nil
+}
+
+type DeliveryPathResult_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Success      *bool
+	DeliveryPath *DeliveryPath
+	Alternatives []*DeliveryPath
+}
+
+func (b0 DeliveryPathResult_builder) Build() *DeliveryPathResult {
+	m0 := &DeliveryPathResult{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Success != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
+		x.xxx_hidden_Success = *b.Success
+	}
+	x.xxx_hidden_DeliveryPath = b.DeliveryPath
+	x.xxx_hidden_Alternatives = &b.Alternatives
+	return m0
+}
+
+type FindNearestWarehousesRequest struct {
+	state                        protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Latitude          float64                `protobuf:"fixed64,1,opt,name=latitude"`
+	xxx_hidden_Longitude         float64                `protobuf:"fixed64,2,opt,name=longitude"`
+	xxx_hidden_Limit             int32                  `protobuf:"varint,3,opt,name=limit"`
+	xxx_hidden_MaxDistanceKm     float64                `protobuf:"fixed64,4,opt,name=max_distance_km,json=maxDistanceKm"`
+	xxx_hidden_Types             []WarehouseType        `protobuf:"varint,5,rep,packed,name=types,enum=warehouses.WarehouseType"`
+	xxx_hidden_OnlyAvailableRest bool                   `protobuf:"varint,6,opt,name=only_available_rest,json=onlyAvailableRest"`
+	xxx_hidden_DescriptorGroup   *string                `protobuf:"bytes,7,opt,name=descriptor_group,json=descriptorGroup"`
+	XXX_raceDetectHookData       protoimpl.RaceDetectHookData
+	XXX_presence                 [1]uint32
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
+}
+
+func (x *FindNearestWarehousesRequest) Reset() {
+	*x = FindNearestWarehousesRequest{}
+	mi := &file_api_warehouse_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindNearestWarehousesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindNearestWarehousesRequest) ProtoMessage() {}
+
+func (x *FindNearestWarehousesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_warehouse_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *FindNearestWarehousesRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.xxx_hidden_Latitude
+	}
+	return 0
+}
+
+func (x *FindNearestWarehousesRequest) GetLongitude() float64 {
+	if x != nil {
+		return x.xxx_hidden_Longitude
+	}
+	return 0
+}
+
+func (x *FindNearestWarehousesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.xxx_hidden_Limit
+	}
+	return 0
+}
+
+func (x *FindNearestWarehousesRequest) GetMaxDistanceKm() float64 {
+	if x != nil {
+		return x.xxx_hidden_MaxDistanceKm
+	}
+	return 0
+}
+
+func (x *FindNearestWarehousesRequest) GetTypes() []WarehouseType {
+	if x != nil {
+		return x.xxx_hidden_Types
+	}
+	return nil
+}
+
+func (x *FindNearestWarehousesRequest) GetOnlyAvailableRest() bool {
+	if x != nil {
+		return x.xxx_hidden_OnlyAvailableRest
+	}
+	return false
+}
+
+func (x *FindNearestWarehousesRequest) GetDescriptorGroup() string {
+	if x != nil {
+		if x.xxx_hidden_DescriptorGroup != nil {
+			return *x.xxx_hidden_DescriptorGroup
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *FindNearestWarehousesRequest) SetLatitude(v float64) {
+	x.xxx_hidden_Latitude = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 7)
+}
+
+func (x *FindNearestWarehousesRequest) SetLongitude(v float64) {
+	x.xxx_hidden_Longitude = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 7)
+}
+
+func (x *FindNearestWarehousesRequest) SetLimit(v int32) {
+	x.xxx_hidden_Limit = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 7)
+}
+
+func (x *FindNearestWarehousesRequest) SetMaxDistanceKm(v float64) {
+	x.xxx_hidden_MaxDistanceKm = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 7)
+}
+
+func (x *FindNearestWarehousesRequest) SetTypes(v []WarehouseType) {
+	x.xxx_hidden_Types = v
+}
+
+func (x *FindNearestWarehousesRequest) SetOnlyAvailableRest(v bool) {
+	x.xxx_hidden_OnlyAvailableRest = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 7)
+}
+
+func (x *FindNearestWarehousesRequest) SetDescriptorGroup(v string) {
+	x.xxx_hidden_DescriptorGroup = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 7)
+}
+
+func (x *FindNearestWarehousesRequest) HasLatitude() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *FindNearestWarehousesRequest) HasLongitude() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *FindNearestWarehousesRequest) HasLimit() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *FindNearestWarehousesRequest) HasMaxDistanceKm() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *FindNearestWarehousesRequest) HasOnlyAvailableRest() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+}
+
+func (x *FindNearestWarehousesRequest) HasDescriptorGroup() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 6)
+}
+
+func (x *FindNearestWarehousesRequest) ClearLatitude() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Latitude = 0
+}
+
+func (x *FindNearestWarehousesRequest) ClearLongitude() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Longitude = 0
+}
+
+func (x *FindNearestWarehousesRequest) ClearLimit() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Limit = 0
+}
+
+func (x *FindNearestWarehousesRequest) ClearMaxDistanceKm() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_MaxDistanceKm = 0
+}
+
+func (x *FindNearestWarehousesRequest) ClearOnlyAvailableRest() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_OnlyAvailableRest = false
+}
+
+func (x *FindNearestWarehousesRequest) ClearDescriptorGroup() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 6)
+	x.xxx_hidden_DescriptorGroup = nil
+}
+
+type FindNearestWarehousesRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Latitude          *float64
+	Longitude         *float64
+	Limit             *int32
+	MaxDistanceKm     *float64
+	Types             []WarehouseType
+	OnlyAvailableRest *bool
+	DescriptorGroup   *string
+}
+
+func (b0 FindNearestWarehousesRequest_builder) Build() *FindNearestWarehousesRequest {
+	m0 := &FindNearestWarehousesRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Latitude != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 7)
+		x.xxx_hidden_Latitude = *b.Latitude
+	}
+	if b.Longitude != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 7)
+		x.xxx_hidden_Longitude = *b.Longitude
+	}
+	if b.Limit != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 7)
+		x.xxx_hidden_Limit = *b.Limit
+	}
+	if b.MaxDistanceKm != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 7)
+		x.xxx_hidden_MaxDistanceKm = *b.MaxDistanceKm
+	}
+	x.xxx_hidden_Types = b.Types
+	if b.OnlyAvailableRest != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 7)
+		x.xxx_hidden_OnlyAvailableRest = *b.OnlyAvailableRest
+	}
+	if b.DescriptorGroup != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 7)
+		x.xxx_hidden_DescriptorGroup = b.DescriptorGroup
+	}
+	return m0
+}
+
+type NearestWarehouse struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Warehouse   *Warehouse             `protobuf:"bytes,1,opt,name=warehouse"`
+	xxx_hidden_DistanceKm  float64                `protobuf:"fixed64,2,opt,name=distance_km,json=distanceKm"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *NearestWarehouse) Reset() {
+	*x = NearestWarehouse{}
+	mi := &file_api_warehouse_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NearestWarehouse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NearestWarehouse) ProtoMessage() {}
+
+func (x *NearestWarehouse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_warehouse_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *NearestWarehouse) GetWarehouse() *Warehouse {
+	if x != nil {
+		return x.xxx_hidden_Warehouse
+	}
+	return nil
+}
+
+func (x *NearestWarehouse) GetDistanceKm() float64 {
+	if x != nil {
+		return x.xxx_hidden_DistanceKm
+	}
+	return 0
+}
+
+func (x *NearestWarehouse) SetWarehouse(v *Warehouse) {
+	x.xxx_hidden_Warehouse = v
+}
+
+func (x *NearestWarehouse) SetDistanceKm(v float64) {
+	x.xxx_hidden_DistanceKm = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *NearestWarehouse) HasWarehouse() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Warehouse != nil
+}
+
+func (x *NearestWarehouse) HasDistanceKm() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *NearestWarehouse) ClearWarehouse() {
+	x.xxx_hidden_Warehouse = nil
+}
+
+func (x *NearestWarehouse) ClearDistanceKm() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_DistanceKm = 0
+}
+
+type NearestWarehouse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Warehouse  *Warehouse
+	DistanceKm *float64
+}
+
+func (b0 NearestWarehouse_builder) Build() *NearestWarehouse {
+	m0 := &NearestWarehouse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Warehouse = b.Warehouse
+	if b.DistanceKm != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_DistanceKm = *b.DistanceKm
+	}
+	return m0
+}
+
+type FindNearestWarehousesResponse struct {
+	state                 protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Warehouses *[]*NearestWarehouse   `protobuf:"bytes,1,rep,name=warehouses"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *FindNearestWarehousesResponse) Reset() {
+	*x = FindNearestWarehousesResponse{}
+	mi := &file_api_warehouse_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindNearestWarehousesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindNearestWarehousesResponse) ProtoMessage() {}
+
+func (x *FindNearestWarehousesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_warehouse_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *FindNearestWarehousesResponse) GetWarehouses() []*NearestWarehouse {
+	if x != nil {
+		if x.xxx_hidden_Warehouses != nil {
+			return *x.xxx_hidden_Warehouses
+		}
+	}
+	return nil
+}
+
+func (x *FindNearestWarehousesResponse) SetWarehouses(v []*NearestWarehouse) {
+	x.xxx_hidden_Warehouses = &v
+}
+
+type FindNearestWarehousesResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Warehouses []*NearestWarehouse
+}
+
+func (b0 FindNearestWarehousesResponse_builder) Build() *FindNearestWarehousesResponse {
+	m0 := &FindNearestWarehousesResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Warehouses = &b.Warehouses
+	return m0
+}
+
+type EdgeChange struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_FromId      *string                `protobuf:"bytes,1,opt,name=from_id,json=fromId"`
+	xxx_hidden_ToId        *string                `protobuf:"bytes,2,opt,name=to_id,json=toId"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *EdgeChange) Reset() {
+	*x = EdgeChange{}
+	mi := &file_api_warehouse_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EdgeChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EdgeChange) ProtoMessage() {}
+
+func (x *EdgeChange) ProtoReflect() protoreflect.Message {
+	mi := &file_api_warehouse_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *EdgeChange) GetFromId() string {
+	if x != nil {
+		if x.xxx_hidden_FromId != nil {
+			return *x.xxx_hidden_FromId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *EdgeChange) GetToId() string {
+	if x != nil {
+		if x.xxx_hidden_ToId != nil {
+			return *x.xxx_hidden_ToId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *EdgeChange) SetFromId(v string) {
+	x.xxx_hidden_FromId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *EdgeChange) SetToId(v string) {
+	x.xxx_hidden_ToId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *EdgeChange) HasFromId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *EdgeChange) HasToId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *EdgeChange) ClearFromId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_FromId = nil
+}
+
+func (x *EdgeChange) ClearToId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_ToId = nil
+}
+
+type EdgeChange_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	FromId *string
+	ToId   *string
+}
+
+func (b0 EdgeChange_builder) Build() *EdgeChange {
+	m0 := &EdgeChange{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.FromId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_FromId = b.FromId
+	}
+	if b.ToId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_ToId = b.ToId
+	}
+	return m0
+}
+
+// Changes take effect masks first, then removed edges, then added edges, so
+// an edge both removed and added stays. A scenario applied after another
+// overrides it the same way.
+type Scenario struct {
+	state                         protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Name               *string                `protobuf:"bytes,1,opt,name=name"`
+	xxx_hidden_MaskedWarehouseIds []string               `protobuf:"bytes,2,rep,name=masked_warehouse_ids,json=maskedWarehouseIds"`
+	xxx_hidden_AddedEdges         *[]*EdgeChange         `protobuf:"bytes,3,rep,name=added_edges,json=addedEdges"`
+	xxx_hidden_RemovedEdges       *[]*EdgeChange         `protobuf:"bytes,4,rep,name=removed_edges,json=removedEdges"`
+	XXX_raceDetectHookData        protoimpl.RaceDetectHookData
+	XXX_presence                  [1]uint32
+	unknownFields                 protoimpl.UnknownFields
+	sizeCache                     protoimpl.SizeCache
+}
+
+func (x *Scenario) Reset() {
+	*x = Scenario{}
+	mi := &file_api_warehouse_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Scenario) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Scenario) ProtoMessage() {}
+
+func (x *Scenario) ProtoReflect() protoreflect.Message {
+	mi := &file_api_warehouse_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Scenario) GetName() string {
+	if x != nil {
+		if x.xxx_hidden_Name != nil {
+			return *x.xxx_hidden_Name
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Scenario) GetMaskedWarehouseIds() []string {
+	if x != nil {
+		return x.xxx_hidden_MaskedWarehouseIds
+	}
+	return nil
+}
+
+func (x *Scenario) GetAddedEdges() []*EdgeChange {
+	if x != nil {
+		if x.xxx_hidden_AddedEdges != nil {
+			return *x.xxx_hidden_AddedEdges
+		}
+	}
+	return nil
+}
+
+func (x *Scenario) GetRemovedEdges() []*EdgeChange {
+	if x != nil {
+		if x.xxx_hidden_RemovedEdges != nil {
+			return *x.xxx_hidden_RemovedEdges
+		}
+	}
+	return nil
+}
+
+func (x *Scenario) SetName(v string) {
+	x.xxx_hidden_Name = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 4)
+}
+
+func (x *Scenario) SetMaskedWarehouseIds(v []string) {
+	x.xxx_hidden_MaskedWarehouseIds = v
+}
+
+func (x *Scenario) SetAddedEdges(v []*EdgeChange) {
+	x.xxx_hidden_AddedEdges = &v
+}
+
+func (x *Scenario) SetRemovedEdges(v []*EdgeChange) {
+	x.xxx_hidden_RemovedEdges = &v
+}
+
+func (x *Scenario) HasName() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *Scenario) ClearName() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Name = nil
+}
+
+type Scenario_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Name               *string
+	MaskedWarehouseIds []string
+	AddedEdges         []*EdgeChange
+	RemovedEdges       []*EdgeChange
+}
+
+func (b0 Scenario_builder) Build() *Scenario {
+	m0 := &Scenario{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Name != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 4)
+		x.xxx_hidden_Name = b.Name
+	}
+	x.xxx_hidden_MaskedWarehouseIds = b.MaskedWarehouseIds
+	x.xxx_hidden_AddedEdges = &b.AddedEdges
+	x.xxx_hidden_RemovedEdges = &b.RemovedEdges
+	return m0
+}
+
+type SimulatePathRequest struct {
+	state                   protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Path         *GetPath               `protobuf:"bytes,1,opt,name=path"`
+	xxx_hidden_ScenarioName *string                `protobuf:"bytes,2,opt,name=scenario_name,json=scenarioName"`
+	xxx_hidden_Scenario     *Scenario              `protobuf:"bytes,3,opt,name=scenario"`
+	XXX_raceDetectHookData  protoimpl.RaceDetectHookData
+	XXX_presence            [1]uint32
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *SimulatePathRequest) Reset() {
+	*x = SimulatePathRequest{}
+	mi := &file_api_warehouse_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimulatePathRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulatePathRequest) ProtoMessage() {}
+
+func (x *SimulatePathRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_warehouse_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *SimulatePathRequest) GetPath() *GetPath {
+	if x != nil {
+		return x.xxx_hidden_Path
+	}
+	return nil
+}
+
+func (x *SimulatePathRequest) GetScenarioName() string {
+	if x != nil {
+		if x.xxx_hidden_ScenarioName != nil {
+			return *x.xxx_hidden_ScenarioName
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *SimulatePathRequest) GetScenario() *Scenario {
+	if x != nil {
+		return x.xxx_hidden_Scenario
+	}
+	return nil
+}
+
+func (x *SimulatePathRequest) SetPath(v *GetPath) {
+	x.xxx_hidden_Path = v
+}
+
+func (x *SimulatePathRequest) SetScenarioName(v string) {
+	x.xxx_hidden_ScenarioName = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 3)
+}
+
+func (x *SimulatePathRequest) SetScenario(v *Scenario) {
+	x.xxx_hidden_Scenario = v
+}
+
+func (x *SimulatePathRequest) HasPath() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Path != nil
+}
+
+func (x *SimulatePathRequest) HasScenarioName() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *SimulatePathRequest) HasScenario() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Scenario != nil
+}
+
+func (x *SimulatePathRequest) ClearPath() {
+	x.xxx_hidden_Path = nil
+}
+
+func (x *SimulatePathRequest) ClearScenarioName() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_ScenarioName = nil
+}
+
+func (x *SimulatePathRequest) ClearScenario() {
+	x.xxx_hidden_Scenario = nil
+}
+
+type SimulatePathRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Path         *GetPath
+	ScenarioName *string
+	Scenario     *Scenario
+}
+
+func (b0 SimulatePathRequest_builder) Build() *SimulatePathRequest {
+	m0 := &SimulatePathRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Path = b.Path
+	if b.ScenarioName != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 3)
+		x.xxx_hidden_ScenarioName = b.ScenarioName
+	}
+	x.xxx_hidden_Scenario = b.Scenario
+	return m0
+}
+
+type PathOutcome struct {
+	state            protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Path  *Path                  `protobuf:"bytes,1,opt,name=path"`
+	xxx_hidden_Error *ErrorDetail           `protobuf:"bytes,2,opt,name=error"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *PathOutcome) Reset() {
+	*x = PathOutcome{}
+	mi := &file_api_warehouse_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PathOutcome) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PathOutcome) ProtoMessage() {}
+
+func (x *PathOutcome) ProtoReflect() protoreflect.Message {
+	mi := &file_api_warehouse_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *PathOutcome) GetPath() *Path {
+	if x != nil {
+		return x.xxx_hidden_Path
+	}
+	return nil
+}
+
+func (x *PathOutcome) GetError() *ErrorDetail {
+	if x != nil {
+		return x.xxx_hidden_Error
+	}
+	return nil
+}
+
+func (x *PathOutcome) SetPath(v *Path) {
+	x.xxx_hidden_Path = v
+}
+
+func (x *PathOutcome) SetError(v *ErrorDetail) {
+	x.xxx_hidden_Error = v
+}
+
+func (x *PathOutcome) HasPath() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Path != nil
+}
+
+func (x *PathOutcome) HasError() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Error != nil
+}
+
+func (x *PathOutcome) ClearPath() {
+	x.xxx_hidden_Path = nil
+}
+
+func (x *PathOutcome) ClearError() {
+	x.xxx_hidden_Error = nil
+}
+
+type PathOutcome_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Path  *Path
+	Error *ErrorDetail
+}
+
+func (b0 PathOutcome_builder) Build() *PathOutcome {
+	m0 := &PathOutcome{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Path = b.Path
+	x.xxx_hidden_Error = b.Error
+	return m0
+}
+
+type SimulatePathResponse struct {
+	state                protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Actual    *PathOutcome           `protobuf:"bytes,1,opt,name=actual"`
+	xxx_hidden_Simulated *PathOutcome           `protobuf:"bytes,2,opt,name=simulated"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *SimulatePathResponse) Reset() {
+	*x = SimulatePathResponse{}
+	mi := &file_api_warehouse_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimulatePathResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulatePathResponse) ProtoMessage() {}
+
+func (x *SimulatePathResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_warehouse_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *SimulatePathResponse) GetActual() *PathOutcome {
+	if x != nil {
+		return x.xxx_hidden_Actual
+	}
+	return nil
+}
+
+func (x *SimulatePathResponse) GetSimulated() *PathOutcome {
+	if x != nil {
+		return x.xxx_hidden_Simulated
+	}
+	return nil
+}
+
+func (x *SimulatePathResponse) SetActual(v *PathOutcome) {
+	x.xxx_hidden_Actual = v
+}
+
+func (x *SimulatePathResponse) SetSimulated(v *PathOutcome) {
+	x.xxx_hidden_Simulated = v
+}
+
+func (x *SimulatePathResponse) HasActual() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Actual != nil
+}
+
+func (x *SimulatePathResponse) HasSimulated() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Simulated != nil
+}
+
+func (x *SimulatePathResponse) ClearActual() {
+	x.xxx_hidden_Actual = nil
+}
+
+func (x *SimulatePathResponse) ClearSimulated() {
+	x.xxx_hidden_Simulated = nil
+}
+
+type SimulatePathResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Actual    *PathOutcome
+	Simulated *PathOutcome
+}
+
+func (b0 SimulatePathResponse_builder) Build() *SimulatePathResponse {
+	m0 := &SimulatePathResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Actual = b.Actual
+	x.xxx_hidden_Simulated = b.Simulated
+	return m0
+}
+
+type DeleteScenarioRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Name        *string                `protobuf:"bytes,1,opt,name=name"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *DeleteScenarioRequest) Reset() {
+	*x = DeleteScenarioRequest{}
+	mi := &file_api_warehouse_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteScenarioRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteScenarioRequest) ProtoMessage() {}
+
+func (x *DeleteScenarioRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_warehouse_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DeleteScenarioRequest) GetName() string {
+	if x != nil {
+		if x.xxx_hidden_Name != nil {
+			return *x.xxx_hidden_Name
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *DeleteScenarioRequest) SetName(v string) {
+	x.xxx_hidden_Name = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+}
+
+func (x *DeleteScenarioRequest) HasName() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *DeleteScenarioRequest) ClearName() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Name = nil
+}
+
+type DeleteScenarioRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Name *string
+}
+
+func (b0 DeleteScenarioRequest_builder) Build() *DeleteScenarioRequest {
+	m0 := &DeleteScenarioRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Name != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_Name = b.Name
+	}
+	return m0
+}
+
+type DeleteScenarioResponse struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Deleted     bool                   `protobuf:"varint,1,opt,name=deleted"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *DeleteScenarioResponse) Reset() {
+	*x = DeleteScenarioResponse{}
+	mi := &file_api_warehouse_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteScenarioResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteScenarioResponse) ProtoMessage() {}
+
+func (x *DeleteScenarioResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_warehouse_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DeleteScenarioResponse) GetDeleted() bool {
+	if x != nil {
+		return x.xxx_hidden_Deleted
+	}
+	return false
+}
+
+func (x *DeleteScenarioResponse) SetDeleted(v bool) {
+	x.xxx_hidden_Deleted = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+}
+
+func (x *DeleteScenarioResponse) HasDeleted() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *DeleteScenarioResponse) ClearDeleted() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Deleted = false
+}
+
+type DeleteScenarioResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Deleted *bool
+}
+
+func (b0 DeleteScenarioResponse_builder) Build() *DeleteScenarioResponse {
+	m0 := &DeleteScenarioResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Deleted != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_Deleted = *b.Deleted
+	}
+	return m0
+}
+
+type ListScenariosRequest struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListScenariosRequest) Reset() {
+	*x = ListScenariosRequest{}
+	mi := &file_api_warehouse_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScenariosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScenariosRequest) ProtoMessage() {}
+
+func (x *ListScenariosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_warehouse_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type ListScenariosRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 ListScenariosRequest_builder) Build() *ListScenariosRequest {
+	m0 := &ListScenariosRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type ListScenariosResponse struct {
+	state                protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Scenarios *[]*Scenario           `protobuf:"bytes,1,rep,name=scenarios"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ListScenariosResponse) Reset() {
+	*x = ListScenariosResponse{}
+	mi := &file_api_warehouse_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScenariosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScenariosResponse) ProtoMessage() {}
+
+func (x *ListScenariosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_warehouse_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListScenariosResponse) GetScenarios() []*Scenario {
+	if x != nil {
+		if x.xxx_hidden_Scenarios != nil {
+			return *x.xxx_hidden_Scenarios
+		}
+	}
+	return nil
+}
+
+func (x *ListScenariosResponse) SetScenarios(v []*Scenario) {
+	x.xxx_hidden_Scenarios = &v
+}
+
+type ListScenariosResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Scenarios []*Scenario
+}
+
+func (b0 ListScenariosResponse_builder) Build() *ListScenariosResponse {
+	m0 := &ListScenariosResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Scenarios = &b.Scenarios
+	return m0
+}
+
+type ImpactRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ImpactRequest) Reset() {
+	*x = ImpactRequest{}
+	mi := &file_api_warehouse_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImpactRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImpactRequest) ProtoMessage() {}
+
+func (x *ImpactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_warehouse_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ImpactRequest) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ImpactRequest) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+}
+
+func (x *ImpactRequest) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *ImpactRequest) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+type ImpactRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id *string
+}
+
+func (b0 ImpactRequest_builder) Build() *ImpactRequest {
+	m0 := &ImpactRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_Id = b.Id
+	}
+	return m0
+}
+
+type Impact struct {
+	state                 protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Warehouse  *Warehouse             `protobuf:"bytes,1,opt,name=warehouse"`
+	xxx_hidden_Dependents *[]*Warehouse          `protobuf:"bytes,2,rep,name=dependents"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *Impact) Reset() {
+	*x = Impact{}
+	mi := &file_api_warehouse_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Impact) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Impact) ProtoMessage() {}
+
+func (x *Impact) ProtoReflect() protoreflect.Message {
+	mi := &file_api_warehouse_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Impact) GetWarehouse() *Warehouse {
+	if x != nil {
+		return x.xxx_hidden_Warehouse
+	}
+	return nil
+}
+
+func (x *Impact) GetDependents() []*Warehouse {
+	if x != nil {
+		if x.xxx_hidden_Dependents != nil {
+			return *x.xxx_hidden_Dependents
+		}
+	}
+	return nil
+}
+
+func (x *Impact) SetWarehouse(v *Warehouse) {
+	x.xxx_hidden_Warehouse = v
+}
+
+func (x *Impact) SetDependents(v []*Warehouse) {
+	x.xxx_hidden_Dependents = &v
+}
+
+func (x *Impact) HasWarehouse() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Warehouse != nil
+}
+
+func (x *Impact) ClearWarehouse() {
+	x.xxx_hidden_Warehouse = nil
+}
+
+type Impact_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Warehouse  *Warehouse
+	Dependents []*Warehouse
+}
+
+func (b0 Impact_builder) Build() *Impact {
+	m0 := &Impact{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Warehouse = b.Warehouse
+	x.xxx_hidden_Dependents = &b.Dependents
+	return m0
+}
+
+type SinglePointsOfFailureRequest struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SinglePointsOfFailureRequest) Reset() {
+	*x = SinglePointsOfFailureRequest{}
+	mi := &file_api_warehouse_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Warehouse) String() string {
+func (x *SinglePointsOfFailureRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Warehouse) ProtoMessage() {}
+func (*SinglePointsOfFailureRequest) ProtoMessage() {}
 
-func (x *Warehouse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_warehouse_proto_msgTypes[0]
+func (x *SinglePointsOfFailureRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_warehouse_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -176,318 +3361,619 @@ func (x *Warehouse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *Warehouse) GetId() string {
+type SinglePointsOfFailureRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 SinglePointsOfFailureRequest_builder) Build() *SinglePointsOfFailureRequest {
+	m0 := &SinglePointsOfFailureRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type SinglePointsOfFailureResponse struct {
+	state            protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Items *[]*Impact             `protobuf:"bytes,1,rep,name=items"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SinglePointsOfFailureResponse) Reset() {
+	*x = SinglePointsOfFailureResponse{}
+	mi := &file_api_warehouse_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SinglePointsOfFailureResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SinglePointsOfFailureResponse) ProtoMessage() {}
+
+func (x *SinglePointsOfFailureResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_warehouse_proto_msgTypes[24]
 	if x != nil {
-		if x.xxx_hidden_Id != nil {
-			return *x.xxx_hidden_Id
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		return ""
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Warehouse) GetName() string {
+func (x *SinglePointsOfFailureResponse) GetItems() []*Impact {
 	if x != nil {
-		if x.xxx_hidden_Name != nil {
-			return *x.xxx_hidden_Name
+		if x.xxx_hidden_Items != nil {
+			return *x.xxx_hidden_Items
+		}
+	}
+	return nil
+}
+
+func (x *SinglePointsOfFailureResponse) SetItems(v []*Impact) {
+	x.xxx_hidden_Items = &v
+}
+
+type SinglePointsOfFailureResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Items []*Impact
+}
+
+func (b0 SinglePointsOfFailureResponse_builder) Build() *SinglePointsOfFailureResponse {
+	m0 := &SinglePointsOfFailureResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Items = &b.Items
+	return m0
+}
+
+type GroupStatistics struct {
+	state                      protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_DescriptorGroup *string                `protobuf:"bytes,1,opt,name=descriptor_group,json=descriptorGroup"`
+	xxx_hidden_Nodes           int32                  `protobuf:"varint,2,opt,name=nodes"`
+	xxx_hidden_Edges           int32                  `protobuf:"varint,3,opt,name=edges"`
+	xxx_hidden_CrossGroupEdges int32                  `protobuf:"varint,4,opt,name=cross_group_edges,json=crossGroupEdges"`
+	XXX_raceDetectHookData     protoimpl.RaceDetectHookData
+	XXX_presence               [1]uint32
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *GroupStatistics) Reset() {
+	*x = GroupStatistics{}
+	mi := &file_api_warehouse_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupStatistics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupStatistics) ProtoMessage() {}
+
+func (x *GroupStatistics) ProtoReflect() protoreflect.Message {
+	mi := &file_api_warehouse_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GroupStatistics) GetDescriptorGroup() string {
+	if x != nil {
+		if x.xxx_hidden_DescriptorGroup != nil {
+			return *x.xxx_hidden_DescriptorGroup
 		}
 		return ""
 	}
 	return ""
 }
 
-func (x *Warehouse) GetType() WarehouseType {
+func (x *GroupStatistics) GetNodes() int32 {
+	if x != nil {
+		return x.xxx_hidden_Nodes
+	}
+	return 0
+}
+
+func (x *GroupStatistics) GetEdges() int32 {
+	if x != nil {
+		return x.xxx_hidden_Edges
+	}
+	return 0
+}
+
+func (x *GroupStatistics) GetCrossGroupEdges() int32 {
+	if x != nil {
+		return x.xxx_hidden_CrossGroupEdges
+	}
+	return 0
+}
+
+func (x *GroupStatistics) SetDescriptorGroup(v string) {
+	x.xxx_hidden_DescriptorGroup = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 4)
+}
+
+func (x *GroupStatistics) SetNodes(v int32) {
+	x.xxx_hidden_Nodes = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 4)
+}
+
+func (x *GroupStatistics) SetEdges(v int32) {
+	x.xxx_hidden_Edges = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 4)
+}
+
+func (x *GroupStatistics) SetCrossGroupEdges(v int32) {
+	x.xxx_hidden_CrossGroupEdges = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 4)
+}
+
+func (x *GroupStatistics) HasDescriptorGroup() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *GroupStatistics) HasNodes() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *GroupStatistics) HasEdges() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *GroupStatistics) HasCrossGroupEdges() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *GroupStatistics) ClearDescriptorGroup() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_DescriptorGroup = nil
+}
+
+func (x *GroupStatistics) ClearNodes() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Nodes = 0
+}
+
+func (x *GroupStatistics) ClearEdges() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Edges = 0
+}
+
+func (x *GroupStatistics) ClearCrossGroupEdges() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_CrossGroupEdges = 0
+}
+
+type GroupStatistics_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	DescriptorGroup *string
+	Nodes           *int32
+	Edges           *int32
+	CrossGroupEdges *int32
+}
+
+func (b0 GroupStatistics_builder) Build() *GroupStatistics {
+	m0 := &GroupStatistics{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.DescriptorGroup != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 4)
+		x.xxx_hidden_DescriptorGroup = b.DescriptorGroup
+	}
+	if b.Nodes != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 4)
+		x.xxx_hidden_Nodes = *b.Nodes
+	}
+	if b.Edges != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 4)
+		x.xxx_hidden_Edges = *b.Edges
+	}
+	if b.CrossGroupEdges != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 4)
+		x.xxx_hidden_CrossGroupEdges = *b.CrossGroupEdges
+	}
+	return m0
+}
+
+type GroupStatisticsRequest struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupStatisticsRequest) Reset() {
+	*x = GroupStatisticsRequest{}
+	mi := &file_api_warehouse_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupStatisticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupStatisticsRequest) ProtoMessage() {}
+
+func (x *GroupStatisticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_warehouse_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type GroupStatisticsRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 GroupStatisticsRequest_builder) Build() *GroupStatisticsRequest {
+	m0 := &GroupStatisticsRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type GroupStatisticsResponse struct {
+	state             protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Groups *[]*GroupStatistics    `protobuf:"bytes,1,rep,name=groups"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GroupStatisticsResponse) Reset() {
+	*x = GroupStatisticsResponse{}
+	mi := &file_api_warehouse_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupStatisticsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupStatisticsResponse) ProtoMessage() {}
+
+func (x *GroupStatisticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_warehouse_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GroupStatisticsResponse) GetGroups() []*GroupStatistics {
+	if x != nil {
+		if x.xxx_hidden_Groups != nil {
+			return *x.xxx_hidden_Groups
+		}
+	}
+	return nil
+}
+
+func (x *GroupStatisticsResponse) SetGroups(v []*GroupStatistics) {
+	x.xxx_hidden_Groups = &v
+}
+
+type GroupStatisticsResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Groups []*GroupStatistics
+}
+
+func (b0 GroupStatisticsResponse_builder) Build() *GroupStatisticsResponse {
+	m0 := &GroupStatisticsResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Groups = &b.Groups
+	return m0
+}
+
+type LocalTime struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_WarehouseId *string                `protobuf:"bytes,1,opt,name=warehouse_id,json=warehouseId"`
+	xxx_hidden_TimeZone    *string                `protobuf:"bytes,2,opt,name=time_zone,json=timeZone"`
+	xxx_hidden_Time        *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=time"`
+	xxx_hidden_LocalTime   *string                `protobuf:"bytes,4,opt,name=local_time,json=localTime"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *LocalTime) Reset() {
+	*x = LocalTime{}
+	mi := &file_api_warehouse_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LocalTime) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocalTime) ProtoMessage() {}
+
+func (x *LocalTime) ProtoReflect() protoreflect.Message {
+	mi := &file_api_warehouse_proto_msgTypes[28]
 	if x != nil {
-		if protoimpl.X.Present(&(x.XXX_presence[0]), 2) {
-			return x.xxx_hidden_Type
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
 	}
-	return WarehouseType_UNRECOGNIZED
+	return mi.MessageOf(x)
 }
 
-func (x *Warehouse) GetTimeZone() string {
+func (x *LocalTime) GetWarehouseId() string {
 	if x != nil {
-		if x.xxx_hidden_TimeZone != nil {
-			return *x.xxx_hidden_TimeZone
+		if x.xxx_hidden_WarehouseId != nil {
+			return *x.xxx_hidden_WarehouseId
 		}
 		return ""
 	}
 	return ""
 }
 
-func (x *Warehouse) GetAvailableRest() bool {
-	if x != nil {
-		return x.xxx_hidden_AvailableRest
-	}
-	return false
-}
-
-func (x *Warehouse) GetLevel() int32 {
-	if x != nil {
-		return x.xxx_hidden_Level
-	}
-	return 0
-}
-
-func (x *Warehouse) GetAddress() string {
+func (x *LocalTime) GetTimeZone() string {
 	if x != nil {
-		if x.xxx_hidden_Address != nil {
-			return *x.xxx_hidden_Address
+		if x.xxx_hidden_TimeZone != nil {
+			return *x.xxx_hidden_TimeZone
 		}
 		return ""
 	}
 	return ""
 }
 
-func (x *Warehouse) GetOnlyStockPickupAllowed() bool {
+func (x *LocalTime) GetTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.xxx_hidden_OnlyStockPickupAllowed
+		return x.xxx_hidden_Time
 	}
-	return false
+	return nil
 }
 
-func (x *Warehouse) GetDescriptorGroup() string {
+func (x *LocalTime) GetLocalTime() string {
 	if x != nil {
-		if x.xxx_hidden_DescriptorGroup != nil {
-			return *x.xxx_hidden_DescriptorGroup
+		if x.xxx_hidden_LocalTime != nil {
+			return *x.xxx_hidden_LocalTime
 		}
 		return ""
 	}
 	return ""
 }
 
-func (x *Warehouse) SetId(v string) {
-	x.xxx_hidden_Id = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 9)
-}
-
-func (x *Warehouse) SetName(v string) {
-	x.xxx_hidden_Name = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 9)
-}
-
-func (x *Warehouse) SetType(v WarehouseType) {
-	x.xxx_hidden_Type = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 9)
+func (x *LocalTime) SetWarehouseId(v string) {
+	x.xxx_hidden_WarehouseId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 4)
 }
 
-func (x *Warehouse) SetTimeZone(v string) {
+func (x *LocalTime) SetTimeZone(v string) {
 	x.xxx_hidden_TimeZone = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 9)
-}
-
-func (x *Warehouse) SetAvailableRest(v bool) {
-	x.xxx_hidden_AvailableRest = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 9)
-}
-
-func (x *Warehouse) SetLevel(v int32) {
-	x.xxx_hidden_Level = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 9)
-}
-
-func (x *Warehouse) SetAddress(v string) {
-	x.xxx_hidden_Address = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 9)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 4)
 }
 
-func (x *Warehouse) SetOnlyStockPickupAllowed(v bool) {
-	x.xxx_hidden_OnlyStockPickupAllowed = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 9)
+func (x *LocalTime) SetTime(v *timestamppb.Timestamp) {
+	x.xxx_hidden_Time = v
 }
 
-func (x *Warehouse) SetDescriptorGroup(v string) {
-	x.xxx_hidden_DescriptorGroup = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 8, 9)
+func (x *LocalTime) SetLocalTime(v string) {
+	x.xxx_hidden_LocalTime = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 4)
 }
 
-func (x *Warehouse) HasId() bool {
+func (x *LocalTime) HasWarehouseId() bool {
 	if x == nil {
 		return false
 	}
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
 }
 
-func (x *Warehouse) HasName() bool {
+func (x *LocalTime) HasTimeZone() bool {
 	if x == nil {
 		return false
 	}
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
 }
 
-func (x *Warehouse) HasType() bool {
+func (x *LocalTime) HasTime() bool {
 	if x == nil {
 		return false
 	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+	return x.xxx_hidden_Time != nil
 }
 
-func (x *Warehouse) HasTimeZone() bool {
+func (x *LocalTime) HasLocalTime() bool {
 	if x == nil {
 		return false
 	}
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
 }
 
-func (x *Warehouse) HasAvailableRest() bool {
-	if x == nil {
-		return false
-	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+func (x *LocalTime) ClearWarehouseId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_WarehouseId = nil
 }
 
-func (x *Warehouse) HasLevel() bool {
-	if x == nil {
-		return false
-	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+func (x *LocalTime) ClearTimeZone() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_TimeZone = nil
 }
 
-func (x *Warehouse) HasAddress() bool {
-	if x == nil {
-		return false
-	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 6)
+func (x *LocalTime) ClearTime() {
+	x.xxx_hidden_Time = nil
 }
 
-func (x *Warehouse) HasOnlyStockPickupAllowed() bool {
-	if x == nil {
-		return false
-	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 7)
+func (x *LocalTime) ClearLocalTime() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_LocalTime = nil
 }
 
-func (x *Warehouse) HasDescriptorGroup() bool {
-	if x == nil {
-		return false
-	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 8)
+type LocalTime_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	WarehouseId *string
+	TimeZone    *string
+	Time        *timestamppb.Timestamp
+	LocalTime   *string
 }
 
-func (x *Warehouse) ClearId() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
-	x.xxx_hidden_Id = nil
+func (b0 LocalTime_builder) Build() *LocalTime {
+	m0 := &LocalTime{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.WarehouseId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 4)
+		x.xxx_hidden_WarehouseId = b.WarehouseId
+	}
+	if b.TimeZone != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 4)
+		x.xxx_hidden_TimeZone = b.TimeZone
+	}
+	x.xxx_hidden_Time = b.Time
+	if b.LocalTime != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 4)
+		x.xxx_hidden_LocalTime = b.LocalTime
+	}
+	return m0
 }
 
-func (x *Warehouse) ClearName() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
-	x.xxx_hidden_Name = nil
+type LocalNowRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_WarehouseId *string                `protobuf:"bytes,1,opt,name=warehouse_id,json=warehouseId"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
-func (x *Warehouse) ClearType() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
-	x.xxx_hidden_Type = WarehouseType_UNRECOGNIZED
+func (x *LocalNowRequest) Reset() {
+	*x = LocalNowRequest{}
+	mi := &file_api_warehouse_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Warehouse) ClearTimeZone() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
-	x.xxx_hidden_TimeZone = nil
+func (x *LocalNowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Warehouse) ClearAvailableRest() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
-	x.xxx_hidden_AvailableRest = false
+func (*LocalNowRequest) ProtoMessage() {}
+
+func (x *LocalNowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_warehouse_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *Warehouse) ClearLevel() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
-	x.xxx_hidden_Level = 0
+func (x *LocalNowRequest) GetWarehouseId() string {
+	if x != nil {
+		if x.xxx_hidden_WarehouseId != nil {
+			return *x.xxx_hidden_WarehouseId
+		}
+		return ""
+	}
+	return ""
 }
 
-func (x *Warehouse) ClearAddress() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 6)
-	x.xxx_hidden_Address = nil
+func (x *LocalNowRequest) SetWarehouseId(v string) {
+	x.xxx_hidden_WarehouseId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
 }
 
-func (x *Warehouse) ClearOnlyStockPickupAllowed() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 7)
-	x.xxx_hidden_OnlyStockPickupAllowed = false
+func (x *LocalNowRequest) HasWarehouseId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
 }
 
-func (x *Warehouse) ClearDescriptorGroup() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 8)
-	x.xxx_hidden_DescriptorGroup = nil
+func (x *LocalNowRequest) ClearWarehouseId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_WarehouseId = nil
 }
 
-type Warehouse_builder struct {
+type LocalNowRequest_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Id                     *string
-	Name                   *string
-	Type                   *WarehouseType
-	TimeZone               *string
-	AvailableRest          *bool
-	Level                  *int32
-	Address                *string
-	OnlyStockPickupAllowed *bool
-	DescriptorGroup        *string
+	WarehouseId *string
 }
 
-func (b0 Warehouse_builder) Build() *Warehouse {
-	m0 := &Warehouse{}
+func (b0 LocalNowRequest_builder) Build() *LocalNowRequest {
+	m0 := &LocalNowRequest{}
 	b, x := &b0, m0
 	_, _ = b, x
-	if b.Id != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 9)
-		x.xxx_hidden_Id = b.Id
-	}
-	if b.Name != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 9)
-		x.xxx_hidden_Name = b.Name
-	}
-	if b.Type != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 9)
-		x.xxx_hidden_Type = *b.Type
-	}
-	if b.TimeZone != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 9)
-		x.xxx_hidden_TimeZone = b.TimeZone
-	}
-	if b.AvailableRest != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 9)
-		x.xxx_hidden_AvailableRest = *b.AvailableRest
-	}
-	if b.Level != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 9)
-		x.xxx_hidden_Level = *b.Level
-	}
-	if b.Address != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 9)
-		x.xxx_hidden_Address = b.Address
-	}
-	if b.OnlyStockPickupAllowed != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 9)
-		x.xxx_hidden_OnlyStockPickupAllowed = *b.OnlyStockPickupAllowed
-	}
-	if b.DescriptorGroup != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 8, 9)
-		x.xxx_hidden_DescriptorGroup = b.DescriptorGroup
+	if b.WarehouseId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_WarehouseId = b.WarehouseId
 	}
 	return m0
 }
 
-type Path struct {
-	state            protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Nodes *[]*Warehouse          `protobuf:"bytes,1,rep,name=nodes"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type CutOffRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_WarehouseId *string                `protobuf:"bytes,1,opt,name=warehouse_id,json=warehouseId"`
+	xxx_hidden_CutOff      *string                `protobuf:"bytes,2,opt,name=cut_off,json=cutOff"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
-func (x *Path) Reset() {
-	*x = Path{}
-	mi := &file_api_warehouse_proto_msgTypes[1]
+func (x *CutOffRequest) Reset() {
+	*x = CutOffRequest{}
+	mi := &file_api_warehouse_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Path) String() string {
+func (x *CutOffRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Path) ProtoMessage() {}
+func (*CutOffRequest) ProtoMessage() {}
 
-func (x *Path) ProtoReflect() protoreflect.Message {
-	mi := &file_api_warehouse_proto_msgTypes[1]
+func (x *CutOffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_warehouse_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -498,58 +3984,108 @@ func (x *Path) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *Path) GetNodes() []*Warehouse {
+func (x *CutOffRequest) GetWarehouseId() string {
 	if x != nil {
-		if x.xxx_hidden_Nodes != nil {
-			return *x.xxx_hidden_Nodes
+		if x.xxx_hidden_WarehouseId != nil {
+			return *x.xxx_hidden_WarehouseId
 		}
+		return ""
 	}
-	return nil
+	return ""
 }
 
-func (x *Path) SetNodes(v []*Warehouse) {
-	x.xxx_hidden_Nodes = &v
+func (x *CutOffRequest) GetCutOff() string {
+	if x != nil {
+		if x.xxx_hidden_CutOff != nil {
+			return *x.xxx_hidden_CutOff
+		}
+		return ""
+	}
+	return ""
 }
 
-type Path_builder struct {
+func (x *CutOffRequest) SetWarehouseId(v string) {
+	x.xxx_hidden_WarehouseId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *CutOffRequest) SetCutOff(v string) {
+	x.xxx_hidden_CutOff = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *CutOffRequest) HasWarehouseId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *CutOffRequest) HasCutOff() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *CutOffRequest) ClearWarehouseId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_WarehouseId = nil
+}
+
+func (x *CutOffRequest) ClearCutOff() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_CutOff = nil
+}
+
+type CutOffRequest_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Nodes []*Warehouse
+	WarehouseId *string
+	CutOff      *string
 }
 
-func (b0 Path_builder) Build() *Path {
-	m0 := &Path{}
+func (b0 CutOffRequest_builder) Build() *CutOffRequest {
+	m0 := &CutOffRequest{}
 	b, x := &b0, m0
 	_, _ = b, x
-	x.xxx_hidden_Nodes = &b.Nodes
+	if b.WarehouseId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_WarehouseId = b.WarehouseId
+	}
+	if b.CutOff != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_CutOff = b.CutOff
+	}
 	return m0
 }
 
-type GetPath struct {
-	state                         protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Id                 *string                `protobuf:"bytes,1,opt,name=id"`
-	xxx_hidden_DefaultWarehouseId *string                `protobuf:"bytes,2,opt,name=default_warehouse_id,json=defaultWarehouseId"`
-	XXX_raceDetectHookData        protoimpl.RaceDetectHookData
-	XXX_presence                  [1]uint32
-	unknownFields                 protoimpl.UnknownFields
-	sizeCache                     protoimpl.SizeCache
+type CutOffResponse struct {
+	state                   protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_BeforeCutOff bool                   `protobuf:"varint,1,opt,name=before_cut_off,json=beforeCutOff"`
+	xxx_hidden_Now          *LocalTime             `protobuf:"bytes,2,opt,name=now"`
+	xxx_hidden_CutOff       *LocalTime             `protobuf:"bytes,3,opt,name=cut_off,json=cutOff"`
+	XXX_raceDetectHookData  protoimpl.RaceDetectHookData
+	XXX_presence            [1]uint32
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
 }
 
-func (x *GetPath) Reset() {
-	*x = GetPath{}
-	mi := &file_api_warehouse_proto_msgTypes[2]
+func (x *CutOffResponse) Reset() {
+	*x = CutOffResponse{}
+	mi := &file_api_warehouse_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPath) String() string {
+func (x *CutOffResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPath) ProtoMessage() {}
+func (*CutOffResponse) ProtoMessage() {}
 
-func (x *GetPath) ProtoReflect() protoreflect.Message {
-	mi := &file_api_warehouse_proto_msgTypes[2]
+func (x *CutOffResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_warehouse_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -560,106 +4096,120 @@ func (x *GetPath) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *GetPath) GetId() string {
+func (x *CutOffResponse) GetBeforeCutOff() bool {
 	if x != nil {
-		if x.xxx_hidden_Id != nil {
-			return *x.xxx_hidden_Id
-		}
-		return ""
+		return x.xxx_hidden_BeforeCutOff
 	}
-	return ""
+	return false
 }
 
-func (x *GetPath) GetDefaultWarehouseId() string {
+func (x *CutOffResponse) GetNow() *LocalTime {
 	if x != nil {
-		if x.xxx_hidden_DefaultWarehouseId != nil {
-			return *x.xxx_hidden_DefaultWarehouseId
-		}
-		return ""
+		return x.xxx_hidden_Now
 	}
-	return ""
+	return nil
 }
 
-func (x *GetPath) SetId(v string) {
-	x.xxx_hidden_Id = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+func (x *CutOffResponse) GetCutOff() *LocalTime {
+	if x != nil {
+		return x.xxx_hidden_CutOff
+	}
+	return nil
 }
 
-func (x *GetPath) SetDefaultWarehouseId(v string) {
-	x.xxx_hidden_DefaultWarehouseId = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+func (x *CutOffResponse) SetBeforeCutOff(v bool) {
+	x.xxx_hidden_BeforeCutOff = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
 }
 
-func (x *GetPath) HasId() bool {
+func (x *CutOffResponse) SetNow(v *LocalTime) {
+	x.xxx_hidden_Now = v
+}
+
+func (x *CutOffResponse) SetCutOff(v *LocalTime) {
+	x.xxx_hidden_CutOff = v
+}
+
+func (x *CutOffResponse) HasBeforeCutOff() bool {
 	if x == nil {
 		return false
 	}
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
 }
 
-func (x *GetPath) HasDefaultWarehouseId() bool {
+func (x *CutOffResponse) HasNow() bool {
 	if x == nil {
 		return false
 	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+	return x.xxx_hidden_Now != nil
 }
 
-func (x *GetPath) ClearId() {
+func (x *CutOffResponse) HasCutOff() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_CutOff != nil
+}
+
+func (x *CutOffResponse) ClearBeforeCutOff() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
-	x.xxx_hidden_Id = nil
+	x.xxx_hidden_BeforeCutOff = false
 }
 
-func (x *GetPath) ClearDefaultWarehouseId() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
-	x.xxx_hidden_DefaultWarehouseId = nil
+func (x *CutOffResponse) ClearNow() {
+	x.xxx_hidden_Now = nil
 }
 
-type GetPath_builder struct {
+func (x *CutOffResponse) ClearCutOff() {
+	x.xxx_hidden_CutOff = nil
+}
+
+type CutOffResponse_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Id                 *string
-	DefaultWarehouseId *string
+	BeforeCutOff *bool
+	Now          *LocalTime
+	CutOff       *LocalTime
 }
 
-func (b0 GetPath_builder) Build() *GetPath {
-	m0 := &GetPath{}
+func (b0 CutOffResponse_builder) Build() *CutOffResponse {
+	m0 := &CutOffResponse{}
 	b, x := &b0, m0
 	_, _ = b, x
-	if b.Id != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
-		x.xxx_hidden_Id = b.Id
-	}
-	if b.DefaultWarehouseId != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
-		x.xxx_hidden_DefaultWarehouseId = b.DefaultWarehouseId
+	if b.BeforeCutOff != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
+		x.xxx_hidden_BeforeCutOff = *b.BeforeCutOff
 	}
+	x.xxx_hidden_Now = b.Now
+	x.xxx_hidden_CutOff = b.CutOff
 	return m0
 }
 
-type DeliveryPathRequest struct {
-	state           protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Node *[]*Warehouse          `protobuf:"bytes,1,rep,name=node"`
-	xxx_hidden_From *Warehouse             `protobuf:"bytes,2,opt,name=from"`
-	xxx_hidden_To   *Warehouse             `protobuf:"bytes,3,opt,name=to"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type NextBusinessDayRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_WarehouseId *string                `protobuf:"bytes,1,opt,name=warehouse_id,json=warehouseId"`
+	xxx_hidden_From        *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
-func (x *DeliveryPathRequest) Reset() {
-	*x = DeliveryPathRequest{}
-	mi := &file_api_warehouse_proto_msgTypes[3]
+func (x *NextBusinessDayRequest) Reset() {
+	*x = NextBusinessDayRequest{}
+	mi := &file_api_warehouse_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeliveryPathRequest) String() string {
+func (x *NextBusinessDayRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeliveryPathRequest) ProtoMessage() {}
+func (*NextBusinessDayRequest) ProtoMessage() {}
 
-func (x *DeliveryPathRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_warehouse_proto_msgTypes[3]
+func (x *NextBusinessDayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_warehouse_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -670,105 +4220,100 @@ func (x *DeliveryPathRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *DeliveryPathRequest) GetNode() []*Warehouse {
+func (x *NextBusinessDayRequest) GetWarehouseId() string {
 	if x != nil {
-		if x.xxx_hidden_Node != nil {
-			return *x.xxx_hidden_Node
+		if x.xxx_hidden_WarehouseId != nil {
+			return *x.xxx_hidden_WarehouseId
 		}
+		return ""
 	}
-	return nil
+	return ""
 }
 
-func (x *DeliveryPathRequest) GetFrom() *Warehouse {
+func (x *NextBusinessDayRequest) GetFrom() *timestamppb.Timestamp {
 	if x != nil {
 		return x.xxx_hidden_From
 	}
 	return nil
 }
 
-func (x *DeliveryPathRequest) GetTo() *Warehouse {
-	if x != nil {
-		return x.xxx_hidden_To
-	}
-	return nil
-}
-
-func (x *DeliveryPathRequest) SetNode(v []*Warehouse) {
-	x.xxx_hidden_Node = &v
+func (x *NextBusinessDayRequest) SetWarehouseId(v string) {
+	x.xxx_hidden_WarehouseId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
 }
 
-func (x *DeliveryPathRequest) SetFrom(v *Warehouse) {
+func (x *NextBusinessDayRequest) SetFrom(v *timestamppb.Timestamp) {
 	x.xxx_hidden_From = v
 }
 
-func (x *DeliveryPathRequest) SetTo(v *Warehouse) {
-	x.xxx_hidden_To = v
-}
-
-func (x *DeliveryPathRequest) HasFrom() bool {
+func (x *NextBusinessDayRequest) HasWarehouseId() bool {
 	if x == nil {
 		return false
 	}
-	return x.xxx_hidden_From != nil
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
 }
 
-func (x *DeliveryPathRequest) HasTo() bool {
+func (x *NextBusinessDayRequest) HasFrom() bool {
 	if x == nil {
 		return false
 	}
-	return x.xxx_hidden_To != nil
+	return x.xxx_hidden_From != nil
 }
 
-func (x *DeliveryPathRequest) ClearFrom() {
-	x.xxx_hidden_From = nil
+func (x *NextBusinessDayRequest) ClearWarehouseId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_WarehouseId = nil
 }
 
-func (x *DeliveryPathRequest) ClearTo() {
-	x.xxx_hidden_To = nil
+func (x *NextBusinessDayRequest) ClearFrom() {
+	x.xxx_hidden_From = nil
 }
 
-type DeliveryPathRequest_builder struct {
+type NextBusinessDayRequest_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Node []*Warehouse
-	From *Warehouse
-	To   *Warehouse
+	WarehouseId *string
+	From        *timestamppb.Timestamp
 }
 
-func (b0 DeliveryPathRequest_builder) Build() *DeliveryPathRequest {
-	m0 := &DeliveryPathRequest{}
+func (b0 NextBusinessDayRequest_builder) Build() *NextBusinessDayRequest {
+	m0 := &NextBusinessDayRequest{}
 	b, x := &b0, m0
 	_, _ = b, x
-	x.xxx_hidden_Node = &b.Node
+	if b.WarehouseId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_WarehouseId = b.WarehouseId
+	}
 	x.xxx_hidden_From = b.From
-	x.xxx_hidden_To = b.To
 	return m0
 }
 
-type DeliveryPath struct {
-	state            protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_First *Warehouse             `protobuf:"bytes,1,opt,name=first"`
-	xxx_hidden_Last  *Warehouse             `protobuf:"bytes,2,opt,name=last"`
-	xxx_hidden_Path  *Path                  `protobuf:"bytes,3,opt,name=path"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type ConvertTimeRequest struct {
+	state                      protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_FromWarehouseId *string                `protobuf:"bytes,1,opt,name=from_warehouse_id,json=fromWarehouseId"`
+	xxx_hidden_ToWarehouseId   *string                `protobuf:"bytes,2,opt,name=to_warehouse_id,json=toWarehouseId"`
+	xxx_hidden_LocalTime       *string                `protobuf:"bytes,3,opt,name=local_time,json=localTime"`
+	XXX_raceDetectHookData     protoimpl.RaceDetectHookData
+	XXX_presence               [1]uint32
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
 }
 
-func (x *DeliveryPath) Reset() {
-	*x = DeliveryPath{}
-	mi := &file_api_warehouse_proto_msgTypes[4]
+func (x *ConvertTimeRequest) Reset() {
+	*x = ConvertTimeRequest{}
+	mi := &file_api_warehouse_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeliveryPath) String() string {
+func (x *ConvertTimeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeliveryPath) ProtoMessage() {}
+func (*ConvertTimeRequest) ProtoMessage() {}
 
-func (x *DeliveryPath) ProtoReflect() protoreflect.Message {
-	mi := &file_api_warehouse_proto_msgTypes[4]
+func (x *ConvertTimeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_warehouse_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -779,115 +4324,137 @@ func (x *DeliveryPath) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *DeliveryPath) GetFirst() *Warehouse {
+func (x *ConvertTimeRequest) GetFromWarehouseId() string {
 	if x != nil {
-		return x.xxx_hidden_First
+		if x.xxx_hidden_FromWarehouseId != nil {
+			return *x.xxx_hidden_FromWarehouseId
+		}
+		return ""
 	}
-	return nil
+	return ""
 }
 
-func (x *DeliveryPath) GetLast() *Warehouse {
+func (x *ConvertTimeRequest) GetToWarehouseId() string {
 	if x != nil {
-		return x.xxx_hidden_Last
+		if x.xxx_hidden_ToWarehouseId != nil {
+			return *x.xxx_hidden_ToWarehouseId
+		}
+		return ""
 	}
-	return nil
+	return ""
 }
 
-func (x *DeliveryPath) GetPath() *Path {
+func (x *ConvertTimeRequest) GetLocalTime() string {
 	if x != nil {
-		return x.xxx_hidden_Path
+		if x.xxx_hidden_LocalTime != nil {
+			return *x.xxx_hidden_LocalTime
+		}
+		return ""
 	}
-	return nil
+	return ""
 }
 
-func (x *DeliveryPath) SetFirst(v *Warehouse) {
-	x.xxx_hidden_First = v
+func (x *ConvertTimeRequest) SetFromWarehouseId(v string) {
+	x.xxx_hidden_FromWarehouseId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
 }
 
-func (x *DeliveryPath) SetLast(v *Warehouse) {
-	x.xxx_hidden_Last = v
+func (x *ConvertTimeRequest) SetToWarehouseId(v string) {
+	x.xxx_hidden_ToWarehouseId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 3)
 }
 
-func (x *DeliveryPath) SetPath(v *Path) {
-	x.xxx_hidden_Path = v
+func (x *ConvertTimeRequest) SetLocalTime(v string) {
+	x.xxx_hidden_LocalTime = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 3)
 }
 
-func (x *DeliveryPath) HasFirst() bool {
+func (x *ConvertTimeRequest) HasFromWarehouseId() bool {
 	if x == nil {
 		return false
 	}
-	return x.xxx_hidden_First != nil
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
 }
 
-func (x *DeliveryPath) HasLast() bool {
+func (x *ConvertTimeRequest) HasToWarehouseId() bool {
 	if x == nil {
 		return false
 	}
-	return x.xxx_hidden_Last != nil
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
 }
 
-func (x *DeliveryPath) HasPath() bool {
+func (x *ConvertTimeRequest) HasLocalTime() bool {
 	if x == nil {
 		return false
 	}
-	return x.xxx_hidden_Path != nil
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
 }
 
-func (x *DeliveryPath) ClearFirst() {
-	x.xxx_hidden_First = nil
+func (x *ConvertTimeRequest) ClearFromWarehouseId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_FromWarehouseId = nil
 }
 
-func (x *DeliveryPath) ClearLast() {
-	x.xxx_hidden_Last = nil
+func (x *ConvertTimeRequest) ClearToWarehouseId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_ToWarehouseId = nil
 }
 
-func (x *DeliveryPath) ClearPath() {
-	x.xxx_hidden_Path = nil
+func (x *ConvertTimeRequest) ClearLocalTime() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_LocalTime = nil
 }
 
-type DeliveryPath_builder struct {
+type ConvertTimeRequest_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	First *Warehouse
-	Last  *Warehouse
-	Path  *Path
+	FromWarehouseId *string
+	ToWarehouseId   *string
+	LocalTime       *string
 }
 
-func (b0 DeliveryPath_builder) Build() *DeliveryPath {
-	m0 := &DeliveryPath{}
+func (b0 ConvertTimeRequest_builder) Build() *ConvertTimeRequest {
+	m0 := &ConvertTimeRequest{}
 	b, x := &b0, m0
 	_, _ = b, x
-	x.xxx_hidden_First = b.First
-	x.xxx_hidden_Last = b.Last
-	x.xxx_hidden_Path = b.Path
+	if b.FromWarehouseId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
+		x.xxx_hidden_FromWarehouseId = b.FromWarehouseId
+	}
+	if b.ToWarehouseId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 3)
+		x.xxx_hidden_ToWarehouseId = b.ToWarehouseId
+	}
+	if b.LocalTime != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 3)
+		x.xxx_hidden_LocalTime = b.LocalTime
+	}
 	return m0
 }
 
-type DeliveryPathResult struct {
-	state                   protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Success      bool                   `protobuf:"varint,1,opt,name=success"`
-	xxx_hidden_DeliveryPath *DeliveryPath          `protobuf:"bytes,2,opt,name=delivery_path,json=deliveryPath"`
-	XXX_raceDetectHookData  protoimpl.RaceDetectHookData
-	XXX_presence            [1]uint32
-	unknownFields           protoimpl.UnknownFields
-	sizeCache               protoimpl.SizeCache
+type ConvertTimeResponse struct {
+	state           protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_From *LocalTime             `protobuf:"bytes,1,opt,name=from"`
+	xxx_hidden_To   *LocalTime             `protobuf:"bytes,2,opt,name=to"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *DeliveryPathResult) Reset() {
-	*x = DeliveryPathResult{}
-	mi := &file_api_warehouse_proto_msgTypes[5]
+func (x *ConvertTimeResponse) Reset() {
+	*x = ConvertTimeResponse{}
+	mi := &file_api_warehouse_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeliveryPathResult) String() string {
+func (x *ConvertTimeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeliveryPathResult) ProtoMessage() {}
+func (*ConvertTimeResponse) ProtoMessage() {}
 
-func (x *DeliveryPathResult) ProtoReflect() protoreflect.Message {
-	mi := &file_api_warehouse_proto_msgTypes[5]
+func (x *ConvertTimeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_warehouse_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -898,68 +4465,63 @@ func (x *DeliveryPathResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *DeliveryPathResult) GetSuccess() bool {
+func (x *ConvertTimeResponse) GetFrom() *LocalTime {
 	if x != nil {
-		return x.xxx_hidden_Success
+		return x.xxx_hidden_From
 	}
-	return false
+	return nil
 }
 
-func (x *DeliveryPathResult) GetDeliveryPath() *DeliveryPath {
+func (x *ConvertTimeResponse) GetTo() *LocalTime {
 	if x != nil {
-		return x.xxx_hidden_DeliveryPath
+		return x.xxx_hidden_To
 	}
 	return nil
 }
 
-func (x *DeliveryPathResult) SetSuccess(v bool) {
-	x.xxx_hidden_Success = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+func (x *ConvertTimeResponse) SetFrom(v *LocalTime) {
+	x.xxx_hidden_From = v
 }
 
-func (x *DeliveryPathResult) SetDeliveryPath(v *DeliveryPath) {
-	x.xxx_hidden_DeliveryPath = v
+func (x *ConvertTimeResponse) SetTo(v *LocalTime) {
+	x.xxx_hidden_To = v
 }
 
-func (x *DeliveryPathResult) HasSuccess() bool {
+func (x *ConvertTimeResponse) HasFrom() bool {
 	if x == nil {
 		return false
 	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+	return x.xxx_hidden_From != nil
 }
 
-func (x *DeliveryPathResult) HasDeliveryPath() bool {
+func (x *ConvertTimeResponse) HasTo() bool {
 	if x == nil {
 		return false
 	}
-	return x.xxx_hidden_DeliveryPath != nil
+	return x.xxx_hidden_To != nil
 }
 
-func (x *DeliveryPathResult) ClearSuccess() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
-	x.xxx_hidden_Success = false
+func (x *ConvertTimeResponse) ClearFrom() {
+	x.xxx_hidden_From = nil
 }
 
-func (x *DeliveryPathResult) ClearDeliveryPath() {
-	x.xxx_hidden_DeliveryPath = nil
+func (x *ConvertTimeResponse) ClearTo() {
+	x.xxx_hidden_To = nil
 }
 
-type DeliveryPathResult_builder struct {
+type ConvertTimeResponse_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Success      *bool
-	DeliveryPath *DeliveryPath
+	From *LocalTime
+	To   *LocalTime
 }
 
-func (b0 DeliveryPathResult_builder) Build() *DeliveryPathResult {
-	m0 := &DeliveryPathResult{}
+func (b0 ConvertTimeResponse_builder) Build() *ConvertTimeResponse {
+	m0 := &ConvertTimeResponse{}
 	b, x := &b0, m0
 	_, _ = b, x
-	if b.Success != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
-		x.xxx_hidden_Success = *b.Success
-	}
-	x.xxx_hidden_DeliveryPath = b.DeliveryPath
+	x.xxx_hidden_From = b.From
+	x.xxx_hidden_To = b.To
 	return m0
 }
 
@@ -968,7 +4530,23 @@ var File_api_warehouse_proto protoreflect.FileDescriptor
 const file_api_warehouse_proto_rawDesc = "" +
 	"\n" +
 	"\x13api/warehouse.proto\x12\n" +
-	"warehouses\x1a!google/protobuf/go_features.proto\"\xb7\x02\n" +
+	"warehouses\x1a!google/protobuf/go_features.proto\x1a\x10api/errors.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa9\x01\n" +
+	"\x0eHandlingLimits\x12\x1d\n" +
+	"\n" +
+	"max_length\x18\x01 \x01(\x01R\tmaxLength\x12\x1b\n" +
+	"\tmax_width\x18\x02 \x01(\x01R\bmaxWidth\x12\x1d\n" +
+	"\n" +
+	"max_height\x18\x03 \x01(\x01R\tmaxHeight\x12\x1d\n" +
+	"\n" +
+	"max_weight\x18\x04 \x01(\x01R\tmaxWeight\x12\x1d\n" +
+	"\n" +
+	"max_volume\x18\x05 \x01(\x01R\tmaxVolume\"\x86\x01\n" +
+	"\x0ePackDimensions\x12\x16\n" +
+	"\x06length\x18\x01 \x01(\x01R\x06length\x12\x14\n" +
+	"\x05width\x18\x02 \x01(\x01R\x05width\x12\x16\n" +
+	"\x06height\x18\x03 \x01(\x01R\x06height\x12\x16\n" +
+	"\x06weight\x18\x04 \x01(\x01R\x06weight\x12\x16\n" +
+	"\x06volume\x18\x05 \x01(\x01R\x06volume\"\xb6\x03\n" +
 	"\tWarehouse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12-\n" +
@@ -978,23 +4556,129 @@ const file_api_warehouse_proto_rawDesc = "" +
 	"\x05level\x18\x06 \x01(\x05R\x05level\x12\x18\n" +
 	"\aaddress\x18\a \x01(\tR\aaddress\x129\n" +
 	"\x19only_stock_pickup_allowed\x18\b \x01(\bR\x16onlyStockPickupAllowed\x12(\n" +
-	"\x0fDescriptorGroup\x18\t \x01(\tR\x0fDescriptorGroup\"3\n" +
+	"\x0fDescriptorGroup\x18\t \x01(\tR\x0fDescriptorGroup\x12\x1a\n" +
+	"\blatitude\x18\n" +
+	" \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\v \x01(\x01R\tlongitude\x12C\n" +
+	"\x0fhandling_limits\x18\f \x01(\v2\x1a.warehouses.HandlingLimitsR\x0ehandlingLimits\"U\n" +
+	"\x11RejectedWarehouse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x120\n" +
+	"\x06reason\x18\x02 \x01(\x0e2\x18.warehouses.RejectReasonR\x06reason\"\xcf\x01\n" +
 	"\x04Path\x12+\n" +
-	"\x05nodes\x18\x01 \x03(\v2\x15.warehouses.WarehouseR\x05nodes\"K\n" +
+	"\x05nodes\x18\x01 \x03(\v2\x15.warehouses.WarehouseR\x05nodes\x12*\n" +
+	"\x11used_warehouse_id\x18\x02 \x01(\tR\x0fusedWarehouseId\x129\n" +
+	"\brejected\x18\x03 \x03(\v2\x1d.warehouses.RejectedWarehouseR\brejected\x123\n" +
+	"\toversized\x18\x04 \x03(\v2\x15.warehouses.WarehouseR\toversized\"\x88\x02\n" +
 	"\aGetPath\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x120\n" +
-	"\x14default_warehouse_id\x18\x02 \x01(\tR\x12defaultWarehouseId\"\x92\x01\n" +
+	"\x14default_warehouse_id\x18\x02 \x01(\tR\x12defaultWarehouseId\x124\n" +
+	"\x16fallback_warehouse_ids\x18\x03 \x03(\tR\x14fallbackWarehouseIds\x12)\n" +
+	"\x10descriptor_group\x18\x04 \x01(\tR\x0fdescriptorGroup\x12*\n" +
+	"\x11allow_cross_group\x18\x05 \x01(\bR\x0fallowCrossGroup\x12.\n" +
+	"\x04pack\x18\x06 \x01(\v2\x1a.warehouses.PackDimensionsR\x04pack\"\xc7\x01\n" +
 	"\x13DeliveryPathRequest\x12)\n" +
 	"\x04node\x18\x01 \x03(\v2\x15.warehouses.WarehouseR\x04node\x12)\n" +
 	"\x04from\x18\x02 \x01(\v2\x15.warehouses.WarehouseR\x04from\x12%\n" +
-	"\x02to\x18\x03 \x01(\v2\x15.warehouses.WarehouseR\x02to\"\x8c\x01\n" +
+	"\x02to\x18\x03 \x01(\v2\x15.warehouses.WarehouseR\x02to\x123\n" +
+	"\tobjective\x18\x04 \x01(\x0e2\x15.warehouses.ObjectiveR\tobjective\"\xd9\x01\n" +
 	"\fDeliveryPath\x12+\n" +
 	"\x05first\x18\x01 \x01(\v2\x15.warehouses.WarehouseR\x05first\x12)\n" +
 	"\x04last\x18\x02 \x01(\v2\x15.warehouses.WarehouseR\x04last\x12$\n" +
-	"\x04path\x18\x03 \x01(\v2\x10.warehouses.PathR\x04path\"m\n" +
+	"\x04path\x18\x03 \x01(\v2\x10.warehouses.PathR\x04path\x12#\n" +
+	"\rtransit_hours\x18\x04 \x01(\x01R\ftransitHours\x12\x12\n" +
+	"\x04cost\x18\x05 \x01(\x01R\x04cost\x12\x12\n" +
+	"\x04hops\x18\x06 \x01(\x05R\x04hops\"\xab\x01\n" +
 	"\x12DeliveryPathResult\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12=\n" +
-	"\rdelivery_path\x18\x02 \x01(\v2\x18.warehouses.DeliveryPathR\fdeliveryPath*\xb1\x03\n" +
+	"\rdelivery_path\x18\x02 \x01(\v2\x18.warehouses.DeliveryPathR\fdeliveryPath\x12<\n" +
+	"\falternatives\x18\x03 \x03(\v2\x18.warehouses.DeliveryPathR\falternatives\"\xa2\x02\n" +
+	"\x1cFindNearestWarehousesRequest\x12\x1a\n" +
+	"\blatitude\x18\x01 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x02 \x01(\x01R\tlongitude\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12&\n" +
+	"\x0fmax_distance_km\x18\x04 \x01(\x01R\rmaxDistanceKm\x12/\n" +
+	"\x05types\x18\x05 \x03(\x0e2\x19.warehouses.WarehouseTypeR\x05types\x12.\n" +
+	"\x13only_available_rest\x18\x06 \x01(\bR\x11onlyAvailableRest\x12)\n" +
+	"\x10descriptor_group\x18\a \x01(\tR\x0fdescriptorGroup\"h\n" +
+	"\x10NearestWarehouse\x123\n" +
+	"\twarehouse\x18\x01 \x01(\v2\x15.warehouses.WarehouseR\twarehouse\x12\x1f\n" +
+	"\vdistance_km\x18\x02 \x01(\x01R\n" +
+	"distanceKm\"]\n" +
+	"\x1dFindNearestWarehousesResponse\x12<\n" +
+	"\n" +
+	"warehouses\x18\x01 \x03(\v2\x1c.warehouses.NearestWarehouseR\n" +
+	"warehouses\":\n" +
+	"\n" +
+	"EdgeChange\x12\x17\n" +
+	"\afrom_id\x18\x01 \x01(\tR\x06fromId\x12\x13\n" +
+	"\x05to_id\x18\x02 \x01(\tR\x04toId\"\xc6\x01\n" +
+	"\bScenario\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x120\n" +
+	"\x14masked_warehouse_ids\x18\x02 \x03(\tR\x12maskedWarehouseIds\x127\n" +
+	"\vadded_edges\x18\x03 \x03(\v2\x16.warehouses.EdgeChangeR\n" +
+	"addedEdges\x12;\n" +
+	"\rremoved_edges\x18\x04 \x03(\v2\x16.warehouses.EdgeChangeR\fremovedEdges\"\x95\x01\n" +
+	"\x13SimulatePathRequest\x12'\n" +
+	"\x04path\x18\x01 \x01(\v2\x13.warehouses.GetPathR\x04path\x12#\n" +
+	"\rscenario_name\x18\x02 \x01(\tR\fscenarioName\x120\n" +
+	"\bscenario\x18\x03 \x01(\v2\x14.warehouses.ScenarioR\bscenario\"^\n" +
+	"\vPathOutcome\x12$\n" +
+	"\x04path\x18\x01 \x01(\v2\x10.warehouses.PathR\x04path\x12)\n" +
+	"\x05error\x18\x02 \x01(\v2\x13.errors.ErrorDetailR\x05error\"~\n" +
+	"\x14SimulatePathResponse\x12/\n" +
+	"\x06actual\x18\x01 \x01(\v2\x17.warehouses.PathOutcomeR\x06actual\x125\n" +
+	"\tsimulated\x18\x02 \x01(\v2\x17.warehouses.PathOutcomeR\tsimulated\"+\n" +
+	"\x15DeleteScenarioRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"2\n" +
+	"\x16DeleteScenarioResponse\x12\x18\n" +
+	"\adeleted\x18\x01 \x01(\bR\adeleted\"\x16\n" +
+	"\x14ListScenariosRequest\"K\n" +
+	"\x15ListScenariosResponse\x122\n" +
+	"\tscenarios\x18\x01 \x03(\v2\x14.warehouses.ScenarioR\tscenarios\"\x1f\n" +
+	"\rImpactRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"t\n" +
+	"\x06Impact\x123\n" +
+	"\twarehouse\x18\x01 \x01(\v2\x15.warehouses.WarehouseR\twarehouse\x125\n" +
+	"\n" +
+	"dependents\x18\x02 \x03(\v2\x15.warehouses.WarehouseR\n" +
+	"dependents\"\x1e\n" +
+	"\x1cSinglePointsOfFailureRequest\"I\n" +
+	"\x1dSinglePointsOfFailureResponse\x12(\n" +
+	"\x05items\x18\x01 \x03(\v2\x12.warehouses.ImpactR\x05items\"\x94\x01\n" +
+	"\x0fGroupStatistics\x12)\n" +
+	"\x10descriptor_group\x18\x01 \x01(\tR\x0fdescriptorGroup\x12\x14\n" +
+	"\x05nodes\x18\x02 \x01(\x05R\x05nodes\x12\x14\n" +
+	"\x05edges\x18\x03 \x01(\x05R\x05edges\x12*\n" +
+	"\x11cross_group_edges\x18\x04 \x01(\x05R\x0fcrossGroupEdges\"\x18\n" +
+	"\x16GroupStatisticsRequest\"N\n" +
+	"\x17GroupStatisticsResponse\x123\n" +
+	"\x06groups\x18\x01 \x03(\v2\x1b.warehouses.GroupStatisticsR\x06groups\"\x9a\x01\n" +
+	"\tLocalTime\x12!\n" +
+	"\fwarehouse_id\x18\x01 \x01(\tR\vwarehouseId\x12\x1b\n" +
+	"\ttime_zone\x18\x02 \x01(\tR\btimeZone\x12.\n" +
+	"\x04time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12\x1d\n" +
+	"\n" +
+	"local_time\x18\x04 \x01(\tR\tlocalTime\"4\n" +
+	"\x0fLocalNowRequest\x12!\n" +
+	"\fwarehouse_id\x18\x01 \x01(\tR\vwarehouseId\"K\n" +
+	"\rCutOffRequest\x12!\n" +
+	"\fwarehouse_id\x18\x01 \x01(\tR\vwarehouseId\x12\x17\n" +
+	"\acut_off\x18\x02 \x01(\tR\x06cutOff\"\x8f\x01\n" +
+	"\x0eCutOffResponse\x12$\n" +
+	"\x0ebefore_cut_off\x18\x01 \x01(\bR\fbeforeCutOff\x12'\n" +
+	"\x03now\x18\x02 \x01(\v2\x15.warehouses.LocalTimeR\x03now\x12.\n" +
+	"\acut_off\x18\x03 \x01(\v2\x15.warehouses.LocalTimeR\x06cutOff\"k\n" +
+	"\x16NextBusinessDayRequest\x12!\n" +
+	"\fwarehouse_id\x18\x01 \x01(\tR\vwarehouseId\x12.\n" +
+	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\"\x87\x01\n" +
+	"\x12ConvertTimeRequest\x12*\n" +
+	"\x11from_warehouse_id\x18\x01 \x01(\tR\x0ffromWarehouseId\x12&\n" +
+	"\x0fto_warehouse_id\x18\x02 \x01(\tR\rtoWarehouseId\x12\x1d\n" +
+	"\n" +
+	"local_time\x18\x03 \x01(\tR\tlocalTime\"g\n" +
+	"\x13ConvertTimeResponse\x12)\n" +
+	"\x04from\x18\x01 \x01(\v2\x15.warehouses.LocalTimeR\x04from\x12%\n" +
+	"\x02to\x18\x02 \x01(\v2\x15.warehouses.LocalTimeR\x02to*\xb1\x03\n" +
 	"\rWarehouseType\x12\x10\n" +
 	"\fUNRECOGNIZED\x10\x00\x12\b\n" +
 	"\x04FREE\x10\x01\x12\b\n" +
@@ -1026,41 +4710,155 @@ const file_api_warehouse_proto_rawDesc = "" +
 	"\x19CENTRAL_MAIN_INTERMEDIATE\x10\x16\x12\x1d\n" +
 	"\x19MAIN_CENTRAL_INTERMEDIATE\x10\x17\x12\x1d\n" +
 	"\x19CENTRAL_FREE_INTERMEDIATE\x10\x18\x12\x1d\n" +
-	"\x19FREE_CENTRAL_INTERMEDIATE\x10\x192\x8f\x01\n" +
+	"\x19FREE_CENTRAL_INTERMEDIATE\x10\x19*\xb3\x01\n" +
+	"\fRejectReason\x12\x1d\n" +
+	"\x19REJECT_REASON_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17REJECT_REASON_NOT_FOUND\x10\x01\x12*\n" +
+	"&REJECT_REASON_DESTINATION_NOT_IN_CHAIN\x10\x02\x12\x1f\n" +
+	"\x1bREJECT_REASON_OUTSIDE_GROUP\x10\x03\x12\x1a\n" +
+	"\x16REJECT_REASON_OVERSIZE\x10\x04*\x88\x01\n" +
+	"\tObjective\x12\x19\n" +
+	"\x15OBJECTIVE_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11OBJECTIVE_FASTEST\x10\x01\x12\x16\n" +
+	"\x12OBJECTIVE_CHEAPEST\x10\x02\x12\x19\n" +
+	"\x15OBJECTIVE_FEWEST_HOPS\x10\x03\x12\x16\n" +
+	"\x12OBJECTIVE_BALANCED\x10\x042\xee\x01\n" +
 	"\vPathService\x12,\n" +
 	"\x03Get\x12\x13.warehouses.GetPath\x1a\x10.warehouses.Path\x12R\n" +
-	"\x0fGetDeliveryPath\x12\x1f.warehouses.DeliveryPathRequest\x1a\x1e.warehouses.DeliveryPathResultB\x10Z\x06/proto\x92\x03\x05\xd2>\x02\x10\x03b\beditionsp\xe8\a"
-
-var file_api_warehouse_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_api_warehouse_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+	"\x0fGetDeliveryPath\x12\x1f.warehouses.DeliveryPathRequest\x1a\x1e.warehouses.DeliveryPathResult\x12]\n" +
+	"\x12GetGroupStatistics\x12\".warehouses.GroupStatisticsRequest\x1a#.warehouses.GroupStatisticsResponse2\x7f\n" +
+	"\x0fLocationService\x12l\n" +
+	"\x15FindNearestWarehouses\x12(.warehouses.FindNearestWarehousesRequest\x1a).warehouses.FindNearestWarehousesResponse2\xd0\x02\n" +
+	"\x11SimulationService\x12Q\n" +
+	"\fSimulatePath\x12\x1f.warehouses.SimulatePathRequest\x1a .warehouses.SimulatePathResponse\x129\n" +
+	"\vPutScenario\x12\x14.warehouses.Scenario\x1a\x14.warehouses.Scenario\x12W\n" +
+	"\x0eDeleteScenario\x12!.warehouses.DeleteScenarioRequest\x1a\".warehouses.DeleteScenarioResponse\x12T\n" +
+	"\rListScenarios\x12 .warehouses.ListScenariosRequest\x1a!.warehouses.ListScenariosResponse2\xbc\x01\n" +
+	"\rImpactService\x12:\n" +
+	"\tGetImpact\x12\x19.warehouses.ImpactRequest\x1a\x12.warehouses.Impact\x12o\n" +
+	"\x18GetSinglePointsOfFailure\x12(.warehouses.SinglePointsOfFailureRequest\x1a).warehouses.SinglePointsOfFailureResponse2\xb4\x02\n" +
+	"\vTimeService\x12>\n" +
+	"\bLocalNow\x12\x1b.warehouses.LocalNowRequest\x1a\x15.warehouses.LocalTime\x12G\n" +
+	"\x0eIsBeforeCutOff\x12\x19.warehouses.CutOffRequest\x1a\x1a.warehouses.CutOffResponse\x12L\n" +
+	"\x0fNextBusinessDay\x12\".warehouses.NextBusinessDayRequest\x1a\x15.warehouses.LocalTime\x12N\n" +
+	"\vConvertTime\x12\x1e.warehouses.ConvertTimeRequest\x1a\x1f.warehouses.ConvertTimeResponseB\x10Z\x06/proto\x92\x03\x05\xd2>\x02\x10\x03b\beditionsp\xe8\a"
+
+var file_api_warehouse_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_api_warehouse_proto_msgTypes = make([]protoimpl.MessageInfo, 35)
 var file_api_warehouse_proto_goTypes = []any{
-	(WarehouseType)(0),          // 0: warehouses.WarehouseType
-	(*Warehouse)(nil),           // 1: warehouses.Warehouse
-	(*Path)(nil),                // 2: warehouses.Path
-	(*GetPath)(nil),             // 3: warehouses.GetPath
-	(*DeliveryPathRequest)(nil), // 4: warehouses.DeliveryPathRequest
-	(*DeliveryPath)(nil),        // 5: warehouses.DeliveryPath
-	(*DeliveryPathResult)(nil),  // 6: warehouses.DeliveryPathResult
+	(WarehouseType)(0),                    // 0: warehouses.WarehouseType
+	(RejectReason)(0),                     // 1: warehouses.RejectReason
+	(Objective)(0),                        // 2: warehouses.Objective
+	(*HandlingLimits)(nil),                // 3: warehouses.HandlingLimits
+	(*PackDimensions)(nil),                // 4: warehouses.PackDimensions
+	(*Warehouse)(nil),                     // 5: warehouses.Warehouse
+	(*RejectedWarehouse)(nil),             // 6: warehouses.RejectedWarehouse
+	(*Path)(nil),                          // 7: warehouses.Path
+	(*GetPath)(nil),                       // 8: warehouses.GetPath
+	(*DeliveryPathRequest)(nil),           // 9: warehouses.DeliveryPathRequest
+	(*DeliveryPath)(nil),                  // 10: warehouses.DeliveryPath
+	(*DeliveryPathResult)(nil),            // 11: warehouses.DeliveryPathResult
+	(*FindNearestWarehousesRequest)(nil),  // 12: warehouses.FindNearestWarehousesRequest
+	(*NearestWarehouse)(nil),              // 13: warehouses.NearestWarehouse
+	(*FindNearestWarehousesResponse)(nil), // 14: warehouses.FindNearestWarehousesResponse
+	(*EdgeChange)(nil),                    // 15: warehouses.EdgeChange
+	(*Scenario)(nil),                      // 16: warehouses.Scenario
+	(*SimulatePathRequest)(nil),           // 17: warehouses.SimulatePathRequest
+	(*PathOutcome)(nil),                   // 18: warehouses.PathOutcome
+	(*SimulatePathResponse)(nil),          // 19: warehouses.SimulatePathResponse
+	(*DeleteScenarioRequest)(nil),         // 20: warehouses.DeleteScenarioRequest
+	(*DeleteScenarioResponse)(nil),        // 21: warehouses.DeleteScenarioResponse
+	(*ListScenariosRequest)(nil),          // 22: warehouses.ListScenariosRequest
+	(*ListScenariosResponse)(nil),         // 23: warehouses.ListScenariosResponse
+	(*ImpactRequest)(nil),                 // 24: warehouses.ImpactRequest
+	(*Impact)(nil),                        // 25: warehouses.Impact
+	(*SinglePointsOfFailureRequest)(nil),  // 26: warehouses.SinglePointsOfFailureRequest
+	(*SinglePointsOfFailureResponse)(nil), // 27: warehouses.SinglePointsOfFailureResponse
+	(*GroupStatistics)(nil),               // 28: warehouses.GroupStatistics
+	(*GroupStatisticsRequest)(nil),        // 29: warehouses.GroupStatisticsRequest
+	(*GroupStatisticsResponse)(nil),       // 30: warehouses.GroupStatisticsResponse
+	(*LocalTime)(nil),                     // 31: warehouses.LocalTime
+	(*LocalNowRequest)(nil),               // 32: warehouses.LocalNowRequest
+	(*CutOffRequest)(nil),                 // 33: warehouses.CutOffRequest
+	(*CutOffResponse)(nil),                // 34: warehouses.CutOffResponse
+	(*NextBusinessDayRequest)(nil),        // 35: warehouses.NextBusinessDayRequest
+	(*ConvertTimeRequest)(nil),            // 36: warehouses.ConvertTimeRequest
+	(*ConvertTimeResponse)(nil),           // 37: warehouses.ConvertTimeResponse
+	(*ErrorDetail)(nil),                   // 38: errors.ErrorDetail
+	(*timestamppb.Timestamp)(nil),         // 39: google.protobuf.Timestamp
 }
 var file_api_warehouse_proto_depIdxs = []int32{
 	0,  // 0: warehouses.Warehouse.type:type_name -> warehouses.WarehouseType
-	1,  // 1: warehouses.Path.nodes:type_name -> warehouses.Warehouse
-	1,  // 2: warehouses.DeliveryPathRequest.node:type_name -> warehouses.Warehouse
-	1,  // 3: warehouses.DeliveryPathRequest.from:type_name -> warehouses.Warehouse
-	1,  // 4: warehouses.DeliveryPathRequest.to:type_name -> warehouses.Warehouse
-	1,  // 5: warehouses.DeliveryPath.first:type_name -> warehouses.Warehouse
-	1,  // 6: warehouses.DeliveryPath.last:type_name -> warehouses.Warehouse
-	2,  // 7: warehouses.DeliveryPath.path:type_name -> warehouses.Path
-	5,  // 8: warehouses.DeliveryPathResult.delivery_path:type_name -> warehouses.DeliveryPath
-	3,  // 9: warehouses.PathService.Get:input_type -> warehouses.GetPath
-	4,  // 10: warehouses.PathService.GetDeliveryPath:input_type -> warehouses.DeliveryPathRequest
-	2,  // 11: warehouses.PathService.Get:output_type -> warehouses.Path
-	6,  // 12: warehouses.PathService.GetDeliveryPath:output_type -> warehouses.DeliveryPathResult
-	11, // [11:13] is the sub-list for method output_type
-	9,  // [9:11] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	3,  // 1: warehouses.Warehouse.handling_limits:type_name -> warehouses.HandlingLimits
+	1,  // 2: warehouses.RejectedWarehouse.reason:type_name -> warehouses.RejectReason
+	5,  // 3: warehouses.Path.nodes:type_name -> warehouses.Warehouse
+	6,  // 4: warehouses.Path.rejected:type_name -> warehouses.RejectedWarehouse
+	5,  // 5: warehouses.Path.oversized:type_name -> warehouses.Warehouse
+	4,  // 6: warehouses.GetPath.pack:type_name -> warehouses.PackDimensions
+	5,  // 7: warehouses.DeliveryPathRequest.node:type_name -> warehouses.Warehouse
+	5,  // 8: warehouses.DeliveryPathRequest.from:type_name -> warehouses.Warehouse
+	5,  // 9: warehouses.DeliveryPathRequest.to:type_name -> warehouses.Warehouse
+	2,  // 10: warehouses.DeliveryPathRequest.objective:type_name -> warehouses.Objective
+	5,  // 11: warehouses.DeliveryPath.first:type_name -> warehouses.Warehouse
+	5,  // 12: warehouses.DeliveryPath.last:type_name -> warehouses.Warehouse
+	7,  // 13: warehouses.DeliveryPath.path:type_name -> warehouses.Path
+	10, // 14: warehouses.DeliveryPathResult.delivery_path:type_name -> warehouses.DeliveryPath
+	10, // 15: warehouses.DeliveryPathResult.alternatives:type_name -> warehouses.DeliveryPath
+	0,  // 16: warehouses.FindNearestWarehousesRequest.types:type_name -> warehouses.WarehouseType
+	5,  // 17: warehouses.NearestWarehouse.warehouse:type_name -> warehouses.Warehouse
+	13, // 18: warehouses.FindNearestWarehousesResponse.warehouses:type_name -> warehouses.NearestWarehouse
+	15, // 19: warehouses.Scenario.added_edges:type_name -> warehouses.EdgeChange
+	15, // 20: warehouses.Scenario.removed_edges:type_name -> warehouses.EdgeChange
+	8,  // 21: warehouses.SimulatePathRequest.path:type_name -> warehouses.GetPath
+	16, // 22: warehouses.SimulatePathRequest.scenario:type_name -> warehouses.Scenario
+	7,  // 23: warehouses.PathOutcome.path:type_name -> warehouses.Path
+	38, // 24: warehouses.PathOutcome.error:type_name -> errors.ErrorDetail
+	18, // 25: warehouses.SimulatePathResponse.actual:type_name -> warehouses.PathOutcome
+	18, // 26: warehouses.SimulatePathResponse.simulated:type_name -> warehouses.PathOutcome
+	16, // 27: warehouses.ListScenariosResponse.scenarios:type_name -> warehouses.Scenario
+	5,  // 28: warehouses.Impact.warehouse:type_name -> warehouses.Warehouse
+	5,  // 29: warehouses.Impact.dependents:type_name -> warehouses.Warehouse
+	25, // 30: warehouses.SinglePointsOfFailureResponse.items:type_name -> warehouses.Impact
+	28, // 31: warehouses.GroupStatisticsResponse.groups:type_name -> warehouses.GroupStatistics
+	39, // 32: warehouses.LocalTime.time:type_name -> google.protobuf.Timestamp
+	31, // 33: warehouses.CutOffResponse.now:type_name -> warehouses.LocalTime
+	31, // 34: warehouses.CutOffResponse.cut_off:type_name -> warehouses.LocalTime
+	39, // 35: warehouses.NextBusinessDayRequest.from:type_name -> google.protobuf.Timestamp
+	31, // 36: warehouses.ConvertTimeResponse.from:type_name -> warehouses.LocalTime
+	31, // 37: warehouses.ConvertTimeResponse.to:type_name -> warehouses.LocalTime
+	8,  // 38: warehouses.PathService.Get:input_type -> warehouses.GetPath
+	9,  // 39: warehouses.PathService.GetDeliveryPath:input_type -> warehouses.DeliveryPathRequest
+	29, // 40: warehouses.PathService.GetGroupStatistics:input_type -> warehouses.GroupStatisticsRequest
+	12, // 41: warehouses.LocationService.FindNearestWarehouses:input_type -> warehouses.FindNearestWarehousesRequest
+	17, // 42: warehouses.SimulationService.SimulatePath:input_type -> warehouses.SimulatePathRequest
+	16, // 43: warehouses.SimulationService.PutScenario:input_type -> warehouses.Scenario
+	20, // 44: warehouses.SimulationService.DeleteScenario:input_type -> warehouses.DeleteScenarioRequest
+	22, // 45: warehouses.SimulationService.ListScenarios:input_type -> warehouses.ListScenariosRequest
+	24, // 46: warehouses.ImpactService.GetImpact:input_type -> warehouses.ImpactRequest
+	26, // 47: warehouses.ImpactService.GetSinglePointsOfFailure:input_type -> warehouses.SinglePointsOfFailureRequest
+	32, // 48: warehouses.TimeService.LocalNow:input_type -> warehouses.LocalNowRequest
+	33, // 49: warehouses.TimeService.IsBeforeCutOff:input_type -> warehouses.CutOffRequest
+	35, // 50: warehouses.TimeService.NextBusinessDay:input_type -> warehouses.NextBusinessDayRequest
+	36, // 51: warehouses.TimeService.ConvertTime:input_type -> warehouses.ConvertTimeRequest
+	7,  // 52: warehouses.PathService.Get:output_type -> warehouses.Path
+	11, // 53: warehouses.PathService.GetDeliveryPath:output_type -> warehouses.DeliveryPathResult
+	30, // 54: warehouses.PathService.GetGroupStatistics:output_type -> warehouses.GroupStatisticsResponse
+	14, // 55: warehouses.LocationService.FindNearestWarehouses:output_type -> warehouses.FindNearestWarehousesResponse
+	19, // 56: warehouses.SimulationService.SimulatePath:output_type -> warehouses.SimulatePathResponse
+	16, // 57: warehouses.SimulationService.PutScenario:output_type -> warehouses.Scenario
+	21, // 58: warehouses.SimulationService.DeleteScenario:output_type -> warehouses.DeleteScenarioResponse
+	23, // 59: warehouses.SimulationService.ListScenarios:output_type -> warehouses.ListScenariosResponse
+	25, // 60: warehouses.ImpactService.GetImpact:output_type -> warehouses.Impact
+	27, // 61: warehouses.ImpactService.GetSinglePointsOfFailure:output_type -> warehouses.SinglePointsOfFailureResponse
+	31, // 62: warehouses.TimeService.LocalNow:output_type -> warehouses.LocalTime
+	34, // 63: warehouses.TimeService.IsBeforeCutOff:output_type -> warehouses.CutOffResponse
+	31, // 64: warehouses.TimeService.NextBusinessDay:output_type -> warehouses.LocalTime
+	37, // 65: warehouses.TimeService.ConvertTime:output_type -> warehouses.ConvertTimeResponse
+	52, // [52:66] is the sub-list for method output_type
+	38, // [38:52] is the sub-list for method input_type
+	38, // [38:38] is the sub-list for extension type_name
+	38, // [38:38] is the sub-list for extension extendee
+	0,  // [0:38] is the sub-list for field type_name
 }
 
 func init() { file_api_warehouse_proto_init() }
@@ -1068,15 +4866,16 @@ func file_api_warehouse_proto_init() {
 	if File_api_warehouse_proto != nil {
 		return
 	}
+	file_api_errors_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_warehouse_proto_rawDesc), len(file_api_warehouse_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   6,
+			NumEnums:      3,
+			NumMessages:   35,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   5,
 		},
 		GoTypes:           file_api_warehouse_proto_goTypes,
 		DependencyIndexes: file_api_warehouse_proto_depIdxs,
@@ -19,8 +19,9 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	PathService_Get_FullMethodName             = "/warehouses.PathService/Get"
-	PathService_GetDeliveryPath_FullMethodName = "/warehouses.PathService/GetDeliveryPath"
+	PathService_Get_FullMethodName                = "/warehouses.PathService/Get"
+	PathService_GetDeliveryPath_FullMethodName    = "/warehouses.PathService/GetDeliveryPath"
+	PathService_GetGroupStatistics_FullMethodName = "/warehouses.PathService/GetGroupStatistics"
 )
 
 // PathServiceClient is the client API for PathService service.
@@ -29,6 +30,7 @@ const (
 type PathServiceClient interface {
 	Get(ctx context.Context, in *GetPath, opts ...grpc.CallOption) (*Path, error)
 	GetDeliveryPath(ctx context.Context, in *DeliveryPathRequest, opts ...grpc.CallOption) (*DeliveryPathResult, error)
+	GetGroupStatistics(ctx context.Context, in *GroupStatisticsRequest, opts ...grpc.CallOption) (*GroupStatisticsResponse, error)
 }
 
 type pathServiceClient struct {
@@ -59,12 +61,23 @@ func (c *pathServiceClient) GetDeliveryPath(ctx context.Context, in *DeliveryPat
 	return out, nil
 }
 
+func (c *pathServiceClient) GetGroupStatistics(ctx context.Context, in *GroupStatisticsRequest, opts ...grpc.CallOption) (*GroupStatisticsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GroupStatisticsResponse)
+	err := c.cc.Invoke(ctx, PathService_GetGroupStatistics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PathServiceServer is the server API for PathService service.
 // All implementations must embed UnimplementedPathServiceServer
 // for forward compatibility.
 type PathServiceServer interface {
 	Get(context.Context, *GetPath) (*Path, error)
 	GetDeliveryPath(context.Context, *DeliveryPathRequest) (*DeliveryPathResult, error)
+	GetGroupStatistics(context.Context, *GroupStatisticsRequest) (*GroupStatisticsResponse, error)
 	mustEmbedUnimplementedPathServiceServer()
 }
 
@@ -81,6 +94,9 @@ func (UnimplementedPathServiceServer) Get(context.Context, *GetPath) (*Path, err
 func (UnimplementedPathServiceServer) GetDeliveryPath(context.Context, *DeliveryPathRequest) (*DeliveryPathResult, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDeliveryPath not implemented")
 }
+func (UnimplementedPathServiceServer) GetGroupStatistics(context.Context, *GroupStatisticsRequest) (*GroupStatisticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupStatistics not implemented")
+}
 func (UnimplementedPathServiceServer) mustEmbedUnimplementedPathServiceServer() {}
 func (UnimplementedPathServiceServer) testEmbeddedByValue()                     {}
 
@@ -138,6 +154,24 @@ func _PathService_GetDeliveryPath_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PathService_GetGroupStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupStatisticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PathServiceServer).GetGroupStatistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PathService_GetGroupStatistics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PathServiceServer).GetGroupStatistics(ctx, req.(*GroupStatisticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PathService_ServiceDesc is the grpc.ServiceDesc for PathService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -153,6 +187,684 @@ var PathService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetDeliveryPath",
 			Handler:    _PathService_GetDeliveryPath_Handler,
 		},
+		{
+			MethodName: "GetGroupStatistics",
+			Handler:    _PathService_GetGroupStatistics_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/warehouse.proto",
+}
+
+const (
+	LocationService_FindNearestWarehouses_FullMethodName = "/warehouses.LocationService/FindNearestWarehouses"
+)
+
+// LocationServiceClient is the client API for LocationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type LocationServiceClient interface {
+	FindNearestWarehouses(ctx context.Context, in *FindNearestWarehousesRequest, opts ...grpc.CallOption) (*FindNearestWarehousesResponse, error)
+}
+
+type locationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLocationServiceClient(cc grpc.ClientConnInterface) LocationServiceClient {
+	return &locationServiceClient{cc}
+}
+
+func (c *locationServiceClient) FindNearestWarehouses(ctx context.Context, in *FindNearestWarehousesRequest, opts ...grpc.CallOption) (*FindNearestWarehousesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindNearestWarehousesResponse)
+	err := c.cc.Invoke(ctx, LocationService_FindNearestWarehouses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LocationServiceServer is the server API for LocationService service.
+// All implementations must embed UnimplementedLocationServiceServer
+// for forward compatibility.
+type LocationServiceServer interface {
+	FindNearestWarehouses(context.Context, *FindNearestWarehousesRequest) (*FindNearestWarehousesResponse, error)
+	mustEmbedUnimplementedLocationServiceServer()
+}
+
+// UnimplementedLocationServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedLocationServiceServer struct{}
+
+func (UnimplementedLocationServiceServer) FindNearestWarehouses(context.Context, *FindNearestWarehousesRequest) (*FindNearestWarehousesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindNearestWarehouses not implemented")
+}
+func (UnimplementedLocationServiceServer) mustEmbedUnimplementedLocationServiceServer() {}
+func (UnimplementedLocationServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeLocationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LocationServiceServer will
+// result in compilation errors.
+type UnsafeLocationServiceServer interface {
+	mustEmbedUnimplementedLocationServiceServer()
+}
+
+func RegisterLocationServiceServer(s grpc.ServiceRegistrar, srv LocationServiceServer) {
+	// If the following call pancis, it indicates UnimplementedLocationServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&LocationService_ServiceDesc, srv)
+}
+
+func _LocationService_FindNearestWarehouses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindNearestWarehousesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocationServiceServer).FindNearestWarehouses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LocationService_FindNearestWarehouses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocationServiceServer).FindNearestWarehouses(ctx, req.(*FindNearestWarehousesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// LocationService_ServiceDesc is the grpc.ServiceDesc for LocationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var LocationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "warehouses.LocationService",
+	HandlerType: (*LocationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "FindNearestWarehouses",
+			Handler:    _LocationService_FindNearestWarehouses_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/warehouse.proto",
+}
+
+const (
+	SimulationService_SimulatePath_FullMethodName   = "/warehouses.SimulationService/SimulatePath"
+	SimulationService_PutScenario_FullMethodName    = "/warehouses.SimulationService/PutScenario"
+	SimulationService_DeleteScenario_FullMethodName = "/warehouses.SimulationService/DeleteScenario"
+	SimulationService_ListScenarios_FullMethodName  = "/warehouses.SimulationService/ListScenarios"
+)
+
+// SimulationServiceClient is the client API for SimulationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SimulationServiceClient interface {
+	SimulatePath(ctx context.Context, in *SimulatePathRequest, opts ...grpc.CallOption) (*SimulatePathResponse, error)
+	PutScenario(ctx context.Context, in *Scenario, opts ...grpc.CallOption) (*Scenario, error)
+	DeleteScenario(ctx context.Context, in *DeleteScenarioRequest, opts ...grpc.CallOption) (*DeleteScenarioResponse, error)
+	ListScenarios(ctx context.Context, in *ListScenariosRequest, opts ...grpc.CallOption) (*ListScenariosResponse, error)
+}
+
+type simulationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSimulationServiceClient(cc grpc.ClientConnInterface) SimulationServiceClient {
+	return &simulationServiceClient{cc}
+}
+
+func (c *simulationServiceClient) SimulatePath(ctx context.Context, in *SimulatePathRequest, opts ...grpc.CallOption) (*SimulatePathResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SimulatePathResponse)
+	err := c.cc.Invoke(ctx, SimulationService_SimulatePath_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simulationServiceClient) PutScenario(ctx context.Context, in *Scenario, opts ...grpc.CallOption) (*Scenario, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Scenario)
+	err := c.cc.Invoke(ctx, SimulationService_PutScenario_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simulationServiceClient) DeleteScenario(ctx context.Context, in *DeleteScenarioRequest, opts ...grpc.CallOption) (*DeleteScenarioResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteScenarioResponse)
+	err := c.cc.Invoke(ctx, SimulationService_DeleteScenario_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simulationServiceClient) ListScenarios(ctx context.Context, in *ListScenariosRequest, opts ...grpc.CallOption) (*ListScenariosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListScenariosResponse)
+	err := c.cc.Invoke(ctx, SimulationService_ListScenarios_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SimulationServiceServer is the server API for SimulationService service.
+// All implementations must embed UnimplementedSimulationServiceServer
+// for forward compatibility.
+type SimulationServiceServer interface {
+	SimulatePath(context.Context, *SimulatePathRequest) (*SimulatePathResponse, error)
+	PutScenario(context.Context, *Scenario) (*Scenario, error)
+	DeleteScenario(context.Context, *DeleteScenarioRequest) (*DeleteScenarioResponse, error)
+	ListScenarios(context.Context, *ListScenariosRequest) (*ListScenariosResponse, error)
+	mustEmbedUnimplementedSimulationServiceServer()
+}
+
+// UnimplementedSimulationServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSimulationServiceServer struct{}
+
+func (UnimplementedSimulationServiceServer) SimulatePath(context.Context, *SimulatePathRequest) (*SimulatePathResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulatePath not implemented")
+}
+func (UnimplementedSimulationServiceServer) PutScenario(context.Context, *Scenario) (*Scenario, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutScenario not implemented")
+}
+func (UnimplementedSimulationServiceServer) DeleteScenario(context.Context, *DeleteScenarioRequest) (*DeleteScenarioResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteScenario not implemented")
+}
+func (UnimplementedSimulationServiceServer) ListScenarios(context.Context, *ListScenariosRequest) (*ListScenariosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListScenarios not implemented")
+}
+func (UnimplementedSimulationServiceServer) mustEmbedUnimplementedSimulationServiceServer() {}
+func (UnimplementedSimulationServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeSimulationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SimulationServiceServer will
+// result in compilation errors.
+type UnsafeSimulationServiceServer interface {
+	mustEmbedUnimplementedSimulationServiceServer()
+}
+
+func RegisterSimulationServiceServer(s grpc.ServiceRegistrar, srv SimulationServiceServer) {
+	// If the following call pancis, it indicates UnimplementedSimulationServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SimulationService_ServiceDesc, srv)
+}
+
+func _SimulationService_SimulatePath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulatePathRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimulationServiceServer).SimulatePath(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SimulationService_SimulatePath_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimulationServiceServer).SimulatePath(ctx, req.(*SimulatePathRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SimulationService_PutScenario_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Scenario)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimulationServiceServer).PutScenario(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SimulationService_PutScenario_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimulationServiceServer).PutScenario(ctx, req.(*Scenario))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SimulationService_DeleteScenario_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteScenarioRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimulationServiceServer).DeleteScenario(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SimulationService_DeleteScenario_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimulationServiceServer).DeleteScenario(ctx, req.(*DeleteScenarioRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SimulationService_ListScenarios_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScenariosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimulationServiceServer).ListScenarios(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SimulationService_ListScenarios_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimulationServiceServer).ListScenarios(ctx, req.(*ListScenariosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SimulationService_ServiceDesc is the grpc.ServiceDesc for SimulationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SimulationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "warehouses.SimulationService",
+	HandlerType: (*SimulationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SimulatePath",
+			Handler:    _SimulationService_SimulatePath_Handler,
+		},
+		{
+			MethodName: "PutScenario",
+			Handler:    _SimulationService_PutScenario_Handler,
+		},
+		{
+			MethodName: "DeleteScenario",
+			Handler:    _SimulationService_DeleteScenario_Handler,
+		},
+		{
+			MethodName: "ListScenarios",
+			Handler:    _SimulationService_ListScenarios_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/warehouse.proto",
+}
+
+const (
+	ImpactService_GetImpact_FullMethodName                = "/warehouses.ImpactService/GetImpact"
+	ImpactService_GetSinglePointsOfFailure_FullMethodName = "/warehouses.ImpactService/GetSinglePointsOfFailure"
+)
+
+// ImpactServiceClient is the client API for ImpactService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ImpactServiceClient interface {
+	GetImpact(ctx context.Context, in *ImpactRequest, opts ...grpc.CallOption) (*Impact, error)
+	GetSinglePointsOfFailure(ctx context.Context, in *SinglePointsOfFailureRequest, opts ...grpc.CallOption) (*SinglePointsOfFailureResponse, error)
+}
+
+type impactServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewImpactServiceClient(cc grpc.ClientConnInterface) ImpactServiceClient {
+	return &impactServiceClient{cc}
+}
+
+func (c *impactServiceClient) GetImpact(ctx context.Context, in *ImpactRequest, opts ...grpc.CallOption) (*Impact, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Impact)
+	err := c.cc.Invoke(ctx, ImpactService_GetImpact_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *impactServiceClient) GetSinglePointsOfFailure(ctx context.Context, in *SinglePointsOfFailureRequest, opts ...grpc.CallOption) (*SinglePointsOfFailureResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SinglePointsOfFailureResponse)
+	err := c.cc.Invoke(ctx, ImpactService_GetSinglePointsOfFailure_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ImpactServiceServer is the server API for ImpactService service.
+// All implementations must embed UnimplementedImpactServiceServer
+// for forward compatibility.
+type ImpactServiceServer interface {
+	GetImpact(context.Context, *ImpactRequest) (*Impact, error)
+	GetSinglePointsOfFailure(context.Context, *SinglePointsOfFailureRequest) (*SinglePointsOfFailureResponse, error)
+	mustEmbedUnimplementedImpactServiceServer()
+}
+
+// UnimplementedImpactServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedImpactServiceServer struct{}
+
+func (UnimplementedImpactServiceServer) GetImpact(context.Context, *ImpactRequest) (*Impact, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetImpact not implemented")
+}
+func (UnimplementedImpactServiceServer) GetSinglePointsOfFailure(context.Context, *SinglePointsOfFailureRequest) (*SinglePointsOfFailureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSinglePointsOfFailure not implemented")
+}
+func (UnimplementedImpactServiceServer) mustEmbedUnimplementedImpactServiceServer() {}
+func (UnimplementedImpactServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeImpactServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ImpactServiceServer will
+// result in compilation errors.
+type UnsafeImpactServiceServer interface {
+	mustEmbedUnimplementedImpactServiceServer()
+}
+
+func RegisterImpactServiceServer(s grpc.ServiceRegistrar, srv ImpactServiceServer) {
+	// If the following call pancis, it indicates UnimplementedImpactServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ImpactService_ServiceDesc, srv)
+}
+
+func _ImpactService_GetImpact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImpactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImpactServiceServer).GetImpact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ImpactService_GetImpact_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImpactServiceServer).GetImpact(ctx, req.(*ImpactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ImpactService_GetSinglePointsOfFailure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SinglePointsOfFailureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImpactServiceServer).GetSinglePointsOfFailure(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ImpactService_GetSinglePointsOfFailure_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImpactServiceServer).GetSinglePointsOfFailure(ctx, req.(*SinglePointsOfFailureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ImpactService_ServiceDesc is the grpc.ServiceDesc for ImpactService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ImpactService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "warehouses.ImpactService",
+	HandlerType: (*ImpactServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetImpact",
+			Handler:    _ImpactService_GetImpact_Handler,
+		},
+		{
+			MethodName: "GetSinglePointsOfFailure",
+			Handler:    _ImpactService_GetSinglePointsOfFailure_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/warehouse.proto",
+}
+
+const (
+	TimeService_LocalNow_FullMethodName        = "/warehouses.TimeService/LocalNow"
+	TimeService_IsBeforeCutOff_FullMethodName  = "/warehouses.TimeService/IsBeforeCutOff"
+	TimeService_NextBusinessDay_FullMethodName = "/warehouses.TimeService/NextBusinessDay"
+	TimeService_ConvertTime_FullMethodName     = "/warehouses.TimeService/ConvertTime"
+)
+
+// TimeServiceClient is the client API for TimeService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TimeServiceClient interface {
+	LocalNow(ctx context.Context, in *LocalNowRequest, opts ...grpc.CallOption) (*LocalTime, error)
+	IsBeforeCutOff(ctx context.Context, in *CutOffRequest, opts ...grpc.CallOption) (*CutOffResponse, error)
+	NextBusinessDay(ctx context.Context, in *NextBusinessDayRequest, opts ...grpc.CallOption) (*LocalTime, error)
+	ConvertTime(ctx context.Context, in *ConvertTimeRequest, opts ...grpc.CallOption) (*ConvertTimeResponse, error)
+}
+
+type timeServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTimeServiceClient(cc grpc.ClientConnInterface) TimeServiceClient {
+	return &timeServiceClient{cc}
+}
+
+func (c *timeServiceClient) LocalNow(ctx context.Context, in *LocalNowRequest, opts ...grpc.CallOption) (*LocalTime, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LocalTime)
+	err := c.cc.Invoke(ctx, TimeService_LocalNow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timeServiceClient) IsBeforeCutOff(ctx context.Context, in *CutOffRequest, opts ...grpc.CallOption) (*CutOffResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CutOffResponse)
+	err := c.cc.Invoke(ctx, TimeService_IsBeforeCutOff_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timeServiceClient) NextBusinessDay(ctx context.Context, in *NextBusinessDayRequest, opts ...grpc.CallOption) (*LocalTime, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LocalTime)
+	err := c.cc.Invoke(ctx, TimeService_NextBusinessDay_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timeServiceClient) ConvertTime(ctx context.Context, in *ConvertTimeRequest, opts ...grpc.CallOption) (*ConvertTimeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConvertTimeResponse)
+	err := c.cc.Invoke(ctx, TimeService_ConvertTime_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TimeServiceServer is the server API for TimeService service.
+// All implementations must embed UnimplementedTimeServiceServer
+// for forward compatibility.
+type TimeServiceServer interface {
+	LocalNow(context.Context, *LocalNowRequest) (*LocalTime, error)
+	IsBeforeCutOff(context.Context, *CutOffRequest) (*CutOffResponse, error)
+	NextBusinessDay(context.Context, *NextBusinessDayRequest) (*LocalTime, error)
+	ConvertTime(context.Context, *ConvertTimeRequest) (*ConvertTimeResponse, error)
+	mustEmbedUnimplementedTimeServiceServer()
+}
+
+// UnimplementedTimeServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTimeServiceServer struct{}
+
+func (UnimplementedTimeServiceServer) LocalNow(context.Context, *LocalNowRequest) (*LocalTime, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LocalNow not implemented")
+}
+func (UnimplementedTimeServiceServer) IsBeforeCutOff(context.Context, *CutOffRequest) (*CutOffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsBeforeCutOff not implemented")
+}
+func (UnimplementedTimeServiceServer) NextBusinessDay(context.Context, *NextBusinessDayRequest) (*LocalTime, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NextBusinessDay not implemented")
+}
+func (UnimplementedTimeServiceServer) ConvertTime(context.Context, *ConvertTimeRequest) (*ConvertTimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertTime not implemented")
+}
+func (UnimplementedTimeServiceServer) mustEmbedUnimplementedTimeServiceServer() {}
+func (UnimplementedTimeServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeTimeServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TimeServiceServer will
+// result in compilation errors.
+type UnsafeTimeServiceServer interface {
+	mustEmbedUnimplementedTimeServiceServer()
+}
+
+func RegisterTimeServiceServer(s grpc.ServiceRegistrar, srv TimeServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTimeServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TimeService_ServiceDesc, srv)
+}
+
+func _TimeService_LocalNow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LocalNowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimeServiceServer).LocalNow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimeService_LocalNow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimeServiceServer).LocalNow(ctx, req.(*LocalNowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimeService_IsBeforeCutOff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CutOffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimeServiceServer).IsBeforeCutOff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimeService_IsBeforeCutOff_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimeServiceServer).IsBeforeCutOff(ctx, req.(*CutOffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimeService_NextBusinessDay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NextBusinessDayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimeServiceServer).NextBusinessDay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimeService_NextBusinessDay_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimeServiceServer).NextBusinessDay(ctx, req.(*NextBusinessDayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimeService_ConvertTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConvertTimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimeServiceServer).ConvertTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimeService_ConvertTime_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimeServiceServer).ConvertTime(ctx, req.(*ConvertTimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TimeService_ServiceDesc is the grpc.ServiceDesc for TimeService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TimeService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "warehouses.TimeService",
+	HandlerType: (*TimeServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "LocalNow",
+			Handler:    _TimeService_LocalNow_Handler,
+		},
+		{
+			MethodName: "IsBeforeCutOff",
+			Handler:    _TimeService_IsBeforeCutOff_Handler,
+		},
+		{
+			MethodName: "NextBusinessDay",
+			Handler:    _TimeService_NextBusinessDay_Handler,
+		},
+		{
+			MethodName: "ConvertTime",
+			Handler:    _TimeService_ConvertTime_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/warehouse.proto",
@@ -9,10 +9,12 @@ import (
 	"time"
 
 	"github.com/DimKa163/dalty/internal/logging"
+	"github.com/DimKa163/dalty/internal/product/cache"
 	"github.com/DimKa163/dalty/internal/product/core"
 	"github.com/DimKa163/dalty/internal/product/persistence"
 	"github.com/DimKa163/dalty/internal/product/server"
 	"github.com/DimKa163/dalty/internal/product/usecase"
+	"github.com/DimKa163/dalty/pkg/daltymodel"
 	"github.com/DimKa163/dalty/pkg/proto"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"go.uber.org/zap"
@@ -24,8 +26,13 @@ type ServiceContainer struct {
 	PgPool             *pgxpool.Pool
 	ProductRepository  core.ProductRepository
 	RelationRepository core.RelationRepository
+	ProductCache       *cache.ProductRepository
+	RelationCache      *cache.RelationRepository
 	GrpcServer         *grpc.Server
 	ProductService     *usecase.ProductService
+	TaxonomyService    *usecase.TaxonomyService
+	ChangeNotifier     *usecase.LocalNotifier
+	WatchService       *usecase.WatchService
 	binders            []proto.Binder
 	ProductServer      proto.Binder
 }
@@ -56,15 +63,39 @@ func (s *Server) AddServices() error {
 	if err != nil {
 		return err
 	}
+	groups, err := addProductGroups()
+	if err != nil {
+		return err
+	}
 	s.PgPool, err = addPgPool(s.Config.Database)
 	if err != nil {
 		return err
 	}
 	s.ProductRepository = addProductRepository(s.PgPool)
-	s.ProductService = addProductService(s.ProductRepository)
 	s.RelationRepository = addRelationRepository(s.PgPool)
-	s.binders = append(s.binders, server.NewProductServer(s.ProductService),
-		server.NewSpecificationServer(usecase.NewSpecificationService(s.ProductRepository, s.RelationRepository)))
+	if s.Config.CacheSize > 0 {
+		options := cache.Options{
+			Size:        s.Config.CacheSize,
+			TTL:         s.Config.CacheTTL,
+			NegativeTTL: s.Config.CacheNegativeTTL,
+		}
+		s.ProductCache = cache.NewProductRepository(s.ProductRepository, options)
+		s.RelationCache = cache.NewRelationRepository(s.RelationRepository, options)
+		s.ProductRepository = s.ProductCache
+		s.RelationRepository = s.RelationCache
+		s.binders = append(s.binders, addGrpcCacheServer(s.ProductCache, s.RelationCache))
+	}
+	s.TaxonomyService = addTaxonomyService(s.PgPool)
+	replacementService := addReplacementService(s.PgPool)
+	s.ProductService = addProductService(s.ProductRepository, persistence.NewNameSearchRepository(s.PgPool),
+		s.TaxonomyService, replacementService)
+	s.ChangeNotifier = usecase.NewLocalNotifier()
+	s.WatchService = addWatchService(s.PgPool, s.ChangeNotifier, s.Config.WatchInterval)
+	s.binders = append(s.binders, server.NewProductServer(s.ProductService, s.WatchService, groups),
+		server.NewTaxonomyServer(s.TaxonomyService, groups),
+		server.NewSeriesServer(usecase.NewSeriesService(s.ProductRepository, s.RelationRepository), groups),
+		server.NewQualityServer(addQualityService(s.PgPool)),
+		server.NewSpecificationServer(usecase.NewSpecificationService(s.ProductRepository, s.RelationRepository, replacementService), groups))
 	s.ServerImpl = proto.NewGRPCServer[*ServiceContainer](listener, addGrpcServer(), s.ServiceContainer)
 	return nil
 }
@@ -83,9 +114,21 @@ func (s *Server) Map() {
 }
 
 func (s *Server) Run() error {
+	logger := logging.GetLogger().Sugar()
 	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM, syscall.SIGQUIT)
 	defer cancel()
+	// without a taxonomy the group RPCs and subgroup searches report
+	// ErrTaxonomyNotLoaded, everything else keeps working
+	if err := s.TaxonomyService.Update(ctx); err != nil {
+		logger.Errorf("TaxonomyService.Update err: %v", err)
+	}
 	s.addSyscallObserver(ctx)
+	s.addTaxonomyRefresher(ctx)
+	listeners := []cache.Invalidator{changeSignal{notifier: s.ChangeNotifier}}
+	if s.ProductCache != nil {
+		listeners = append(listeners, s.ProductCache, s.RelationCache)
+	}
+	go cache.Listen(ctx, s.PgPool, s.Config.CacheChannel, listeners...)
 	return s.ListenAndServe()
 }
 
@@ -100,6 +143,28 @@ func (s *Server) addSyscallObserver(ctx context.Context) {
 	}()
 }
 
+// addTaxonomyRefresher reloads the taxonomy every TaxonomyInterval. A failed
+// reload keeps serving the previous taxonomy.
+func (s *Server) addTaxonomyRefresher(ctx context.Context) {
+	if s.Config.TaxonomyInterval <= 0 {
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(s.Config.TaxonomyInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := s.TaxonomyService.Update(ctx); err != nil {
+					logging.Logger(ctx).Error("error occurred when refreshing taxonomy", zap.Error(err))
+				}
+			}
+		}
+	}()
+}
+
 func addGrpcServer() *grpc.Server {
 	return grpc.NewServer(grpc.ChainUnaryInterceptor(interceptor.UnaryServerLoggingInterceptor()))
 }
@@ -110,6 +175,14 @@ func addPgPool(database string) (*pgxpool.Pool, error) {
 	}
 	return pg, nil
 }
+func addProductGroups() (*server.GroupMapping, error) {
+	registry, err := daltymodel.DefaultGroupRegistry()
+	if err != nil {
+		return nil, err
+	}
+	return server.NewGroupMapping(registry)
+}
+
 func addProductRepository(pool *pgxpool.Pool) core.ProductRepository {
 	return persistence.NewProductRepository(pool)
 }
@@ -118,6 +191,44 @@ func addRelationRepository(pool *pgxpool.Pool) core.RelationRepository {
 	return persistence.NewRelationRepository(pool)
 }
 
-func addProductService(productService core.ProductRepository) *usecase.ProductService {
-	return usecase.NewProductService(productService)
+func addProductService(productService core.ProductRepository, nameSearchRepository core.NameSearchRepository,
+	taxonomyService *usecase.TaxonomyService, replacementService *usecase.ReplacementService) *usecase.ProductService {
+	return usecase.NewProductService(productService, nameSearchRepository, taxonomyService, replacementService)
+}
+
+func addQualityService(pool *pgxpool.Pool) *usecase.QualityService {
+	return usecase.NewQualityService(persistence.NewQualityRepository(pool))
+}
+
+func addReplacementService(pool *pgxpool.Pool) *usecase.ReplacementService {
+	return usecase.NewReplacementService(persistence.NewSuccessorRepository(pool))
+}
+
+func addWatchService(pool *pgxpool.Pool, notifier *usecase.LocalNotifier, interval time.Duration) *usecase.WatchService {
+	return usecase.NewWatchService(persistence.NewChangeRepository(pool), notifier, interval)
+}
+
+func addTaxonomyService(pool *pgxpool.Pool) *usecase.TaxonomyService {
+	return usecase.NewTaxonomyService(persistence.NewTaxonomyRepository(pool))
+}
+
+func addGrpcCacheServer(productCache *cache.ProductRepository, relationCache *cache.RelationRepository) proto.Binder {
+	return server.NewCacheServer(map[string]server.Cache{
+		"product":  productCache,
+		"relation": relationCache,
+	})
+}
+
+// changeSignal wakes product watchers up on the same notifications that
+// invalidate the caches.
+type changeSignal struct {
+	notifier *usecase.LocalNotifier
+}
+
+func (c changeSignal) Invalidate(string) {
+	c.notifier.Notify()
+}
+
+func (c changeSignal) Purge() {
+	c.notifier.Notify()
 }
@@ -1,6 +1,23 @@
 package product
 
+import "time"
+
 type Config struct {
 	Addr     string `env:"ADDR" envDefault:":8080"`
 	Database string `env:"DATABASE,required"`
+	// CacheSize is the number of entries per repository cache, 0 disables
+	// caching.
+	CacheSize        int           `env:"CACHE_SIZE" envDefault:"10000"`
+	CacheTTL         time.Duration `env:"CACHE_TTL" envDefault:"5m"`
+	CacheNegativeTTL time.Duration `env:"CACHE_NEGATIVE_TTL" envDefault:"30s"`
+	// CacheChannel carries product change notifications, they invalidate
+	// the caches and wake product watchers up. The triggers of migration
+	// 0006 notify product_changed, a different channel needs them changed.
+	CacheChannel string `env:"CACHE_CHANNEL" envDefault:"product_changed"`
+	// WatchInterval is how often product watchers poll when no change
+	// notification arrives.
+	WatchInterval time.Duration `env:"WATCH_INTERVAL" envDefault:"30s"`
+	// TaxonomyInterval is how often the product group taxonomy is reloaded,
+	// 0 loads it only at startup.
+	TaxonomyInterval time.Duration `env:"TAXONOMY_INTERVAL" envDefault:"5m"`
 }
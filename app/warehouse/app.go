@@ -7,6 +7,7 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/DimKa163/dalty/pkg/geo"
 	"github.com/DimKa163/dalty/pkg/graph"
 	"github.com/DimKa163/dalty/pkg/proto"
 
@@ -24,11 +25,16 @@ import (
 
 type ServiceContainer struct {
 	PathService         *usecase.PathService
+	LocationService     *usecase.LocationService
+	SimulationService   *usecase.SimulationService
+	ImpactService       *usecase.ImpactService
+	TimeService         *usecase.TimeService
 	PgPool              *pgxpool.Pool
 	GrpcServer          *grpc.Server
 	GrpcPathServer      proto.Binder
 	WarehouseRepository core.WarehouseRepository
 	GraphContext        *graph.GraphContext
+	IndexContext        *geo.IndexContext
 	binders             []proto.Binder
 }
 
@@ -58,15 +64,22 @@ func (s *Server) AddServices() error {
 	if err != nil {
 		return err
 	}
-	s.ServerImpl = proto.NewGRPCServer[*ServiceContainer](listener, addGrpcServer(), s.ServiceContainer)
 	s.GraphContext = addGraphContext()
+	s.ServerImpl = proto.NewGRPCServer[*ServiceContainer](listener, addGrpcServer(s.GraphContext), s.ServiceContainer)
+	s.IndexContext = addIndexContext()
 	s.PgPool, err = addPgPool(s.Config.Database)
 	if err != nil {
 		return err
 	}
 	s.WarehouseRepository = addWarehouseRepository(s.PgPool)
 	s.PathService = addPathService(s.WarehouseRepository, s.GraphContext)
-	s.binders = append(s.binders, addGrpcPathServer(s.PathService))
+	s.LocationService = addLocationService(s.GraphContext, s.IndexContext)
+	s.SimulationService = addSimulationService(s.PathService, s.GraphContext)
+	s.ImpactService = addImpactService(s.GraphContext)
+	s.TimeService = addTimeService(s.GraphContext)
+	s.binders = append(s.binders, addGrpcPathServer(s.PathService), addGrpcLocationServer(s.LocationService),
+		addGrpcSimulationServer(s.SimulationService), addGrpcImpactServer(s.ImpactService),
+		addGrpcTimeServer(s.TimeService))
 	return nil
 }
 
@@ -87,14 +100,48 @@ func (s *Server) Run() error {
 	logger := logging.GetLogger().Sugar()
 	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM, syscall.SIGQUIT)
 	defer cancel()
-	if err := s.PathService.UpdateGraph(ctx); err != nil {
-		logger.Errorf("PathService.UpdateGraph err: %v", err)
+	if err := s.refresh(ctx); err != nil {
+		logger.Errorf("refresh err: %v", err)
 		return err
 	}
 	s.addSyscallObserver(ctx)
+	s.addRefresher(ctx)
 	return s.ListenAndServe()
 }
 
+// refresh swaps the graph snapshot and rebuilds what is derived from it.
+func (s *Server) refresh(ctx context.Context) error {
+	if err := s.PathService.UpdateGraph(ctx); err != nil {
+		return err
+	}
+	if err := s.LocationService.UpdateIndex(ctx); err != nil {
+		return err
+	}
+	return s.TimeService.UpdateZones(ctx)
+}
+
+// addRefresher reloads the warehouses every RefreshInterval. A failed
+// refresh keeps serving the previous snapshot.
+func (s *Server) addRefresher(ctx context.Context) {
+	if s.Config.RefreshInterval <= 0 {
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(s.Config.RefreshInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := s.refresh(ctx); err != nil {
+					logging.Logger(ctx).Error("error occurred when refreshing warehouses", zap.Error(err))
+				}
+			}
+		}
+	}()
+}
+
 func (s *Server) addSyscallObserver(ctx context.Context) {
 	go func() {
 		<-ctx.Done()
@@ -105,8 +152,9 @@ func (s *Server) addSyscallObserver(ctx context.Context) {
 		_ = s.Shutdown(timeoutCtx)
 	}()
 }
-func addGrpcServer() *grpc.Server {
-	return grpc.NewServer(grpc.ChainUnaryInterceptor(interceptor.UnaryServerLoggingInterceptor()))
+func addGrpcServer(graphContext *graph.GraphContext) *grpc.Server {
+	return grpc.NewServer(grpc.ChainUnaryInterceptor(interceptor.UnaryServerLoggingInterceptor(),
+		interceptor.UnaryServerSnapshotInterceptor(graphContext)))
 }
 
 func addPgPool(database string) (*pgxpool.Pool, error) {
@@ -125,6 +173,10 @@ func addGraphContext() *graph.GraphContext {
 	return graph.NewGraphContext()
 }
 
+func addIndexContext() *geo.IndexContext {
+	return geo.NewIndexContext()
+}
+
 func addPathService(repository core.WarehouseRepository,
 	graphContext *graph.GraphContext) *usecase.PathService {
 	return usecase.NewPathService(repository, core.NewPathFinder(graphContext), graphContext)
@@ -133,3 +185,37 @@ func addPathService(repository core.WarehouseRepository,
 func addGrpcPathServer(appService *usecase.PathService) *server.PathServer {
 	return server.NewPathServer(appService)
 }
+
+func addLocationService(graphContext *graph.GraphContext,
+	indexContext *geo.IndexContext) *usecase.LocationService {
+	return usecase.NewLocationService(graphContext, indexContext)
+}
+
+func addGrpcLocationServer(appService *usecase.LocationService) *server.LocationServer {
+	return server.NewLocationServer(appService)
+}
+
+func addSimulationService(pathService *usecase.PathService,
+	graphContext *graph.GraphContext) *usecase.SimulationService {
+	return usecase.NewSimulationService(pathService, graphContext)
+}
+
+func addGrpcSimulationServer(appService *usecase.SimulationService) *server.SimulationServer {
+	return server.NewSimulationServer(appService)
+}
+
+func addImpactService(graphContext *graph.GraphContext) *usecase.ImpactService {
+	return usecase.NewImpactService(graphContext)
+}
+
+func addGrpcImpactServer(appService *usecase.ImpactService) *server.ImpactServer {
+	return server.NewImpactServer(appService)
+}
+
+func addTimeService(graphContext *graph.GraphContext) *usecase.TimeService {
+	return usecase.NewTimeService(graphContext, time.Now)
+}
+
+func addGrpcTimeServer(appService *usecase.TimeService) *server.TimeServer {
+	return server.NewTimeServer(appService)
+}
@@ -1,6 +1,11 @@
 package warehouse
 
+import "time"
+
 type Config struct {
 	Addr     string `env:"ADDR" envDefault:":8080"`
 	Database string `env:"DATABASE,required"`
+	// RefreshInterval is how often the warehouse graph, location index and
+	// time zones are reloaded, 0 loads them only at startup.
+	RefreshInterval time.Duration `env:"REFRESH_INTERVAL" envDefault:"5m"`
 }
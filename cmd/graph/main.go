@@ -1,6 +1,8 @@
 package main
 
 import (
+	_ "time/tzdata"
+
 	"github.com/DimKa163/dalty/app/warehouse"
 	"github.com/caarlos0/env"
 )
@@ -0,0 +1,64 @@
+// Command quality scans the product and relation tables and writes a master
+// data quality report to stdout.
+//
+//	DATABASE=postgres://... quality -format csv -categories no_group,zero_dimensions
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/DimKa163/dalty/app/product"
+	"github.com/DimKa163/dalty/internal/product/core"
+	"github.com/DimKa163/dalty/internal/product/persistence"
+	"github.com/DimKa163/dalty/internal/product/usecase"
+	"github.com/caarlos0/env"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+func main() {
+	format := flag.String("format", "json", "report format, json or csv")
+	categories := flag.String("categories", "", "comma separated issue categories, all when empty")
+	flag.Parse()
+	if err := run(*format, *categories); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(format string, categoryList string) error {
+	var cfg product.Config
+	if err := env.Parse(&cfg); err != nil {
+		return err
+	}
+	var categories []core.IssueCategory
+	if categoryList != "" {
+		var err error
+		if categories, err = usecase.ParseIssueCategories(strings.Split(categoryList, ",")); err != nil {
+			return err
+		}
+	}
+	var write func(*usecase.QualityReport) error
+	switch format {
+	case "json":
+		write = func(r *usecase.QualityReport) error { return r.WriteJSON(os.Stdout) }
+	case "csv":
+		write = func(r *usecase.QualityReport) error { return r.WriteCSV(os.Stdout) }
+	default:
+		return fmt.Errorf("unknown format %q", format)
+	}
+	ctx := context.Background()
+	pool, err := pgxpool.New(ctx, cfg.Database)
+	if err != nil {
+		return err
+	}
+	defer pool.Close()
+	report, err := usecase.NewQualityService(persistence.NewQualityRepository(pool)).Report(ctx, categories)
+	if err != nil {
+		return err
+	}
+	return write(report)
+}
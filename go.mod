@@ -2,8 +2,9 @@ module github.com/DimKa163/dalty
 
 go 1.25.4
 
+require github.com/beevik/guid v1.0.0
+
 require (
-	github.com/beevik/guid v1.0.0 // indirect
 	github.com/caarlos0/env v3.5.0+incompatible // indirect
 	github.com/davecgh/go-spew v1.1.1 // indirect
 	github.com/golang/mock v1.6.0 // indirect
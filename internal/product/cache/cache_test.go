@@ -0,0 +1,164 @@
+package cache
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DimKa163/dalty/internal/product/core"
+	"github.com/DimKa163/dalty/internal/product/mocks"
+	"github.com/DimKa163/dalty/pkg/daltyerrors"
+	"github.com/beevik/guid"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLRUEvictsLeastRecentlyUsed(t *testing.T) {
+	c := newLRU[string, int](2, time.Now)
+	c.Put("a", 1, time.Minute)
+	c.Put("b", 2, time.Minute)
+	c.Get("a")
+	c.Put("c", 3, time.Minute)
+
+	_, ok := c.Get("b")
+	assert.False(t, ok)
+	v, ok := c.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, 1, v)
+}
+
+func TestLRUExpires(t *testing.T) {
+	now := time.Now()
+	c := newLRU[string, int](2, func() time.Time { return now })
+	c.Put("a", 1, time.Minute)
+
+	now = now.Add(2 * time.Minute)
+
+	_, ok := c.Get("a")
+	assert.False(t, ok)
+	assert.Equal(t, Stats{Hits: 0, Misses: 1, Size: 0}, c.Stats())
+}
+
+func TestProductIndexedUnderAllKeys(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	inner := mocks.NewMockProductRepository(ctrl)
+	product := &core.Product{ID: *guid.New(), Fnrec: "100", IntegrationID: "INT-1"}
+	inner.EXPECT().GetByFnrec(ctx, "100").Return(product, nil).Times(1)
+	sut := NewProductRepository(inner, Options{Size: 10, TTL: time.Minute, NegativeTTL: time.Minute})
+
+	_, err := sut.GetByFnrec(ctx, "100")
+	assert.NoError(t, err)
+	byID, err := sut.GetByID(ctx, product.ID.String())
+	assert.NoError(t, err)
+	byIntegrationID, err := sut.GetByIntegrationID(ctx, "INT-1")
+	assert.NoError(t, err)
+
+	assert.Equal(t, product, byID)
+	assert.Equal(t, product, byIntegrationID)
+	stats := sut.Stats()
+	assert.Equal(t, uint64(2), stats.Hits)
+	assert.Equal(t, uint64(1), stats.Misses)
+}
+
+func TestProductNegativeLookupIsCached(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	inner := mocks.NewMockProductRepository(ctrl)
+	inner.EXPECT().GetByFnrec(ctx, "404").
+		Return(nil, daltyerrors.NewNotFoundError(daltyerrors.ErrNotFound, "product not found by fnrec", "404")).
+		Times(1)
+	sut := NewProductRepository(inner, Options{Size: 10, TTL: time.Minute, NegativeTTL: time.Minute})
+
+	_, err := sut.GetByFnrec(ctx, "404")
+	assert.ErrorIs(t, err, daltyerrors.ErrNotFound)
+	_, err = sut.GetByFnrec(ctx, "404")
+	assert.ErrorIs(t, err, daltyerrors.ErrNotFound)
+}
+
+func TestProductBatchFetchesOnlyMisses(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	inner := mocks.NewMockProductRepository(ctrl)
+	cached := &core.Product{ID: *guid.New(), Fnrec: "1"}
+	loaded := &core.Product{ID: *guid.New(), Fnrec: "2"}
+	inner.EXPECT().GetByFnrec(ctx, "1").Return(cached, nil)
+	inner.EXPECT().GetByFnrecs(ctx, []string{"2", "3"}).
+		Return(map[string]*core.Product{"2": loaded}, nil).Times(1)
+	sut := NewProductRepository(inner, Options{Size: 10, TTL: time.Minute, NegativeTTL: time.Minute})
+	_, _ = sut.GetByFnrec(ctx, "1")
+
+	result, err := sut.GetByFnrecs(ctx, []string{"1", "2", "3"})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]*core.Product{"1": cached, "2": loaded}, result)
+
+	result, err = sut.GetByFnrecs(ctx, []string{"2", "3"})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]*core.Product{"2": loaded}, result)
+}
+
+func TestProductInvalidate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	inner := mocks.NewMockProductRepository(ctrl)
+	product := &core.Product{ID: *guid.New(), Fnrec: "100", IntegrationID: "INT-1"}
+	inner.EXPECT().GetByID(ctx, product.ID.String()).Return(product, nil).Times(1)
+	inner.EXPECT().GetByIntegrationID(ctx, "INT-1").Return(product, nil).Times(1)
+	sut := NewProductRepository(inner, Options{Size: 10, TTL: time.Minute, NegativeTTL: time.Minute})
+	_, _ = sut.GetByID(ctx, product.ID.String())
+
+	sut.Invalidate(product.ID.String())
+
+	_, err := sut.GetByIntegrationID(ctx, "INT-1")
+	assert.NoError(t, err)
+}
+
+func TestProductInvalidateSecondaryKeys(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	inner := mocks.NewMockProductRepository(ctrl)
+	product := &core.Product{ID: *guid.New(), Fnrec: "100", IntegrationID: "INT-1"}
+	inner.EXPECT().GetByIntegrationID(ctx, "INT-1").Return(product, nil).Times(1)
+	inner.EXPECT().GetByFnrec(ctx, "100").Return(product, nil).Times(1)
+	sut := NewProductRepository(inner, Options{Size: 10, TTL: time.Minute, NegativeTTL: time.Minute})
+	_, _ = sut.GetByIntegrationID(ctx, "INT-1")
+	// the id key is gone, the product is still cached by fnrec and integration id
+	sut.entries.Remove(keyOf(byID, product.ID.String()))
+
+	sut.Invalidate(strings.ToUpper(product.ID.String()))
+
+	_, err := sut.GetByFnrec(ctx, "100")
+	assert.NoError(t, err)
+}
+
+func TestRelationInvalidateByRightProduct(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	inner := mocks.NewMockRelationRepository(ctrl)
+	left := *guid.New()
+	right := *guid.New()
+	relations := []*core.Relation{{ID: *guid.New(), LeftID: left, RightID: right}}
+	inner.EXPECT().GetByLeftID(ctx, left).Return(relations, nil).Times(2)
+	sut := NewRelationRepository(inner, Options{Size: 10, TTL: time.Minute, NegativeTTL: time.Minute})
+
+	_, _ = sut.GetByLeftID(ctx, left)
+	_, _ = sut.GetByLeftID(ctx, left)
+	sut.Invalidate(right.String())
+	result, err := sut.GetByLeftID(ctx, left)
+
+	assert.NoError(t, err)
+	assert.Equal(t, relations, result)
+}
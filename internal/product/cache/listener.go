@@ -0,0 +1,69 @@
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/DimKa163/dalty/internal/logging"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Invalidator is a cache that can forget a product.
+type Invalidator interface {
+	Invalidate(id string)
+	Purge()
+}
+
+const listenRetryDelay = 5 * time.Second
+
+// Listen subscribes to channel and invalidates caches on every notification
+// until ctx is done. The payload is a product id, an empty payload purges the
+// caches. Notifications sent while the connection is down are lost, so the
+// caches are purged once LISTEN is back after a reconnect.
+func Listen(ctx context.Context, pool *pgxpool.Pool, channel string, caches ...Invalidator) {
+	logger := logging.Logger(ctx).Sugar()
+	reconnect := false
+	for {
+		err := listen(ctx, pool, channel, caches, reconnect)
+		if ctx.Err() != nil {
+			return
+		}
+		logger.Errorf("cache listener on %s: %v", channel, err)
+		reconnect = true
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(listenRetryDelay):
+		}
+	}
+}
+
+func listen(ctx context.Context, pool *pgxpool.Pool, channel string, caches []Invalidator, purge bool) error {
+	conn, err := pool.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Release()
+	if _, err = conn.Exec(ctx, "LISTEN "+pgx.Identifier{channel}.Sanitize()); err != nil {
+		return err
+	}
+	if purge {
+		for _, c := range caches {
+			c.Purge()
+		}
+	}
+	for {
+		notification, err := conn.Conn().WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+		for _, c := range caches {
+			if notification.Payload == "" {
+				c.Purge()
+			} else {
+				c.Invalidate(notification.Payload)
+			}
+		}
+	}
+}
@@ -0,0 +1,138 @@
+package cache
+
+import (
+	"container/list"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Stats counts lookups served by a cache since it was created.
+type Stats struct {
+	Hits   uint64
+	Misses uint64
+	Size   int
+}
+
+// HitRatio is the share of lookups served from the cache, 0 when nothing has
+// been looked up yet.
+func (s Stats) HitRatio() float64 {
+	total := s.Hits + s.Misses
+	if total == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(total)
+}
+
+type item[K comparable, V any] struct {
+	key     K
+	value   V
+	expires time.Time
+}
+
+// lru is a size bounded map that evicts the least recently used entry and
+// drops entries older than their ttl on read.
+type lru[K comparable, V any] struct {
+	mu       sync.Mutex
+	capacity int
+	items    map[K]*list.Element
+	order    *list.List
+	now      func() time.Time
+	hits     atomic.Uint64
+	misses   atomic.Uint64
+}
+
+func newLRU[K comparable, V any](capacity int, now func() time.Time) *lru[K, V] {
+	return &lru[K, V]{
+		capacity: capacity,
+		items:    make(map[K]*list.Element, capacity),
+		order:    list.New(),
+		now:      now,
+	}
+}
+
+func (c *lru[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, ok := c.items[key]; ok {
+		it := el.Value.(*item[K, V])
+		if c.now().Before(it.expires) {
+			c.order.MoveToFront(el)
+			c.hits.Add(1)
+			return it.value, true
+		}
+		c.remove(el)
+	}
+	c.misses.Add(1)
+	var zero V
+	return zero, false
+}
+
+func (c *lru[K, V]) Put(key K, value V, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	expires := c.now().Add(ttl)
+	if el, ok := c.items[key]; ok {
+		it := el.Value.(*item[K, V])
+		it.value = value
+		it.expires = expires
+		c.order.MoveToFront(el)
+		return
+	}
+	c.items[key] = c.order.PushFront(&item[K, V]{key: key, value: value, expires: expires})
+	for c.order.Len() > c.capacity {
+		c.remove(c.order.Back())
+	}
+}
+
+// Peek returns the entry without touching its position or the counters.
+func (c *lru[K, V]) Peek(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, ok := c.items[key]; ok {
+		return el.Value.(*item[K, V]).value, true
+	}
+	var zero V
+	return zero, false
+}
+
+func (c *lru[K, V]) Remove(key K) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, ok := c.items[key]; ok {
+		c.remove(el)
+	}
+}
+
+// RemoveFunc drops every entry the predicate matches.
+func (c *lru[K, V]) RemoveFunc(match func(key K, value V) bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for el := c.order.Front(); el != nil; {
+		next := el.Next()
+		it := el.Value.(*item[K, V])
+		if match(it.key, it.value) {
+			c.remove(el)
+		}
+		el = next
+	}
+}
+
+func (c *lru[K, V]) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.items = make(map[K]*list.Element, c.capacity)
+	c.order.Init()
+}
+
+func (c *lru[K, V]) Stats() Stats {
+	c.mu.Lock()
+	size := c.order.Len()
+	c.mu.Unlock()
+	return Stats{Hits: c.hits.Load(), Misses: c.misses.Load(), Size: size}
+}
+
+func (c *lru[K, V]) remove(el *list.Element) {
+	c.order.Remove(el)
+	delete(c.items, el.Value.(*item[K, V]).key)
+}
@@ -0,0 +1,185 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/DimKa163/dalty/internal/product/core"
+	"github.com/DimKa163/dalty/pkg/daltyerrors"
+)
+
+// Options configures a cache. NegativeTTL applies to lookups that found
+// nothing and is usually shorter than TTL.
+type Options struct {
+	Size        int
+	TTL         time.Duration
+	NegativeTTL time.Duration
+}
+
+type keyKind uint8
+
+const (
+	byID keyKind = iota
+	byFnrec
+	byIntegrationID
+)
+
+type key struct {
+	kind  keyKind
+	value string
+}
+
+// entry is a cached lookup. A nil product means the lookup found nothing and
+// err is the not found error to return again.
+type entry struct {
+	product *core.Product
+	err     error
+}
+
+// ProductRepository is a read-through cache in front of another repository.
+// A product is indexed under its id, fnrec and integration id, so a lookup
+// by any of them warms the other two.
+type ProductRepository struct {
+	inner   core.ProductRepository
+	entries *lru[key, *entry]
+	options Options
+}
+
+func NewProductRepository(inner core.ProductRepository, options Options) *ProductRepository {
+	return &ProductRepository{
+		inner:   inner,
+		entries: newLRU[key, *entry](options.Size, time.Now),
+		options: options,
+	}
+}
+
+func (r *ProductRepository) GetByID(ctx context.Context, id string) (*core.Product, error) {
+	return r.get(ctx, keyOf(byID, id), id, r.inner.GetByID)
+}
+
+func (r *ProductRepository) GetByFnrec(ctx context.Context, fnrec string) (*core.Product, error) {
+	return r.get(ctx, keyOf(byFnrec, fnrec), fnrec, r.inner.GetByFnrec)
+}
+
+func (r *ProductRepository) GetByIntegrationID(ctx context.Context, integrationID string) (*core.Product, error) {
+	return r.get(ctx, keyOf(byIntegrationID, integrationID), integrationID, r.inner.GetByIntegrationID)
+}
+
+func (r *ProductRepository) GetByIDs(ctx context.Context, ids []string) (map[string]*core.Product, error) {
+	return r.getMany(ctx, byID, ids, r.inner.GetByIDs)
+}
+
+func (r *ProductRepository) GetByFnrecs(ctx context.Context, fnrecs []string) (map[string]*core.Product, error) {
+	return r.getMany(ctx, byFnrec, fnrecs, r.inner.GetByFnrecs)
+}
+
+func (r *ProductRepository) GetByIntegrationIDs(ctx context.Context, integrationIDs []string) (map[string]*core.Product, error) {
+	return r.getMany(ctx, byIntegrationID, integrationIDs, r.inner.GetByIntegrationIDs)
+}
+
+// Search is not cached, its results depend on the whole table.
+func (r *ProductRepository) Search(ctx context.Context, query *core.SearchQuery) ([]*core.Product, error) {
+	return r.inner.Search(ctx, query)
+}
+
+// GetBySeriesID is not cached, the members of a series change as products
+// are added. The products it returns warm the cache.
+func (r *ProductRepository) GetBySeriesID(ctx context.Context, seriesID string) ([]*core.Product, error) {
+	products, err := r.inner.GetBySeriesID(ctx, seriesID)
+	if err != nil {
+		return nil, err
+	}
+	for _, product := range products {
+		r.put(product)
+	}
+	return products, nil
+}
+
+// Invalidate drops the product with the given id under all of its keys.
+// Negative entries are dropped as well since the product may be new and we
+// don't know which of them it answers.
+func (r *ProductRepository) Invalidate(id string) {
+	r.entries.Remove(keyOf(byID, id))
+	r.entries.RemoveFunc(func(_ key, e *entry) bool {
+		return e.product == nil || strings.EqualFold(e.product.ID.String(), id)
+	})
+}
+
+func (r *ProductRepository) Purge() {
+	r.entries.Purge()
+}
+
+func (r *ProductRepository) Stats() Stats {
+	return r.entries.Stats()
+}
+
+func (r *ProductRepository) get(ctx context.Context, k key, value string,
+	load func(context.Context, string) (*core.Product, error)) (*core.Product, error) {
+	if e, ok := r.entries.Get(k); ok {
+		return e.product, e.err
+	}
+	product, err := load(ctx, value)
+	if err != nil {
+		if errors.Is(err, daltyerrors.ErrNotFound) {
+			r.entries.Put(k, &entry{err: err}, r.options.NegativeTTL)
+		}
+		return nil, err
+	}
+	r.put(product)
+	return product, nil
+}
+
+func (r *ProductRepository) getMany(ctx context.Context, kind keyKind, values []string,
+	load func(context.Context, []string) (map[string]*core.Product, error)) (map[string]*core.Product, error) {
+	result := make(map[string]*core.Product, len(values))
+	missing := make([]string, 0)
+	for _, value := range values {
+		e, ok := r.entries.Get(keyOf(kind, value))
+		if !ok {
+			missing = append(missing, value)
+			continue
+		}
+		if e.product != nil {
+			result[value] = e.product
+		}
+	}
+	if len(missing) == 0 {
+		return result, nil
+	}
+	loaded, err := load(ctx, missing)
+	if err != nil {
+		return nil, err
+	}
+	for _, value := range missing {
+		product, ok := loaded[value]
+		if !ok {
+			r.entries.Put(keyOf(kind, value),
+				&entry{err: daltyerrors.NewNotFoundError(daltyerrors.ErrNotFound, "product not found", value)},
+				r.options.NegativeTTL)
+			continue
+		}
+		r.put(product)
+		result[value] = product
+	}
+	return result, nil
+}
+
+func (r *ProductRepository) put(product *core.Product) {
+	e := &entry{product: product}
+	r.entries.Put(key{kind: byID, value: product.ID.String()}, e, r.options.TTL)
+	if product.Fnrec != "" {
+		r.entries.Put(key{kind: byFnrec, value: product.Fnrec}, e, r.options.TTL)
+	}
+	if product.IntegrationID != "" {
+		r.entries.Put(key{kind: byIntegrationID, value: product.IntegrationID}, e, r.options.TTL)
+	}
+}
+
+func keyOf(kind keyKind, value string) key {
+	if kind == byID {
+		value = strings.ToLower(value)
+	}
+	return key{kind: kind, value: value}
+}
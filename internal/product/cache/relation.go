@@ -0,0 +1,125 @@
+package cache
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/DimKa163/dalty/internal/product/core"
+	"github.com/beevik/guid"
+)
+
+// RelationRepository caches the relations of a product by the identifier it
+// was asked with. Products without relations are cached as empty lists.
+type RelationRepository struct {
+	inner   core.RelationRepository
+	entries *lru[key, []*core.Relation]
+	options Options
+}
+
+func NewRelationRepository(inner core.RelationRepository, options Options) *RelationRepository {
+	return &RelationRepository{
+		inner:   inner,
+		entries: newLRU[key, []*core.Relation](options.Size, time.Now),
+		options: options,
+	}
+}
+
+func (r *RelationRepository) GetByLeftID(ctx context.Context, id guid.Guid) ([]*core.Relation, error) {
+	return r.get(ctx, keyOf(byID, id.String()), func(ctx context.Context) ([]*core.Relation, error) {
+		return r.inner.GetByLeftID(ctx, id)
+	})
+}
+
+func (r *RelationRepository) GetByLeftIDs(ctx context.Context, ids []guid.Guid) (map[guid.Guid][]*core.Relation, error) {
+	result := make(map[guid.Guid][]*core.Relation, len(ids))
+	missing := make([]guid.Guid, 0)
+	for _, id := range ids {
+		relations, ok := r.entries.Get(keyOf(byID, id.String()))
+		if !ok {
+			missing = append(missing, id)
+			continue
+		}
+		if len(relations) > 0 {
+			result[id] = relations
+		}
+	}
+	if len(missing) == 0 {
+		return result, nil
+	}
+	loaded, err := r.inner.GetByLeftIDs(ctx, missing)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range missing {
+		relations := loaded[id]
+		ttl := r.options.TTL
+		if len(relations) == 0 {
+			relations = make([]*core.Relation, 0)
+			ttl = r.options.NegativeTTL
+		} else {
+			result[id] = relations
+		}
+		r.entries.Put(keyOf(byID, id.String()), relations, ttl)
+	}
+	return result, nil
+}
+
+func (r *RelationRepository) GetByLeftFnrec(ctx context.Context, fnrec string) ([]*core.Relation, error) {
+	return r.get(ctx, keyOf(byFnrec, fnrec), func(ctx context.Context) ([]*core.Relation, error) {
+		return r.inner.GetByLeftFnrec(ctx, fnrec)
+	})
+}
+
+func (r *RelationRepository) GetByLeftIntegrationID(ctx context.Context, integrationID string) ([]*core.Relation, error) {
+	return r.get(ctx, keyOf(byIntegrationID, integrationID), func(ctx context.Context) ([]*core.Relation, error) {
+		return r.inner.GetByLeftIntegrationID(ctx, integrationID)
+	})
+}
+
+// GetByRightID is not cached, it is only used by reverse specifications.
+func (r *RelationRepository) GetByRightID(ctx context.Context, lid, rid guid.Guid) (*core.Relation, *core.Relation, error) {
+	return r.inner.GetByRightID(ctx, lid, rid)
+}
+
+// Invalidate drops every cached list the product takes part in on either
+// side, along with the empty lists that it may now fill.
+func (r *RelationRepository) Invalidate(id string) {
+	id = strings.ToLower(id)
+	r.entries.RemoveFunc(func(k key, relations []*core.Relation) bool {
+		if len(relations) == 0 || (k.kind == byID && k.value == id) {
+			return true
+		}
+		for _, rel := range relations {
+			if rel.LeftID.String() == id || rel.RightID.String() == id {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+func (r *RelationRepository) Purge() {
+	r.entries.Purge()
+}
+
+func (r *RelationRepository) Stats() Stats {
+	return r.entries.Stats()
+}
+
+func (r *RelationRepository) get(ctx context.Context, k key,
+	load func(context.Context) ([]*core.Relation, error)) ([]*core.Relation, error) {
+	if relations, ok := r.entries.Get(k); ok {
+		return relations, nil
+	}
+	relations, err := load(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ttl := r.options.TTL
+	if len(relations) == 0 {
+		ttl = r.options.NegativeTTL
+	}
+	r.entries.Put(k, relations, ttl)
+	return relations, nil
+}
@@ -0,0 +1,37 @@
+package core
+
+import (
+	"context"
+	"time"
+
+	"github.com/beevik/guid"
+)
+
+// ProductChange is the current state of a product that was updated at
+// UpdatedAt.
+type ProductChange struct {
+	Product   *Product
+	UpdatedAt time.Time
+}
+
+// ChangeCursor is the last change a watcher has seen. Changes are ordered by
+// update time and then by id.
+type ChangeCursor struct {
+	UpdatedAt time.Time
+	ID        guid.Guid
+}
+
+type ChangeRepository interface {
+	// GetChanges returns up to limit changes that come after the cursor.
+	GetChanges(ctx context.Context, after *ChangeCursor, limit int) ([]*ProductChange, error)
+
+	// LatestChange is the cursor of the most recent change, nil when there
+	// are no products.
+	LatestChange(ctx context.Context) (*ChangeCursor, error)
+}
+
+// ChangeNotifier wakes watchers up when products may have changed. Signals
+// carry no data, watchers read the changes from the repository.
+type ChangeNotifier interface {
+	Subscribe() (signals <-chan struct{}, cancel func())
+}
@@ -1,7 +1,43 @@
 package core
 
-import "github.com/beevik/guid"
+import (
+	"github.com/DimKa163/dalty/pkg/daltymodel"
+	"github.com/beevik/guid"
+)
 
+// PickupRule sets the pickup strategy for the products of a group, or of the
+// whole subtree below it when IncludeSubgroups is set.
 type PickupRule struct {
-	ID guid.Guid
+	ID               guid.Guid
+	Group            daltymodel.ProductGroup
+	IncludeSubgroups bool
+	Strategy         daltymodel.PickupStrategy
+}
+
+// Applies tells whether the rule covers the group, directly or through its
+// subtree.
+func (r *PickupRule) Applies(group daltymodel.ProductGroup, taxonomy *Taxonomy) bool {
+	if r.Group == group {
+		return true
+	}
+	return r.IncludeSubgroups && taxonomy != nil && taxonomy.Contains(r.Group, group)
+}
+
+// MatchPickupRule picks the most specific rule for the group: the one set on
+// the group itself, otherwise the one on its nearest ancestor.
+func MatchPickupRule(rules []*PickupRule, group daltymodel.ProductGroup, taxonomy *Taxonomy) (*PickupRule, bool) {
+	var best *PickupRule
+	bestDepth := -1
+	for _, r := range rules {
+		if !r.Applies(group, taxonomy) {
+			continue
+		}
+		if r.Group == group {
+			return r, true
+		}
+		if depth := taxonomy.Depth(r.Group); depth > bestDepth {
+			best, bestDepth = r, depth
+		}
+	}
+	return best, best != nil
 }
@@ -0,0 +1,43 @@
+package core
+
+import (
+	"context"
+)
+
+// IssueCategory names a kind of master data problem.
+type IssueCategory string
+
+const (
+	// IssueZeroDimensions is a shipped product with a zero pack volume,
+	// length, width, height or weight.
+	IssueZeroDimensions IssueCategory = "zero_dimensions"
+	// IssueUnknownProductionType is a product that is neither produced nor
+	// purchased, orders for it fail with error 11.
+	IssueUnknownProductionType IssueCategory = "unknown_production_type"
+	// IssueNoGroup is a product without a product group.
+	IssueNoGroup IssueCategory = "no_group"
+	// IssueCountMaMismatch is a product whose CountMa differs from the
+	// number of its relation rows.
+	IssueCountMaMismatch IssueCategory = "count_ma_mismatch"
+)
+
+// IssueCategories lists every category in report order.
+var IssueCategories = []IssueCategory{
+	IssueZeroDimensions,
+	IssueUnknownProductionType,
+	IssueNoGroup,
+	IssueCountMaMismatch,
+}
+
+// ScannedProduct is a product together with the number of relation rows it
+// is the left side of.
+type ScannedProduct struct {
+	*Product
+	Relations int32
+}
+
+type QualityRepository interface {
+	// Walk calls fn for every active product in id order and stops at the
+	// first error fn returns.
+	Walk(ctx context.Context, fn func(*ScannedProduct) error) error
+}
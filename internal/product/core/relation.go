@@ -17,6 +17,9 @@ type Relation struct {
 
 type RelationRepository interface {
 	GetByLeftID(ctx context.Context, id guid.Guid) ([]*Relation, error)
+
+	GetByLeftIDs(ctx context.Context, ids []guid.Guid) (map[guid.Guid][]*Relation, error)
+
 	GetByLeftFnrec(ctx context.Context, fnrec string) ([]*Relation, error)
 
 	GetByLeftIntegrationID(ctx context.Context, integrationID string) ([]*Relation, error)
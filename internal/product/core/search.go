@@ -0,0 +1,58 @@
+package core
+
+import (
+	"context"
+
+	"github.com/DimKa163/dalty/pkg/daltymodel"
+	"github.com/beevik/guid"
+)
+
+// SearchFilter narrows a product search. Zero values and nil pointers don't
+// filter. With IncludeSubgroups the search covers the whole subtree below
+// Group, Groups then holds the subtree.
+type SearchFilter struct {
+	Group            daltymodel.ProductGroup
+	IncludeSubgroups bool
+	Groups           []daltymodel.ProductGroup
+	SeriesID         string
+	CategoryID       string
+	ProductionType   daltymodel.ProductionType
+	IsService        *bool
+	IsArchive        *bool
+	Name             string
+	NamePrefix       bool
+}
+
+// SearchCursor is the last product of the previous page. Products are
+// ordered by name and then by id.
+type SearchCursor struct {
+	Name string
+	ID   guid.Guid
+}
+
+type SearchQuery struct {
+	Filter *SearchFilter
+	After  *SearchCursor
+	Limit  int
+}
+
+// ScoredProduct is a name search hit. Score is the word similarity of the
+// query to the product name, from 0 to 1.
+type ScoredProduct struct {
+	*Product
+	Score float64
+}
+
+// NameSearchQuery looks products up by a partial or misspelled name.
+type NameSearchQuery struct {
+	Text     string
+	Filter   *SearchFilter
+	MinScore float64
+	Limit    int
+}
+
+type NameSearchRepository interface {
+	// SearchByName returns products scoring at least MinScore, best first,
+	// ties ordered by name and then by id.
+	SearchByName(ctx context.Context, query *NameSearchQuery) ([]*ScoredProduct, error)
+}
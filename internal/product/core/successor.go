@@ -0,0 +1,22 @@
+package core
+
+import (
+	"context"
+
+	"github.com/beevik/guid"
+)
+
+// Successor links a product to one that replaces it. Rank orders the
+// successors of a product, lower first.
+type Successor struct {
+	ProductID   guid.Guid
+	SuccessorID guid.Guid
+	Rank        int32
+	Successor   *Product
+}
+
+type SuccessorRepository interface {
+	// GetByProductIDs returns the successors of several products keyed by
+	// product id, each list ordered by rank.
+	GetByProductIDs(ctx context.Context, ids []guid.Guid) (map[guid.Guid][]*Successor, error)
+}
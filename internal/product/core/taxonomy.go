@@ -0,0 +1,144 @@
+package core
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/DimKa163/dalty/pkg/daltymodel"
+	"github.com/DimKa163/dalty/pkg/graph"
+)
+
+// GroupNode is a product group in the taxonomy. Top level groups have no
+// parent.
+type GroupNode struct {
+	ID       daltymodel.ProductGroup
+	ParentID daltymodel.ProductGroup
+	Name     string
+}
+
+// Taxonomy is the product group tree. Edges go from a parent to its children.
+type Taxonomy struct {
+	graph *graph.Graph
+	roots []*GroupNode
+}
+
+// NewTaxonomy builds the tree and rejects unknown parents and cycles.
+func NewTaxonomy(groups []*GroupNode) (*Taxonomy, error) {
+	g := graph.NewGraph()
+	for _, group := range groups {
+		if _, ok := g.Find(string(group.ID)); ok {
+			return nil, fmt.Errorf("product group %s: duplicate", group.ID)
+		}
+		g.AddNode(&graph.Node{ID: string(group.ID), Value: group})
+	}
+	roots := make([]*GroupNode, 0)
+	for _, group := range groups {
+		if group.ParentID == "" {
+			roots = append(roots, group)
+			continue
+		}
+		parent, ok := g.Find(string(group.ParentID))
+		if !ok {
+			return nil, fmt.Errorf("product group %s: unknown parent %s", group.ID, group.ParentID)
+		}
+		child, _ := g.Find(string(group.ID))
+		g.AddEdge(parent, child, 1)
+	}
+	t := &Taxonomy{graph: g, roots: roots}
+	// every group has one parent, so a group is on a cycle exactly when no
+	// root leads to it
+	reached := len(roots)
+	for _, root := range roots {
+		reached += len(t.Descendants(root.ID))
+	}
+	if reached != len(groups) {
+		return nil, fmt.Errorf("product groups: %d groups form a cycle", len(groups)-reached)
+	}
+	return t, nil
+}
+
+func (t *Taxonomy) Find(id daltymodel.ProductGroup) (*GroupNode, bool) {
+	n, ok := t.graph.Find(string(id))
+	if !ok {
+		return nil, false
+	}
+	return groupOf(n), true
+}
+
+func (t *Taxonomy) Roots() []*GroupNode {
+	return t.roots
+}
+
+func (t *Taxonomy) Children(id daltymodel.ProductGroup) []*GroupNode {
+	n, ok := t.graph.Find(string(id))
+	if !ok {
+		return nil
+	}
+	children := make([]*GroupNode, 0)
+	for e := range t.graph.Outgoing(n) {
+		children = append(children, groupOf(e.To))
+	}
+	return children
+}
+
+// Ancestors lists the parents of the group, nearest first.
+func (t *Taxonomy) Ancestors(id daltymodel.ProductGroup) []*GroupNode {
+	n, ok := t.graph.Find(string(id))
+	if !ok {
+		return nil
+	}
+	return groupsOf(graph.Ancestors(t.graph, n))
+}
+
+// Descendants lists every group below the given one, level by level.
+func (t *Taxonomy) Descendants(id daltymodel.ProductGroup) []*GroupNode {
+	n, ok := t.graph.Find(string(id))
+	if !ok {
+		return nil
+	}
+	return groupsOf(graph.Descendants(t.graph, n))
+}
+
+// Subtree is the group followed by all of its descendants.
+func (t *Taxonomy) Subtree(id daltymodel.ProductGroup) []daltymodel.ProductGroup {
+	subtree := []daltymodel.ProductGroup{id}
+	for _, g := range t.Descendants(id) {
+		subtree = append(subtree, g.ID)
+	}
+	return subtree
+}
+
+// Depth is the number of ancestors of the group.
+func (t *Taxonomy) Depth(id daltymodel.ProductGroup) int {
+	return len(t.Ancestors(id))
+}
+
+// Contains tells whether the group is root or lies below it.
+func (t *Taxonomy) Contains(root, id daltymodel.ProductGroup) bool {
+	if root == id {
+		return true
+	}
+	for _, ancestor := range t.Ancestors(id) {
+		if ancestor.ID == root {
+			return true
+		}
+	}
+	return false
+}
+
+func groupOf(n *graph.Node) *GroupNode {
+	group, _ := graph.Cast[*GroupNode](n)
+	return group
+}
+
+func groupsOf(nodes []*graph.Node) []*GroupNode {
+	groups := make([]*GroupNode, len(nodes))
+	for i, n := range nodes {
+		groups[i] = groupOf(n)
+	}
+	return groups
+}
+
+type TaxonomyRepository interface {
+	GetGroups(ctx context.Context) ([]*GroupNode, error)
+}
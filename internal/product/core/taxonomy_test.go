@@ -0,0 +1,75 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/DimKa163/dalty/pkg/daltymodel"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testTaxonomy(t *testing.T) *Taxonomy {
+	taxonomy, err := NewTaxonomy([]*GroupNode{
+		{ID: daltymodel.ProductGroupSpaceOrganizationUpper, Name: "Space organization"},
+		{ID: daltymodel.ProductGroupSpaceOrganization, ParentID: daltymodel.ProductGroupSpaceOrganizationUpper, Name: "Space organization"},
+		{ID: daltymodel.ProductGroupSpaceOrganizationStorage, ParentID: daltymodel.ProductGroupSpaceOrganization, Name: "Storage"},
+		{ID: daltymodel.ProductGroupBeds, Name: "Beds"},
+		{ID: daltymodel.ProductGroupBedAccessories, ParentID: daltymodel.ProductGroupBeds, Name: "Bed accessories"},
+	})
+	require.NoError(t, err)
+	return taxonomy
+}
+
+func TestTaxonomy(t *testing.T) {
+	taxonomy := testTaxonomy(t)
+
+	assert.Len(t, taxonomy.Roots(), 2)
+	assert.Equal(t, []daltymodel.ProductGroup{
+		daltymodel.ProductGroupSpaceOrganizationUpper,
+		daltymodel.ProductGroupSpaceOrganization,
+		daltymodel.ProductGroupSpaceOrganizationStorage,
+	}, taxonomy.Subtree(daltymodel.ProductGroupSpaceOrganizationUpper))
+	ancestors := taxonomy.Ancestors(daltymodel.ProductGroupSpaceOrganizationStorage)
+	require.Len(t, ancestors, 2)
+	assert.Equal(t, daltymodel.ProductGroupSpaceOrganization, ancestors[0].ID)
+	assert.True(t, taxonomy.Contains(daltymodel.ProductGroupSpaceOrganizationUpper, daltymodel.ProductGroupSpaceOrganizationStorage))
+	assert.False(t, taxonomy.Contains(daltymodel.ProductGroupBeds, daltymodel.ProductGroupSpaceOrganizationStorage))
+}
+
+func TestTaxonomyRejectsCycles(t *testing.T) {
+	_, err := NewTaxonomy([]*GroupNode{
+		{ID: daltymodel.ProductGroupBeds, ParentID: daltymodel.ProductGroupBedAccessories},
+		{ID: daltymodel.ProductGroupBedAccessories, ParentID: daltymodel.ProductGroupBeds},
+	})
+
+	assert.Error(t, err)
+}
+
+func TestTaxonomyRejectsUnknownParent(t *testing.T) {
+	_, err := NewTaxonomy([]*GroupNode{
+		{ID: daltymodel.ProductGroupBedAccessories, ParentID: daltymodel.ProductGroupBeds},
+	})
+
+	assert.Error(t, err)
+}
+
+func TestMatchPickupRule(t *testing.T) {
+	taxonomy := testTaxonomy(t)
+	top := &PickupRule{Group: daltymodel.ProductGroupSpaceOrganizationUpper, IncludeSubgroups: true,
+		Strategy: daltymodel.PickupStrategyNearest}
+	middle := &PickupRule{Group: daltymodel.ProductGroupSpaceOrganization, IncludeSubgroups: true,
+		Strategy: daltymodel.PickupStrategyFarthest}
+	exact := &PickupRule{Group: daltymodel.ProductGroupBeds, Strategy: daltymodel.PickupStrategyFarthest}
+	rules := []*PickupRule{top, middle, exact}
+
+	rule, ok := MatchPickupRule(rules, daltymodel.ProductGroupSpaceOrganizationStorage, taxonomy)
+	assert.True(t, ok)
+	assert.Same(t, middle, rule)
+
+	rule, ok = MatchPickupRule(rules, daltymodel.ProductGroupSpaceOrganizationUpper, taxonomy)
+	assert.True(t, ok)
+	assert.Same(t, top, rule)
+
+	_, ok = MatchPickupRule(rules, daltymodel.ProductGroupBedAccessories, taxonomy)
+	assert.False(t, ok)
+}
@@ -0,0 +1,104 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: I:\Goland\dalty\internal\product\core\change.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	core "github.com/DimKa163/dalty/internal/product/core"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockChangeRepository is a mock of ChangeRepository interface.
+type MockChangeRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockChangeRepositoryMockRecorder
+}
+
+// MockChangeRepositoryMockRecorder is the mock recorder for MockChangeRepository.
+type MockChangeRepositoryMockRecorder struct {
+	mock *MockChangeRepository
+}
+
+// NewMockChangeRepository creates a new mock instance.
+func NewMockChangeRepository(ctrl *gomock.Controller) *MockChangeRepository {
+	mock := &MockChangeRepository{ctrl: ctrl}
+	mock.recorder = &MockChangeRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChangeRepository) EXPECT() *MockChangeRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetChanges mocks base method.
+func (m *MockChangeRepository) GetChanges(ctx context.Context, after *core.ChangeCursor, limit int) ([]*core.ProductChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChanges", ctx, after, limit)
+	ret0, _ := ret[0].([]*core.ProductChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChanges indicates an expected call of GetChanges.
+func (mr *MockChangeRepositoryMockRecorder) GetChanges(ctx, after, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChanges", reflect.TypeOf((*MockChangeRepository)(nil).GetChanges), ctx, after, limit)
+}
+
+// LatestChange mocks base method.
+func (m *MockChangeRepository) LatestChange(ctx context.Context) (*core.ChangeCursor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LatestChange", ctx)
+	ret0, _ := ret[0].(*core.ChangeCursor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LatestChange indicates an expected call of LatestChange.
+func (mr *MockChangeRepositoryMockRecorder) LatestChange(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LatestChange", reflect.TypeOf((*MockChangeRepository)(nil).LatestChange), ctx)
+}
+
+// MockChangeNotifier is a mock of ChangeNotifier interface.
+type MockChangeNotifier struct {
+	ctrl     *gomock.Controller
+	recorder *MockChangeNotifierMockRecorder
+}
+
+// MockChangeNotifierMockRecorder is the mock recorder for MockChangeNotifier.
+type MockChangeNotifierMockRecorder struct {
+	mock *MockChangeNotifier
+}
+
+// NewMockChangeNotifier creates a new mock instance.
+func NewMockChangeNotifier(ctrl *gomock.Controller) *MockChangeNotifier {
+	mock := &MockChangeNotifier{ctrl: ctrl}
+	mock.recorder = &MockChangeNotifierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChangeNotifier) EXPECT() *MockChangeNotifierMockRecorder {
+	return m.recorder
+}
+
+// Subscribe mocks base method.
+func (m *MockChangeNotifier) Subscribe() (<-chan struct{}, func()) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe")
+	ret0, _ := ret[0].(<-chan struct{})
+	ret1, _ := ret[1].(func())
+	return ret0, ret1
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockChangeNotifierMockRecorder) Subscribe() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockChangeNotifier)(nil).Subscribe))
+}
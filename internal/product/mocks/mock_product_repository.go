@@ -50,6 +50,21 @@ func (mr *MockProductRepositoryMockRecorder) GetByFnrec(ctx, fnrec interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByFnrec", reflect.TypeOf((*MockProductRepository)(nil).GetByFnrec), ctx, fnrec)
 }
 
+// GetByFnrecs mocks base method.
+func (m *MockProductRepository) GetByFnrecs(ctx context.Context, fnrecs []string) (map[string]*core.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByFnrecs", ctx, fnrecs)
+	ret0, _ := ret[0].(map[string]*core.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByFnrecs indicates an expected call of GetByFnrecs.
+func (mr *MockProductRepositoryMockRecorder) GetByFnrecs(ctx, fnrecs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByFnrecs", reflect.TypeOf((*MockProductRepository)(nil).GetByFnrecs), ctx, fnrecs)
+}
+
 // GetByID mocks base method.
 func (m *MockProductRepository) GetByID(ctx context.Context, id string) (*core.Product, error) {
 	m.ctrl.T.Helper()
@@ -65,6 +80,21 @@ func (mr *MockProductRepositoryMockRecorder) GetByID(ctx, id interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockProductRepository)(nil).GetByID), ctx, id)
 }
 
+// GetByIDs mocks base method.
+func (m *MockProductRepository) GetByIDs(ctx context.Context, ids []string) (map[string]*core.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByIDs", ctx, ids)
+	ret0, _ := ret[0].(map[string]*core.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByIDs indicates an expected call of GetByIDs.
+func (mr *MockProductRepositoryMockRecorder) GetByIDs(ctx, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIDs", reflect.TypeOf((*MockProductRepository)(nil).GetByIDs), ctx, ids)
+}
+
 // GetByIntegrationID mocks base method.
 func (m *MockProductRepository) GetByIntegrationID(ctx context.Context, integrationID string) (*core.Product, error) {
 	m.ctrl.T.Helper()
@@ -79,3 +109,48 @@ func (mr *MockProductRepositoryMockRecorder) GetByIntegrationID(ctx, integration
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIntegrationID", reflect.TypeOf((*MockProductRepository)(nil).GetByIntegrationID), ctx, integrationID)
 }
+
+// GetByIntegrationIDs mocks base method.
+func (m *MockProductRepository) GetByIntegrationIDs(ctx context.Context, integrationIDs []string) (map[string]*core.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByIntegrationIDs", ctx, integrationIDs)
+	ret0, _ := ret[0].(map[string]*core.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByIntegrationIDs indicates an expected call of GetByIntegrationIDs.
+func (mr *MockProductRepositoryMockRecorder) GetByIntegrationIDs(ctx, integrationIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIntegrationIDs", reflect.TypeOf((*MockProductRepository)(nil).GetByIntegrationIDs), ctx, integrationIDs)
+}
+
+// GetBySeriesID mocks base method.
+func (m *MockProductRepository) GetBySeriesID(ctx context.Context, seriesID string) ([]*core.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBySeriesID", ctx, seriesID)
+	ret0, _ := ret[0].([]*core.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBySeriesID indicates an expected call of GetBySeriesID.
+func (mr *MockProductRepositoryMockRecorder) GetBySeriesID(ctx, seriesID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBySeriesID", reflect.TypeOf((*MockProductRepository)(nil).GetBySeriesID), ctx, seriesID)
+}
+
+// Search mocks base method.
+func (m *MockProductRepository) Search(ctx context.Context, query *core.SearchQuery) ([]*core.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, query)
+	ret0, _ := ret[0].([]*core.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockProductRepositoryMockRecorder) Search(ctx, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockProductRepository)(nil).Search), ctx, query)
+}
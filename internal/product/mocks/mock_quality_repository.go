@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: I:\Goland\dalty\internal\product\core\quality.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	core "github.com/DimKa163/dalty/internal/product/core"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockQualityRepository is a mock of QualityRepository interface.
+type MockQualityRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockQualityRepositoryMockRecorder
+}
+
+// MockQualityRepositoryMockRecorder is the mock recorder for MockQualityRepository.
+type MockQualityRepositoryMockRecorder struct {
+	mock *MockQualityRepository
+}
+
+// NewMockQualityRepository creates a new mock instance.
+func NewMockQualityRepository(ctrl *gomock.Controller) *MockQualityRepository {
+	mock := &MockQualityRepository{ctrl: ctrl}
+	mock.recorder = &MockQualityRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockQualityRepository) EXPECT() *MockQualityRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Walk mocks base method.
+func (m *MockQualityRepository) Walk(ctx context.Context, fn func(*core.ScannedProduct) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Walk", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Walk indicates an expected call of Walk.
+func (mr *MockQualityRepositoryMockRecorder) Walk(ctx, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Walk", reflect.TypeOf((*MockQualityRepository)(nil).Walk), ctx, fn)
+}
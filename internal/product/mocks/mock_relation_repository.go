@@ -66,6 +66,21 @@ func (mr *MockRelationRepositoryMockRecorder) GetByLeftID(ctx, id interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByLeftID", reflect.TypeOf((*MockRelationRepository)(nil).GetByLeftID), ctx, id)
 }
 
+// GetByLeftIDs mocks base method.
+func (m *MockRelationRepository) GetByLeftIDs(ctx context.Context, ids []guid.Guid) (map[guid.Guid][]*core.Relation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByLeftIDs", ctx, ids)
+	ret0, _ := ret[0].(map[guid.Guid][]*core.Relation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByLeftIDs indicates an expected call of GetByLeftIDs.
+func (mr *MockRelationRepositoryMockRecorder) GetByLeftIDs(ctx, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByLeftIDs", reflect.TypeOf((*MockRelationRepository)(nil).GetByLeftIDs), ctx, ids)
+}
+
 // GetByLeftIntegrationID mocks base method.
 func (m *MockRelationRepository) GetByLeftIntegrationID(ctx context.Context, integrationID string) ([]*core.Relation, error) {
 	m.ctrl.T.Helper()
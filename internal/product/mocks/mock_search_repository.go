@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: I:\Goland\dalty\internal\product\core\search.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	core "github.com/DimKa163/dalty/internal/product/core"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockNameSearchRepository is a mock of NameSearchRepository interface.
+type MockNameSearchRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockNameSearchRepositoryMockRecorder
+}
+
+// MockNameSearchRepositoryMockRecorder is the mock recorder for MockNameSearchRepository.
+type MockNameSearchRepositoryMockRecorder struct {
+	mock *MockNameSearchRepository
+}
+
+// NewMockNameSearchRepository creates a new mock instance.
+func NewMockNameSearchRepository(ctrl *gomock.Controller) *MockNameSearchRepository {
+	mock := &MockNameSearchRepository{ctrl: ctrl}
+	mock.recorder = &MockNameSearchRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNameSearchRepository) EXPECT() *MockNameSearchRepositoryMockRecorder {
+	return m.recorder
+}
+
+// SearchByName mocks base method.
+func (m *MockNameSearchRepository) SearchByName(ctx context.Context, query *core.NameSearchQuery) ([]*core.ScoredProduct, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchByName", ctx, query)
+	ret0, _ := ret[0].([]*core.ScoredProduct)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchByName indicates an expected call of SearchByName.
+func (mr *MockNameSearchRepositoryMockRecorder) SearchByName(ctx, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchByName", reflect.TypeOf((*MockNameSearchRepository)(nil).SearchByName), ctx, query)
+}
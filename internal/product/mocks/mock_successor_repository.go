@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: I:\Goland\dalty\internal\product\core\successor.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	core "github.com/DimKa163/dalty/internal/product/core"
+	guid "github.com/beevik/guid"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockSuccessorRepository is a mock of SuccessorRepository interface.
+type MockSuccessorRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSuccessorRepositoryMockRecorder
+}
+
+// MockSuccessorRepositoryMockRecorder is the mock recorder for MockSuccessorRepository.
+type MockSuccessorRepositoryMockRecorder struct {
+	mock *MockSuccessorRepository
+}
+
+// NewMockSuccessorRepository creates a new mock instance.
+func NewMockSuccessorRepository(ctrl *gomock.Controller) *MockSuccessorRepository {
+	mock := &MockSuccessorRepository{ctrl: ctrl}
+	mock.recorder = &MockSuccessorRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSuccessorRepository) EXPECT() *MockSuccessorRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetByProductIDs mocks base method.
+func (m *MockSuccessorRepository) GetByProductIDs(ctx context.Context, ids []guid.Guid) (map[guid.Guid][]*core.Successor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByProductIDs", ctx, ids)
+	ret0, _ := ret[0].(map[guid.Guid][]*core.Successor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByProductIDs indicates an expected call of GetByProductIDs.
+func (mr *MockSuccessorRepositoryMockRecorder) GetByProductIDs(ctx, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByProductIDs", reflect.TypeOf((*MockSuccessorRepository)(nil).GetByProductIDs), ctx, ids)
+}
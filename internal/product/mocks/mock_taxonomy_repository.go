@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: I:\Goland\dalty\internal\product\core\taxonomy.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	core "github.com/DimKa163/dalty/internal/product/core"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockTaxonomyRepository is a mock of TaxonomyRepository interface.
+type MockTaxonomyRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTaxonomyRepositoryMockRecorder
+}
+
+// MockTaxonomyRepositoryMockRecorder is the mock recorder for MockTaxonomyRepository.
+type MockTaxonomyRepositoryMockRecorder struct {
+	mock *MockTaxonomyRepository
+}
+
+// NewMockTaxonomyRepository creates a new mock instance.
+func NewMockTaxonomyRepository(ctrl *gomock.Controller) *MockTaxonomyRepository {
+	mock := &MockTaxonomyRepository{ctrl: ctrl}
+	mock.recorder = &MockTaxonomyRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTaxonomyRepository) EXPECT() *MockTaxonomyRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetGroups mocks base method.
+func (m *MockTaxonomyRepository) GetGroups(ctx context.Context) ([]*core.GroupNode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroups", ctx)
+	ret0, _ := ret[0].([]*core.GroupNode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGroups indicates an expected call of GetGroups.
+func (mr *MockTaxonomyRepositoryMockRecorder) GetGroups(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroups", reflect.TypeOf((*MockTaxonomyRepository)(nil).GetGroups), ctx)
+}
@@ -0,0 +1,82 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/DimKa163/dalty/internal/db"
+	"github.com/DimKa163/dalty/internal/product/core"
+	"github.com/beevik/guid"
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	GetChangesStmt = `SELECT 
+	id,
+    name,
+    type_id,
+    nrb_type_production_id,
+    smr_fnrec,
+    is_archive,
+    nrb_integration_id,
+    smr_is_service,
+    smr_product_group_flag_id,
+    category_id,
+    smr_series_id,
+    nrb_account_product_id,
+    ask_non_standart_category_id,
+    nrb_count_mv,
+    ask_pack_volume,
+    ask_pack_length,
+    ask_pack_width,
+    ask_pack_height,
+    ask_weight,
+    updated_at
+	FROM public.product
+    WHERE (updated_at, id) > ($1, $2::uuid)
+    ORDER BY updated_at, id
+    LIMIT $3`
+	LatestChangeStmt = `SELECT updated_at, id FROM public.product ORDER BY updated_at DESC, id DESC LIMIT 1`
+)
+
+type ChangeRepository struct {
+	db db.QueryExecutor
+}
+
+func NewChangeRepository(db db.QueryExecutor) *ChangeRepository {
+	return &ChangeRepository{db: db}
+}
+
+func (r *ChangeRepository) GetChanges(ctx context.Context, after *core.ChangeCursor, limit int) ([]*core.ProductChange, error) {
+	rows, err := r.db.Query(ctx, GetChangesStmt, after.UpdatedAt, after.ID.String(), limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	changes := make([]*core.ProductChange, 0)
+	for rows.Next() {
+		var change core.ProductChange
+		change.Product, err = mapProduct(&withColumns{Row: rows, extra: []any{&change.UpdatedAt}})
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, &change)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
+
+func (r *ChangeRepository) LatestChange(ctx context.Context) (*core.ChangeCursor, error) {
+	var updatedAt time.Time
+	var id guid.Guid
+	if err := r.db.QueryRow(ctx, LatestChangeStmt).Scan(&updatedAt, &id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &core.ChangeCursor{UpdatedAt: updatedAt, ID: id}, nil
+}
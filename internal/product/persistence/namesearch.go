@@ -0,0 +1,100 @@
+package persistence
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/DimKa163/dalty/internal/db"
+	"github.com/DimKa163/dalty/internal/product/core"
+)
+
+const (
+	// SearchByNameStmt needs the pg_trgm extension. The query text is always
+	// $1.
+	SearchByNameStmt = `SELECT 
+	id,
+    name,
+    type_id,
+    nrb_type_production_id,
+    smr_fnrec,
+    is_archive,
+    nrb_integration_id,
+    smr_is_service,
+    smr_product_group_flag_id,
+    category_id,
+    smr_series_id,
+    nrb_account_product_id,
+    ask_non_standart_category_id,
+    nrb_count_mv,
+    ask_pack_volume,
+    ask_pack_length,
+    ask_pack_width,
+    ask_pack_height,
+    ask_weight,
+    word_similarity($1, name) AS score
+	FROM public.product`
+	// SetWordSimilarityThresholdStmt sets the score the <% operator of
+	// pg_trgm matches from, for the current transaction only.
+	SetWordSimilarityThresholdStmt = `SELECT set_config('pg_trgm.word_similarity_threshold', $1, true)`
+)
+
+type NameSearchRepository struct {
+	db db.QueryExecutor
+}
+
+func NewNameSearchRepository(db db.QueryExecutor) *NameSearchRepository {
+	return &NameSearchRepository{db: db}
+}
+
+// SearchByName matches names with the <% operator, which can use a trigram
+// index on name, so the minimum score is set as the threshold of <% in a
+// transaction of its own.
+func (r *NameSearchRepository) SearchByName(ctx context.Context, query *core.NameSearchQuery) ([]*core.ScoredProduct, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+	threshold := strconv.FormatFloat(query.MinScore, 'f', -1, 64)
+	if _, err = tx.Exec(ctx, SetWordSimilarityThresholdStmt, threshold); err != nil {
+		return nil, err
+	}
+	stmt, args := buildNameSearch(query)
+	rows, err := tx.Query(ctx, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	products := make([]*core.ScoredProduct, 0, query.Limit)
+	for rows.Next() {
+		var scored core.ScoredProduct
+		scored.Product, err = mapProduct(&withColumns{Row: rows, extra: []any{&scored.Score}})
+		if err != nil {
+			return nil, err
+		}
+		products = append(products, &scored)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return products, nil
+}
+
+func buildNameSearch(query *core.NameSearchQuery) (string, []any) {
+	args := make([]any, 0)
+	arg := func(value any) string {
+		args = append(args, value)
+		return "$" + strconv.Itoa(len(args))
+	}
+	text := arg(query.Text)
+	conditions := []string{text + " <% name"}
+	conditions = append(conditions, filterConditions(query.Filter, arg)...)
+	var sb strings.Builder
+	sb.WriteString(SearchByNameStmt)
+	sb.WriteString("\n    WHERE ")
+	sb.WriteString(strings.Join(conditions, " AND "))
+	sb.WriteString("\n    ORDER BY score DESC, name, id LIMIT ")
+	sb.WriteString(arg(query.Limit))
+	return sb.String(), args
+}
@@ -6,6 +6,8 @@ import (
 	"errors"
 	"github.com/DimKa163/dalty/pkg/daltyerrors"
 	"github.com/DimKa163/dalty/pkg/daltymodel"
+	"strconv"
+	"strings"
 
 	"github.com/DimKa163/dalty/internal/db"
 	"github.com/DimKa163/dalty/internal/product/core"
@@ -80,6 +82,116 @@ const (
     ask_weight 
 	FROM public.product
     WHERE nrb_integration_id=$1`
+	GetByIDsStmt = `SELECT 
+	id,
+    name,
+    type_id,
+    nrb_type_production_id,
+    smr_fnrec,
+    is_archive,
+    nrb_integration_id,
+    smr_is_service,
+    smr_product_group_flag_id,
+    category_id,
+    smr_series_id,
+    nrb_account_product_id,
+    ask_non_standart_category_id,
+    nrb_count_mv,
+    ask_pack_volume,
+    ask_pack_length,
+    ask_pack_width,
+    ask_pack_height,
+    ask_weight 
+	FROM public.product
+    WHERE id = ANY($1::uuid[])`
+	GetByFnrecsStmt = `SELECT 
+	id,
+    name,
+    type_id,
+    nrb_type_production_id,
+    smr_fnrec,
+    is_archive,
+    nrb_integration_id,
+    smr_is_service,
+    smr_product_group_flag_id,
+    category_id,
+    smr_series_id,
+    nrb_account_product_id,
+    ask_non_standart_category_id,
+    nrb_count_mv,
+    ask_pack_volume,
+    ask_pack_length,
+    ask_pack_width,
+    ask_pack_height,
+    ask_weight 
+	FROM public.product
+    WHERE smr_fnrec = ANY($1)`
+	GetByIntegrationIDsStmt = `SELECT 
+	id,
+    name,
+    type_id,
+    nrb_type_production_id,
+    smr_fnrec,
+    is_archive,
+    nrb_integration_id,
+    smr_is_service,
+    smr_product_group_flag_id,
+    category_id,
+    smr_series_id,
+    nrb_account_product_id,
+    ask_non_standart_category_id,
+    nrb_count_mv,
+    ask_pack_volume,
+    ask_pack_length,
+    ask_pack_width,
+    ask_pack_height,
+    ask_weight 
+	FROM public.product
+    WHERE nrb_integration_id = ANY($1)`
+	SearchStmt = `SELECT 
+	id,
+    COALESCE(name, ''),
+    type_id,
+    nrb_type_production_id,
+    smr_fnrec,
+    is_archive,
+    nrb_integration_id,
+    smr_is_service,
+    smr_product_group_flag_id,
+    category_id,
+    smr_series_id,
+    nrb_account_product_id,
+    ask_non_standart_category_id,
+    nrb_count_mv,
+    ask_pack_volume,
+    ask_pack_length,
+    ask_pack_width,
+    ask_pack_height,
+    ask_weight 
+	FROM public.product`
+	GetBySeriesIDStmt = `SELECT 
+	id,
+    name,
+    type_id,
+    nrb_type_production_id,
+    smr_fnrec,
+    is_archive,
+    nrb_integration_id,
+    smr_is_service,
+    smr_product_group_flag_id,
+    category_id,
+    smr_series_id,
+    nrb_account_product_id,
+    ask_non_standart_category_id,
+    nrb_count_mv,
+    ask_pack_volume,
+    ask_pack_length,
+    ask_pack_width,
+    ask_pack_height,
+    ask_weight 
+	FROM public.product
+    WHERE smr_series_id=$1
+    ORDER BY name`
 )
 
 type ProductRepository struct {
@@ -126,6 +238,161 @@ func (r *ProductRepository) GetByIntegrationID(ctx context.Context, integrationI
 	return prd, nil
 }
 
+// GetByIDs returns the found products keyed by id. Missing ids are absent
+// from the map.
+func (r *ProductRepository) GetByIDs(ctx context.Context, ids []string) (map[string]*core.Product, error) {
+	return r.getMany(ctx, GetByIDsStmt, ids, func(p *core.Product) string {
+		return p.ID.String()
+	})
+}
+
+func (r *ProductRepository) GetByFnrecs(ctx context.Context, fnrecs []string) (map[string]*core.Product, error) {
+	return r.getMany(ctx, GetByFnrecsStmt, fnrecs, func(p *core.Product) string {
+		return p.Fnrec
+	})
+}
+
+func (r *ProductRepository) GetByIntegrationIDs(ctx context.Context, integrationIDs []string) (map[string]*core.Product, error) {
+	return r.getMany(ctx, GetByIntegrationIDsStmt, integrationIDs, func(p *core.Product) string {
+		return p.IntegrationID
+	})
+}
+
+func (r *ProductRepository) getMany(ctx context.Context, stmt string, values []string,
+	key func(p *core.Product) string) (map[string]*core.Product, error) {
+	result := make(map[string]*core.Product, len(values))
+	if len(values) == 0 {
+		return result, nil
+	}
+	rows, err := r.db.Query(ctx, stmt, values)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		prd, err := mapProduct(rows)
+		if err != nil {
+			return nil, err
+		}
+		result[key(prd)] = prd
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (r *ProductRepository) GetBySeriesID(ctx context.Context, seriesID string) ([]*core.Product, error) {
+	rows, err := r.db.Query(ctx, GetBySeriesIDStmt, seriesID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	products := make([]*core.Product, 0)
+	for rows.Next() {
+		prd, err := mapProduct(rows)
+		if err != nil {
+			return nil, err
+		}
+		products = append(products, prd)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return products, nil
+}
+
+// Search pages through products ordered by name and id. The cursor is applied
+// as a keyset condition, so pages stay stable while products are added. A
+// missing name sorts and pages as an empty one.
+func (r *ProductRepository) Search(ctx context.Context, query *core.SearchQuery) ([]*core.Product, error) {
+	stmt, args := buildSearch(query)
+	rows, err := r.db.Query(ctx, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	products := make([]*core.Product, 0, query.Limit)
+	for rows.Next() {
+		prd, err := mapProduct(rows)
+		if err != nil {
+			return nil, err
+		}
+		products = append(products, prd)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return products, nil
+}
+
+func buildSearch(query *core.SearchQuery) (string, []any) {
+	conditions := make([]string, 0)
+	args := make([]any, 0)
+	arg := func(value any) string {
+		args = append(args, value)
+		return "$" + strconv.Itoa(len(args))
+	}
+	conditions = append(conditions, filterConditions(query.Filter, arg)...)
+	if query.After != nil {
+		conditions = append(conditions, "(COALESCE(name, ''), id) > ("+arg(query.After.Name)+", "+arg(query.After.ID.String())+"::uuid)")
+	}
+	var sb strings.Builder
+	sb.WriteString(SearchStmt)
+	if len(conditions) > 0 {
+		sb.WriteString("\n    WHERE ")
+		sb.WriteString(strings.Join(conditions, " AND "))
+	}
+	sb.WriteString("\n    ORDER BY COALESCE(name, ''), id LIMIT ")
+	sb.WriteString(arg(query.Limit))
+	return sb.String(), args
+}
+
+// filterConditions turns f into SQL conditions, arg binds a value and returns
+// its placeholder.
+func filterConditions(f *core.SearchFilter, arg func(any) string) []string {
+	conditions := make([]string, 0)
+	if f == nil {
+		return conditions
+	}
+	if len(f.Groups) > 0 {
+		groups := make([]string, len(f.Groups))
+		for i, g := range f.Groups {
+			groups[i] = string(g)
+		}
+		conditions = append(conditions, "smr_product_group_flag_id = ANY("+arg(groups)+")")
+	} else if f.Group != "" {
+		conditions = append(conditions, "smr_product_group_flag_id="+arg(string(f.Group)))
+	}
+	if f.SeriesID != "" {
+		conditions = append(conditions, "smr_series_id="+arg(f.SeriesID))
+	}
+	if f.CategoryID != "" {
+		conditions = append(conditions, "category_id="+arg(f.CategoryID))
+	}
+	if f.ProductionType != "" {
+		conditions = append(conditions, "nrb_type_production_id="+arg(string(f.ProductionType)))
+	}
+	if f.IsService != nil {
+		conditions = append(conditions, "smr_is_service="+arg(*f.IsService))
+	}
+	if f.IsArchive != nil {
+		conditions = append(conditions, "is_archive="+arg(*f.IsArchive))
+	}
+	if f.Name != "" {
+		pattern := escapeLike(f.Name) + "%"
+		if !f.NamePrefix {
+			pattern = "%" + pattern
+		}
+		conditions = append(conditions, "name ILIKE "+arg(pattern))
+	}
+	return conditions
+}
+
+func escapeLike(value string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(value)
+}
+
 func mapProduct(row pgx.Row) (*core.Product, error) {
 	var product core.Product
 	var productID guid.Guid
@@ -199,3 +466,25 @@ func mapProduct(row pgx.Row) (*core.Product, error) {
 	}
 	return &product, nil
 }
+
+// withColumns scans the columns mapProduct knows about into its destinations
+// and the trailing ones into extra.
+type withColumns struct {
+	pgx.Row
+	extra []any
+}
+
+func (r *withColumns) Scan(dest ...any) error {
+	return r.Row.Scan(append(dest, r.extra...)...)
+}
+
+// withLeadingColumns scans the leading columns into leading and the rest
+// into the destinations mapProduct knows about.
+type withLeadingColumns struct {
+	pgx.Row
+	leading []any
+}
+
+func (r *withLeadingColumns) Scan(dest ...any) error {
+	return r.Row.Scan(append(r.leading, dest...)...)
+}
@@ -0,0 +1,63 @@
+package persistence
+
+import (
+	"context"
+
+	"github.com/DimKa163/dalty/internal/db"
+	"github.com/DimKa163/dalty/internal/product/core"
+)
+
+const WalkProductsStmt = `SELECT
+	p.id,
+    p.name,
+    p.type_id,
+    p.nrb_type_production_id,
+    p.smr_fnrec,
+    p.is_archive,
+    p.nrb_integration_id,
+    p.smr_is_service,
+    p.smr_product_group_flag_id,
+    p.category_id,
+    p.smr_series_id,
+    p.nrb_account_product_id,
+    p.ask_non_standart_category_id,
+    p.nrb_count_mv,
+    p.ask_pack_volume,
+    p.ask_pack_length,
+    p.ask_pack_width,
+    p.ask_pack_height,
+    p.ask_weight,
+    COALESCE(r.relations, 0)
+	FROM public.product p
+	LEFT JOIN (SELECT nrb_product_sku_id, count(*)::int AS relations
+		FROM public.nrb_related_product
+		GROUP BY nrb_product_sku_id) r on r.nrb_product_sku_id = p.id
+	WHERE p.is_archive = false
+	ORDER BY p.id`
+
+type QualityRepository struct {
+	db db.QueryExecutor
+}
+
+func NewQualityRepository(db db.QueryExecutor) *QualityRepository {
+	return &QualityRepository{db: db}
+}
+
+func (r *QualityRepository) Walk(ctx context.Context, fn func(*core.ScannedProduct) error) error {
+	rows, err := r.db.Query(ctx, WalkProductsStmt)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var scanned core.ScannedProduct
+		scanned.Product, err = mapProduct(&withColumns{Row: rows, extra: []any{&scanned.Relations}})
+		if err != nil {
+			return err
+		}
+		if err = fn(&scanned); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
@@ -40,6 +40,33 @@ const (
 	JOIN public.product p on nrb_related_product.nrb_product_sku_id = p.id
 	JOIN public.product p1 on nrb_related_product.nrb_product_mv_id = p1.id
 	WHERE p.id=$1`
+	GetByLeftIDsStmt = `SELECT
+		nrb_related_product.id,
+		nrb_product_sku_id,
+		nrb_amount_mv,
+		p1.id,
+    	p1.name,
+    	p1.type_id,
+    	p1.nrb_type_production_id,
+    	p1.smr_fnrec,
+    	p1.is_archive,
+    	p1.nrb_integration_id,
+    	p1.smr_is_service,
+    	p1.smr_product_group_flag_id,
+    	p1.category_id,
+    	p1.smr_series_id,
+    	p1.nrb_account_product_id,
+    	p1.ask_non_standart_category_id,
+    	p1.nrb_count_mv,
+    	p1.ask_pack_volume,
+    	p1.ask_pack_length,
+    	p1.ask_pack_width,
+    	p1.ask_pack_height,
+    	p1.ask_weight
+	FROM public.nrb_related_product
+	JOIN public.product p on nrb_related_product.nrb_product_sku_id = p.id
+	JOIN public.product p1 on nrb_related_product.nrb_product_mv_id = p1.id
+	WHERE p.id = ANY($1::uuid[])`
 	GetByLeftFnrecStmt = `SELECT
 		nrb_related_product.id,
 		nrb_product_sku_id,
@@ -145,6 +172,33 @@ func (r *RelationRepository) GetByLeftID(ctx context.Context, id guid.Guid) ([]*
 	defer rows.Close()
 	return readDirectRelation(rows)
 }
+
+// GetByLeftIDs returns the relations of several products at once, keyed by
+// the left product id. Products without relations are absent from the map.
+func (r *RelationRepository) GetByLeftIDs(ctx context.Context, ids []guid.Guid) (map[guid.Guid][]*core.Relation, error) {
+	result := make(map[guid.Guid][]*core.Relation, len(ids))
+	if len(ids) == 0 {
+		return result, nil
+	}
+	values := make([]string, len(ids))
+	for i, id := range ids {
+		values[i] = id.String()
+	}
+	rows, err := r.db.Query(ctx, GetByLeftIDsStmt, values)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	relations, err := readDirectRelation(rows)
+	if err != nil {
+		return nil, err
+	}
+	for _, rel := range relations {
+		result[rel.LeftID] = append(result[rel.LeftID], rel)
+	}
+	return result, nil
+}
+
 func (r *RelationRepository) GetByLeftFnrec(ctx context.Context, fnrec string) ([]*core.Relation, error) {
 	rows, err := r.db.Query(ctx, GetByLeftFnrecStmt, fnrec)
 	if err != nil {
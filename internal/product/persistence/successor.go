@@ -0,0 +1,73 @@
+package persistence
+
+import (
+	"context"
+
+	"github.com/DimKa163/dalty/internal/db"
+	"github.com/DimKa163/dalty/internal/product/core"
+	"github.com/beevik/guid"
+)
+
+const GetSuccessorsStmt = `SELECT
+	ps.product_id,
+	ps.rank,
+	p.id,
+    p.name,
+    p.type_id,
+    p.nrb_type_production_id,
+    p.smr_fnrec,
+    p.is_archive,
+    p.nrb_integration_id,
+    p.smr_is_service,
+    p.smr_product_group_flag_id,
+    p.category_id,
+    p.smr_series_id,
+    p.nrb_account_product_id,
+    p.ask_non_standart_category_id,
+    p.nrb_count_mv,
+    p.ask_pack_volume,
+    p.ask_pack_length,
+    p.ask_pack_width,
+    p.ask_pack_height,
+    p.ask_weight
+	FROM public.product_successor ps
+	JOIN public.product p on ps.successor_id = p.id
+	WHERE ps.product_id = ANY($1::uuid[])
+	ORDER BY ps.product_id, ps.rank`
+
+type SuccessorRepository struct {
+	db db.QueryExecutor
+}
+
+func NewSuccessorRepository(db db.QueryExecutor) *SuccessorRepository {
+	return &SuccessorRepository{db: db}
+}
+
+func (r *SuccessorRepository) GetByProductIDs(ctx context.Context, ids []guid.Guid) (map[guid.Guid][]*core.Successor, error) {
+	result := make(map[guid.Guid][]*core.Successor, len(ids))
+	if len(ids) == 0 {
+		return result, nil
+	}
+	values := make([]string, len(ids))
+	for i, id := range ids {
+		values[i] = id.String()
+	}
+	rows, err := r.db.Query(ctx, GetSuccessorsStmt, values)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var successor core.Successor
+		successor.Successor, err = mapProduct(&withLeadingColumns{Row: rows, leading: []any{&successor.ProductID, &successor.Rank}})
+		if err != nil {
+			return nil, err
+		}
+		successor.SuccessorID = successor.Successor.ID
+		result[successor.ProductID] = append(result[successor.ProductID], &successor)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
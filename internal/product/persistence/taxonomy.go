@@ -0,0 +1,51 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/DimKa163/dalty/internal/db"
+	"github.com/DimKa163/dalty/internal/product/core"
+	"github.com/DimKa163/dalty/pkg/daltymodel"
+)
+
+const GetGroupsStmt = `SELECT
+	id,
+	parent_id,
+	name
+	FROM public.smr_product_group_flag
+	ORDER BY name`
+
+type TaxonomyRepository struct {
+	db db.QueryExecutor
+}
+
+func NewTaxonomyRepository(db db.QueryExecutor) *TaxonomyRepository {
+	return &TaxonomyRepository{db: db}
+}
+
+func (r *TaxonomyRepository) GetGroups(ctx context.Context) ([]*core.GroupNode, error) {
+	rows, err := r.db.Query(ctx, GetGroupsStmt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	groups := make([]*core.GroupNode, 0)
+	for rows.Next() {
+		var id string
+		var parentID sql.NullString
+		var group core.GroupNode
+		if err = rows.Scan(&id, &parentID, &group.Name); err != nil {
+			return nil, err
+		}
+		group.ID = daltymodel.ProductGroup(id)
+		if parentID.Valid {
+			group.ParentID = daltymodel.ProductGroup(parentID.String)
+		}
+		groups = append(groups, &group)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return groups, nil
+}
@@ -0,0 +1,73 @@
+package server
+
+import (
+	"context"
+	"sort"
+
+	"github.com/DimKa163/dalty/api/proto"
+	"github.com/DimKa163/dalty/internal/product/cache"
+	"github.com/beevik/guid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Cache is a repository cache the admin can inspect and invalidate.
+type Cache interface {
+	cache.Invalidator
+	Stats() cache.Stats
+}
+
+type CacheServer struct {
+	caches map[string]Cache
+	proto.UnimplementedCacheServiceServer
+}
+
+func NewCacheServer(caches map[string]Cache) *CacheServer {
+	return &CacheServer{
+		caches: caches,
+	}
+}
+
+func (cs *CacheServer) Bind(server *grpc.Server) {
+	proto.RegisterCacheServiceServer(server, cs)
+}
+
+func (cs *CacheServer) InvalidateCache(_ context.Context, in *proto.InvalidateCacheRequest) (*proto.InvalidateCacheResponse, error) {
+	id := in.GetProductId()
+	if id != "" {
+		if _, err := guid.ParseString(id); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	for _, c := range cs.caches {
+		if id == "" {
+			c.Purge()
+		} else {
+			c.Invalidate(id)
+		}
+	}
+	return &proto.InvalidateCacheResponse{}, nil
+}
+
+func (cs *CacheServer) GetCacheStats(_ context.Context, _ *proto.CacheStatsRequest) (*proto.CacheStatsResponse, error) {
+	var response proto.CacheStatsResponse
+	names := make([]string, 0, len(cs.caches))
+	for name := range cs.caches {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	items := make([]*proto.CacheStats, len(names))
+	for i, name := range names {
+		stats := cs.caches[name].Stats()
+		var item proto.CacheStats
+		item.SetName(name)
+		item.SetHits(stats.Hits)
+		item.SetMisses(stats.Misses)
+		item.SetHitRatio(stats.HitRatio())
+		item.SetSize(int32(stats.Size))
+		items[i] = &item
+	}
+	response.SetCaches(items)
+	return &response, nil
+}
@@ -0,0 +1,54 @@
+package server
+
+import (
+	"fmt"
+
+	"github.com/DimKa163/dalty/api/proto"
+	"github.com/DimKa163/dalty/pkg/daltymodel"
+)
+
+// GroupMapping translates product groups between the catalog ids and the
+// proto enum.
+type GroupMapping struct {
+	toProto   map[daltymodel.ProductGroup]proto.ProductGroup
+	fromProto map[proto.ProductGroup]daltymodel.ProductGroup
+}
+
+// NewGroupMapping checks the registry against the proto enum. Every enum
+// value but UNSPECIFIED must have exactly one group.
+func NewGroupMapping(registry *daltymodel.GroupRegistry) (*GroupMapping, error) {
+	mapping := &GroupMapping{
+		toProto:   make(map[daltymodel.ProductGroup]proto.ProductGroup),
+		fromProto: make(map[proto.ProductGroup]daltymodel.ProductGroup),
+	}
+	for _, g := range registry.Groups() {
+		value, ok := proto.ProductGroup_value[g.Enum]
+		if !ok || value == int32(proto.ProductGroup_PRODUCT_GROUP_UNSPECIFIED) {
+			return nil, fmt.Errorf("product group %s: unknown enum %s", g.ID, g.Enum)
+		}
+		mapping.toProto[g.ID] = proto.ProductGroup(value)
+		mapping.fromProto[proto.ProductGroup(value)] = g.ID
+	}
+	for value, name := range proto.ProductGroup_name {
+		if value == int32(proto.ProductGroup_PRODUCT_GROUP_UNSPECIFIED) {
+			continue
+		}
+		if _, ok := mapping.fromProto[proto.ProductGroup(value)]; !ok {
+			return nil, fmt.Errorf("product group enum %s has no group", name)
+		}
+	}
+	return mapping, nil
+}
+
+func (m *GroupMapping) toProtoProductGroup(pg daltymodel.ProductGroup) proto.ProductGroup {
+	if value, ok := m.toProto[pg]; ok {
+		return value
+	}
+	return proto.ProductGroup_PRODUCT_GROUP_UNSPECIFIED
+}
+
+// fromProtoProductGroup returns an empty group for UNSPECIFIED and unknown
+// values.
+func (m *GroupMapping) fromProtoProductGroup(pg proto.ProductGroup) daltymodel.ProductGroup {
+	return m.fromProto[pg]
+}
@@ -0,0 +1,52 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/DimKa163/dalty/api/proto"
+	"github.com/DimKa163/dalty/pkg/daltymodel"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProductGroupRoundTrip(t *testing.T) {
+	registry, err := daltymodel.DefaultGroupRegistry()
+	require.NoError(t, err)
+	groups, err := NewGroupMapping(registry)
+	require.NoError(t, err)
+
+	for value, name := range proto.ProductGroup_name {
+		pg := proto.ProductGroup(value)
+		if pg == proto.ProductGroup_PRODUCT_GROUP_UNSPECIFIED {
+			continue
+		}
+		group := groups.fromProtoProductGroup(pg)
+		assert.NotEmpty(t, group, name)
+		assert.Equal(t, pg, groups.toProtoProductGroup(group), name)
+	}
+	for _, g := range registry.Groups() {
+		assert.Equal(t, g.ID, groups.fromProtoProductGroup(groups.toProtoProductGroup(g.ID)), g.Enum)
+	}
+	assert.Equal(t, proto.ProductGroup_PRODUCT_GROUP_SPACE_ORGANIZATION_UPPER,
+		groups.toProtoProductGroup(daltymodel.ProductGroupSpaceOrganizationUpper))
+	assert.Equal(t, proto.ProductGroup_PRODUCT_GROUP_UNSPECIFIED, groups.toProtoProductGroup(daltymodel.ProductGroupUnknown))
+}
+
+func TestProductGroupRegistryRejectsGaps(t *testing.T) {
+	registry, err := daltymodel.NewGroupRegistry([]*daltymodel.GroupInfo{
+		{ID: daltymodel.ProductGroupKitchens, Enum: "PRODUCT_GROUP_KITCHENS", Name: "Kitchens"},
+	})
+	require.NoError(t, err)
+
+	_, err = NewGroupMapping(registry)
+	assert.Error(t, err)
+}
+
+func TestProductGroupRegistryRejectsDuplicates(t *testing.T) {
+	_, err := daltymodel.NewGroupRegistry([]*daltymodel.GroupInfo{
+		{ID: daltymodel.ProductGroupKitchens, Enum: "PRODUCT_GROUP_KITCHENS", Name: "Kitchens"},
+		{ID: daltymodel.ProductGroupHomeCare, Enum: "PRODUCT_GROUP_KITCHENS", Name: "Home care"},
+	})
+
+	assert.Error(t, err)
+}
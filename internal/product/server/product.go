@@ -9,19 +9,25 @@ import (
 	"github.com/DimKa163/dalty/pkg/daltyerrors"
 	"github.com/DimKa163/dalty/pkg/daltyerrors/protoerr"
 	"github.com/DimKa163/dalty/pkg/daltymodel"
+	"github.com/beevik/guid"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type ProductServer struct {
-	app *usecase.ProductService
+	app    *usecase.ProductService
+	watch  *usecase.WatchService
+	groups *GroupMapping
 	proto.ProductServiceServer
 }
 
-func NewProductServer(app *usecase.ProductService) *ProductServer {
+func NewProductServer(app *usecase.ProductService, watch *usecase.WatchService, groups *GroupMapping) *ProductServer {
 	return &ProductServer{
-		app: app,
+		app:    app,
+		watch:  watch,
+		groups: groups,
 	}
 }
 
@@ -40,7 +46,11 @@ func (ps *ProductServer) BatchRequest(ctx context.Context, in *proto.BatchProduc
 		}
 		batch[i] = r
 	}
-	appResponse, err := ps.app.BatchRequest(ctx, batch)
+	mode := fromProtoDuplicateMode(in.GetDuplicates())
+	if in.GetPartial() {
+		return ps.batchRequestPartial(ctx, batch, mode)
+	}
+	appResponse, err := ps.app.BatchRequest(ctx, batch, mode)
 	if err != nil {
 		var daltyErr *daltyerrors.DaltyError
 		if errors.As(err, &daltyErr) {
@@ -50,41 +60,231 @@ func (ps *ProductServer) BatchRequest(ctx context.Context, in *proto.BatchProduc
 	}
 	batchResponse := make([]*proto.Product, len(appResponse))
 	for i, product := range appResponse {
-		batchResponse[i] = toProtoProduct(product)
+		batchResponse[i] = ps.groups.toProtoProduct(product)
 	}
 	response.SetProducts(batchResponse)
 	return &response, nil
 }
 
-func toProductRequest(in *proto.ProductRequest) (*usecase.ProductRequest, error) {
-	if !in.HasFnrec() && !in.HasIntegrationId() {
-		return nil, protoerr.InvalidArgument("request does not have any identifier",
+func (ps *ProductServer) batchRequestPartial(ctx context.Context, batch []*usecase.ProductRequest,
+	mode usecase.DuplicateMode) (*proto.BatchResponse, error) {
+	var response proto.BatchResponse
+	appResponse, err := ps.app.BatchRequestPartial(ctx, batch, mode)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	items := make([]*proto.BatchItem, len(appResponse))
+	for i, it := range appResponse {
+		var item proto.BatchItem
+		if it.Err != nil {
+			item.SetError(protoerr.Detail(it.Err))
+		} else {
+			item.SetProduct(ps.groups.toProtoProduct(it.Product))
+		}
+		items[i] = &item
+	}
+	response.SetItems(items)
+	return &response, nil
+}
+
+func (ps *ProductServer) Get(ctx context.Context, in *proto.ProductRequest) (*proto.Product, error) {
+	req, err := toProductRequest(in)
+	if err != nil {
+		return nil, err
+	}
+	product, err := ps.app.Find(ctx, req)
+	if err != nil {
+		var daltyErr *daltyerrors.DaltyError
+		if errors.As(err, &daltyErr) {
+			return nil, protoerr.Handle(daltyErr)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return ps.groups.toProtoProduct(product), nil
+}
+
+func (ps *ProductServer) GetReplacements(ctx context.Context, in *proto.ProductRequest) (*proto.ReplacementsResponse, error) {
+	req, err := toProductRequest(in)
+	if err != nil {
+		return nil, err
+	}
+	product, replacements, err := ps.app.Replacements(ctx, req)
+	if err != nil {
+		var daltyErr *daltyerrors.DaltyError
+		if errors.As(err, &daltyErr) {
+			return nil, protoerr.Handle(daltyErr)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	response := &proto.ReplacementsResponse{}
+	response.SetProduct(ps.groups.toProtoProduct(product))
+	protoReplacements := make([]*proto.Product, len(replacements))
+	for i, replacement := range replacements {
+		protoReplacements[i] = ps.groups.toProtoProduct(replacement)
+	}
+	response.SetReplacements(protoReplacements)
+	return response, nil
+}
+
+func (ps *ProductServer) SearchProducts(ctx context.Context, in *proto.SearchProductsRequest) (*proto.SearchProductsResponse, error) {
+	filter := ps.groups.toSearchFilter(in)
+	page, err := ps.app.Search(ctx, filter, in.GetCursor(), int(in.GetPageSize()))
+	if err != nil {
+		if errors.Is(err, usecase.ErrInvalidCursor) {
+			return nil, protoerr.InvalidArgument("cursor is not valid",
+				&protoerr.ValidationError{
+					Message: err.Error(),
+					Members: []string{"cursor"},
+				})
+		}
+		return nil, handleTaxonomyError(err)
+	}
+	products := make([]*proto.Product, len(page.Products))
+	for i, product := range page.Products {
+		products[i] = ps.groups.toProtoProduct(product)
+	}
+	var response proto.SearchProductsResponse
+	response.SetProducts(products)
+	response.SetNextCursor(page.NextCursor)
+	return &response, nil
+}
+
+func (ps *ProductServer) WatchProducts(in *proto.WatchProductsRequest, stream grpc.ServerStreamingServer[proto.ProductDelta]) error {
+	err := ps.watch.Watch(stream.Context(), in.GetCursor(), func(delta *usecase.ProductDelta) error {
+		return stream.Send(ps.groups.toProtoProductDelta(delta))
+	})
+	if err == nil || errors.Is(err, context.Canceled) {
+		return nil
+	}
+	if errors.Is(err, usecase.ErrInvalidCursor) {
+		return protoerr.InvalidArgument("cursor is not valid",
 			&protoerr.ValidationError{
-				Message: "one of the following fields must be set",
-				Members: []string{"fnrec", "integration_id"},
+				Message: err.Error(),
+				Members: []string{"cursor"},
 			})
 	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	return status.Error(codes.Internal, err.Error())
+}
+
+func (ps *ProductServer) SearchProductsByName(ctx context.Context, in *proto.SearchProductsByNameRequest) (*proto.SearchProductsByNameResponse, error) {
+	var filter *core.SearchFilter
+	if in.HasFilter() {
+		filter = ps.groups.toSearchFilter(in.GetFilter())
+	}
+	found, err := ps.app.SearchByName(ctx, in.GetQuery(), filter, in.GetMinScore(), int(in.GetLimit()))
+	if err != nil {
+		if errors.Is(err, usecase.ErrEmptyNameQuery) {
+			return nil, protoerr.InvalidArgument("query is empty",
+				&protoerr.ValidationError{
+					Message: err.Error(),
+					Members: []string{"query"},
+				})
+		}
+		return nil, handleTaxonomyError(err)
+	}
+	products := make([]*proto.ScoredProduct, len(found))
+	for i, product := range found {
+		var scored proto.ScoredProduct
+		scored.SetProduct(ps.groups.toProtoProduct(product.Product))
+		scored.SetScore(product.Score)
+		products[i] = &scored
+	}
+	var response proto.SearchProductsByNameResponse
+	response.SetProducts(products)
+	return &response, nil
+}
+
+// searchFilterRequest is implemented by SearchProductsRequest and
+// ProductFilter.
+type searchFilterRequest interface {
+	GetGroup() proto.ProductGroup
+	GetIncludeSubgroups() bool
+	GetSeriesId() string
+	GetCategoryId() string
+	GetProductionType() proto.ProductionType
+	GetName() string
+	GetNamePrefix() bool
+	HasIsService() bool
+	GetIsService() bool
+	HasIsArchive() bool
+	GetIsArchive() bool
+}
+
+func (m *GroupMapping) toSearchFilter(in searchFilterRequest) *core.SearchFilter {
+	filter := core.SearchFilter{
+		Group:            m.fromProtoProductGroup(in.GetGroup()),
+		IncludeSubgroups: in.GetIncludeSubgroups(),
+		SeriesID:         in.GetSeriesId(),
+		CategoryID:       in.GetCategoryId(),
+		ProductionType:   fromProtoProductionType(in.GetProductionType()),
+		Name:             in.GetName(),
+		NamePrefix:       in.GetNamePrefix(),
+	}
+	if in.HasIsService() {
+		isService := in.GetIsService()
+		filter.IsService = &isService
+	}
+	if in.HasIsArchive() {
+		isArchive := in.GetIsArchive()
+		filter.IsArchive = &isArchive
+	}
+	return &filter
+}
+
+func (m *GroupMapping) toProtoProductDelta(in *usecase.ProductDelta) *proto.ProductDelta {
+	var out proto.ProductDelta
+	out.SetProduct(m.toProtoProduct(in.Product))
+	out.SetChangedFields(in.Fields)
+	out.SetUpdatedAt(timestamppb.New(in.UpdatedAt))
+	out.SetCursor(in.Cursor)
+	return &out
+}
+
+func toProductRequest(in *proto.ProductRequest) (*usecase.ProductRequest, error) {
 	var req usecase.ProductRequest
-	if in.HasIntegrationId() {
+	switch in.WhichIdentifier() {
+	case proto.ProductRequest_Id_case:
+		if _, err := guid.ParseString(in.GetId()); err != nil {
+			return nil, protoerr.InvalidArgument("id is not a valid guid",
+				&protoerr.ValidationError{
+					Message: err.Error(),
+					Members: []string{"id"},
+				})
+		}
+		req.ID = in.GetId()
+	case proto.ProductRequest_IntegrationId_case:
 		req.IntegrationID = in.GetIntegrationId()
-		return &req, nil
+	case proto.ProductRequest_Fnrec_case:
+		req.Fnrec = in.GetFnrec()
+	default:
+		return nil, protoerr.InvalidArgument("request does not have any identifier",
+			&protoerr.ValidationError{
+				Message: "one of the following fields must be set",
+				Members: []string{"fnrec", "integration_id", "id"},
+			})
 	}
-	req.Fnrec = in.GetFnrec()
 	return &req, nil
 }
 
-func toProtoProduct(in *core.Product) *proto.Product {
+func (m *GroupMapping) toProtoProduct(in *core.Product) *proto.Product {
 	var out proto.Product
 	out.SetId(in.ID.String())
 	out.SetName(in.Name)
 	out.SetProductionType(toProtoProductionType(in.ProductionType))
 	out.SetFnrec(in.Fnrec)
 	out.SetIsService(in.IsService)
-	out.SetGroup(toProtoProductGroup(in.Group))
+	out.SetGroup(m.toProtoProductGroup(in.Group))
 	out.SetSeriesId(in.SeriesID)
 	out.SetCategoryId(in.CategoryID)
 	out.SetAccountProvider(in.AccountProviderId)
 	out.SetNonStandardCategoryId(in.NonStandardCategory)
+	out.SetType(toProtoProductType(in.Type))
+	out.SetIsArchive(in.IsArchive)
+	out.SetIntegrationId(in.IntegrationID)
+	out.SetCountMa(in.CountMa)
 	var pack proto.Pack
 	pack.SetLength(in.Length)
 	pack.SetHeight(in.Height)
@@ -95,6 +295,17 @@ func toProtoProduct(in *core.Product) *proto.Product {
 	return &out
 }
 
+func toProtoProductType(in daltymodel.ProductType) proto.ProductType {
+	switch in {
+	case daltymodel.ProductTypeSKU:
+		return proto.ProductType_PRODUCT_TYPE_SKU
+	case daltymodel.ProductTypeMaterialAsset:
+		return proto.ProductType_PRODUCT_TYPE_MATERIAL_ASSET
+	default:
+		return proto.ProductType_PRODUCT_TYPE_UNKNOWN
+	}
+}
+
 func toProtoProductionType(in daltymodel.ProductionType) proto.ProductionType {
 	switch in {
 	case core.ProductionTypeProducing:
@@ -105,135 +316,25 @@ func toProtoProductionType(in daltymodel.ProductionType) proto.ProductionType {
 		return proto.ProductionType_PRODUCTION_TYPE_UNKNOWN
 	}
 }
-func toProtoProductGroup(pg daltymodel.ProductGroup) proto.ProductGroup {
-	switch pg {
-	case daltymodel.ProductGroupKitchens:
-		return proto.ProductGroup_PRODUCT_GROUP_KITCHENS
-	case daltymodel.ProductGroupCaseFurniture:
-		return proto.ProductGroup_PRODUCT_GROUP_CASE_FURNITURE
-	case daltymodel.ProductGroupBeddingSets:
-		return proto.ProductGroup_PRODUCT_GROUP_BEDDING_SETS
-	case daltymodel.ProductGroupSofas:
-		return proto.ProductGroup_PRODUCT_GROUP_SOFAS
-	case daltymodel.ProductGroupCovers:
-		return proto.ProductGroup_PRODUCT_GROUP_COVERS
-	case daltymodel.ProductGroupBlankets:
-		return proto.ProductGroup_PRODUCT_GROUP_BLANKETS
-	case daltymodel.ProductGroupBedBasesWithStorage:
-		return proto.ProductGroup_PRODUCT_GROUP_BED_BASES_WITH_STORAGE
-	case daltymodel.ProductGroupSofaComponents:
-		return proto.ProductGroup_PRODUCT_GROUP_SOFA_COMPONENTS
-	case daltymodel.ProductGroupErgomotion:
-		return proto.ProductGroup_PRODUCT_GROUP_ERGOMOTION
-	case daltymodel.ProductGroupNonProducts:
-		return proto.ProductGroup_PRODUCT_GROUP_NON_PRODUCTS
-	case daltymodel.ProductGroupSmallFurniture:
-		return proto.ProductGroup_PRODUCT_GROUP_SMALL_FURNITURE
-	case daltymodel.ProductGroupMattresses:
-		return proto.ProductGroup_PRODUCT_GROUP_MATTRESSES
-	case daltymodel.ProductGroupSlattedBases:
-		return proto.ProductGroup_PRODUCT_GROUP_SLATTED_BASES
-	case daltymodel.ProductGroupMattressToppers:
-		return proto.ProductGroup_PRODUCT_GROUP_MATTRESS_TOPPERS
-	case daltymodel.ProductGroupPillows:
-		return proto.ProductGroup_PRODUCT_GROUP_PILLOWS
-	case daltymodel.ProductGroupBeds:
-		return proto.ProductGroup_PRODUCT_GROUP_BEDS
-	case daltymodel.ProductGroupBedBases:
-		return proto.ProductGroup_PRODUCT_GROUP_BED_BASES
-	case daltymodel.ProductGroupMiscellaneous:
-		return proto.ProductGroup_PRODUCT_GROUP_MISCELLANEOUS
-	case daltymodel.ProductGroupWardrobes:
-		return proto.ProductGroup_PRODUCT_GROUP_WARDROBES
-	case daltymodel.ProductGroupTextiles:
-		return proto.ProductGroup_PRODUCT_GROUP_TEXTILES
-	case daltymodel.ProductGroupElectronics:
-		return proto.ProductGroup_PRODUCT_GROUP_ELECTRONICS
-	case daltymodel.ProductGroupClothing:
-		return proto.ProductGroup_PRODUCT_GROUP_CLOTHING
-	case daltymodel.ProductGroupOrthopedics:
-		return proto.ProductGroup_PRODUCT_GROUP_ORTHOPEDICS
-	case daltymodel.ProductGroupCoffeeTables:
-		return proto.ProductGroup_PRODUCT_GROUP_COFFEE_TABLES
-	case daltymodel.ProductGroupHomeOffice:
-		return proto.ProductGroup_PRODUCT_GROUP_HOME_OFFICE
-	case daltymodel.ProductGroupLivingRooms:
-		return proto.ProductGroup_PRODUCT_GROUP_LIVING_ROOMS
-	case daltymodel.ProductGroupLighting:
-		return proto.ProductGroup_PRODUCT_GROUP_LIGHTING
-	case daltymodel.ProductGroupDecor:
-		return proto.ProductGroup_PRODUCT_GROUP_DECOR
-	case daltymodel.ProductGroupSpaceOrganizationUpper:
-		return proto.ProductGroup_PRODUCT_GROUP_HOME_CARE
-	case daltymodel.ProductGroupHomeCareUpper:
-		return proto.ProductGroup_PRODUCT_GROUP_HOME_CARE_UPPER
-	case daltymodel.ProductGroupSpaceOrganization:
-		return proto.ProductGroup_PRODUCT_GROUP_SPACE_ORGANIZATION
-	case daltymodel.ProductGroupHomeCare:
-		return proto.ProductGroup_PRODUCT_GROUP_HOME_CARE
-	case daltymodel.ProductGroupHallways:
-		return proto.ProductGroup_PRODUCT_GROUP_HALLWAYS
-	case daltymodel.ProductGroupFurnitureProtectionAndCare:
-		return proto.ProductGroup_PRODUCT_GROUP_FURNITURE_PROTECTION_AND_CARE
-	case daltymodel.ProductGroupOutdoorFurniture:
-		return proto.ProductGroup_PRODUCT_GROUP_OUTDOOR_FURNITURE
-	case daltymodel.ProductGroupStorage:
-		return proto.ProductGroup_PRODUCT_GROUP_STORAGE
-	case daltymodel.ProductGroupInterior:
-		return proto.ProductGroup_PRODUCT_GROUP_INTERIOR
-	case daltymodel.ProductGroupSeasonalProducts:
-		return proto.ProductGroup_PRODUCT_GROUP_SEASONAL_PRODUCTS
-	case daltymodel.ProductGroupFragrances:
-		return proto.ProductGroup_PRODUCT_GROUP_FRAGRANCES
-
-	// accessories & additional groups
-	case daltymodel.ProductGroupMurphyBeds:
-		return proto.ProductGroup_PRODUCT_GROUP_MURPHY_BEDS
-	case daltymodel.ProductGroupBedAccessories:
-		return proto.ProductGroup_PRODUCT_GROUP_BED_ACCESSORIES
-	case daltymodel.ProductGroupMurphyBedAccessories:
-		return proto.ProductGroup_PRODUCT_GROUP_MURPHY_BED_ACCESSORIES
-	case daltymodel.ProductGroupSmallFurnitureAccessories:
-		return proto.ProductGroup_PRODUCT_GROUP_SMALL_FURNITURE_ACCESSORIES
-	case daltymodel.ProductGroupWardrobeAccessories:
-		return proto.ProductGroup_PRODUCT_GROUP_WARDROBE_ACCESSORIES
-	case daltymodel.ProductGroupInteriorDecoration:
-		return proto.ProductGroup_PRODUCT_GROUP_INTERIOR_DECORATION
-	case daltymodel.ProductGroupSleepTherapy:
-		return proto.ProductGroup_PRODUCT_GROUP_SLEEP_THERAPY
-	case daltymodel.ProductGroupKingKoil:
-		return proto.ProductGroup_PRODUCT_GROUP_KING_KOIL
-	case daltymodel.ProductGroupErgomotionAccessories:
-		return proto.ProductGroup_PRODUCT_GROUP_ERGOMOTION_ACCESSORIES
-	case daltymodel.ProductGroupChildrenBedBases:
-		return proto.ProductGroup_PRODUCT_GROUP_CHILDREN_BED_BASES
-	case daltymodel.ProductGroupPillowCovers:
-		return proto.ProductGroup_PRODUCT_GROUP_PILLOW_COVERS
-	case daltymodel.ProductGroupTableware:
-		return proto.ProductGroup_PRODUCT_GROUP_TABLEWARE
-	case daltymodel.ProductGroupSets:
-		return proto.ProductGroup_PRODUCT_GROUP_SETS
-	case daltymodel.ProductGroupChildrenBedrooms:
-		return proto.ProductGroup_PRODUCT_GROUP_CHILDREN_BEDROOMS
-	case daltymodel.ProductGroupSpaceOrganizationStorage:
-		return proto.ProductGroup_PRODUCT_GROUP_SPACE_ORGANIZATION_STORAGE
-	case daltymodel.ProductGroupBathroomProducts:
-		return proto.ProductGroup_PRODUCT_GROUP_BATHROOM_PRODUCTS
-	case daltymodel.ProductGroupToys:
-		return proto.ProductGroup_PRODUCT_GROUP_TOYS
-	case daltymodel.ProductGroupAccessories:
-		return proto.ProductGroup_PRODUCT_GROUP_ACCESSORIES
-	case daltymodel.ProductGroupNewYear:
-		return proto.ProductGroup_PRODUCT_GROUP_NEW_YEAR
-	case daltymodel.ProductGroupArmchairs:
-		return proto.ProductGroup_PRODUCT_GROUP_ARMCHAIRS
-	case daltymodel.ProductGroupMassageChairs:
-		return proto.ProductGroup_PRODUCT_GROUP_MASSAGE_CHAIRS
-	case daltymodel.ProductGroupKafkaTest:
-		return proto.ProductGroup_PRODUCT_GROUP_KAFKA_TEST
-	case daltymodel.ProductGroupCaseFurnitureAccessories:
-		return proto.ProductGroup_PRODUCT_GROUP_CASE_FURNITURE_ACCESSORIES
+
+func fromProtoProductionType(in proto.ProductionType) daltymodel.ProductionType {
+	switch in {
+	case proto.ProductionType_PRODUCTION_TYPE_PRODUCING:
+		return core.ProductionTypeProducing
+	case proto.ProductionType_PRODUCTION_TYPE_PURCHASING:
+		return core.ProductionTypePurchasing
+	default:
+		return ""
+	}
+}
+
+func fromProtoDuplicateMode(in proto.DuplicateMode) usecase.DuplicateMode {
+	switch in {
+	case proto.DuplicateMode_DUPLICATE_MODE_REJECT:
+		return usecase.DuplicateReject
+	case proto.DuplicateMode_DUPLICATE_MODE_MERGE:
+		return usecase.DuplicateMerge
 	default:
-		return proto.ProductGroup_PRODUCT_GROUP_UNSPECIFIED
+		return usecase.DuplicateAccept
 	}
 }
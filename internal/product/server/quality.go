@@ -0,0 +1,112 @@
+package server
+
+import (
+	"github.com/DimKa163/dalty/api/proto"
+	"github.com/DimKa163/dalty/internal/product/core"
+	"github.com/DimKa163/dalty/internal/product/usecase"
+	"github.com/DimKa163/dalty/pkg/daltyerrors/protoerr"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var issueCategories = map[proto.QualityIssueCategory]core.IssueCategory{
+	proto.QualityIssueCategory_QUALITY_ISSUE_CATEGORY_ZERO_DIMENSIONS:         core.IssueZeroDimensions,
+	proto.QualityIssueCategory_QUALITY_ISSUE_CATEGORY_UNKNOWN_PRODUCTION_TYPE: core.IssueUnknownProductionType,
+	proto.QualityIssueCategory_QUALITY_ISSUE_CATEGORY_NO_GROUP:                core.IssueNoGroup,
+	proto.QualityIssueCategory_QUALITY_ISSUE_CATEGORY_COUNT_MA_MISMATCH:       core.IssueCountMaMismatch,
+}
+
+// qualityReportPartSize is the most issues sent in one message.
+const qualityReportPartSize = 1000
+
+type QualityServer struct {
+	service *usecase.QualityService
+	proto.UnimplementedQualityServiceServer
+}
+
+func NewQualityServer(service *usecase.QualityService) *QualityServer {
+	return &QualityServer{
+		service: service,
+	}
+}
+
+func (qs *QualityServer) Bind(server *grpc.Server) {
+	proto.RegisterQualityServiceServer(server, qs)
+}
+
+// GetQualityReport streams the report in parts of qualityReportPartSize
+// issues, so that large reports stay under the message size limit.
+func (qs *QualityServer) GetQualityReport(in *proto.QualityReportRequest, stream grpc.ServerStreamingServer[proto.QualityReport]) error {
+	categories := make([]core.IssueCategory, len(in.GetCategories()))
+	for i, category := range in.GetCategories() {
+		c, ok := issueCategories[category]
+		if !ok {
+			return protoerr.InvalidArgument("unknown issue category", &protoerr.ValidationError{
+				Message: "unknown issue category",
+				Members: []string{"categories"},
+			})
+		}
+		categories[i] = c
+	}
+	report, err := qs.service.Report(stream.Context(), categories)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	for _, part := range toProtoQualityReport(report) {
+		if err = stream.Send(part); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// toProtoQualityReport splits the report into parts, the first one carries
+// the totals. A report without issues is a single part.
+func toProtoQualityReport(in *usecase.QualityReport) []*proto.QualityReport {
+	var first proto.QualityReport
+	first.SetScanned(int32(in.Scanned))
+	counts := make([]*proto.QualityCategoryCount, 0, len(in.Counts))
+	for _, category := range core.IssueCategories {
+		count, ok := in.Counts[category]
+		if !ok {
+			continue
+		}
+		var c proto.QualityCategoryCount
+		c.SetCategory(toProtoIssueCategory(category))
+		c.SetCount(int32(count))
+		counts = append(counts, &c)
+	}
+	first.SetCounts(counts)
+	parts := []*proto.QualityReport{&first}
+	for start := 0; start < len(in.Issues); start += qualityReportPartSize {
+		part := &first
+		if start > 0 {
+			part = &proto.QualityReport{}
+			parts = append(parts, part)
+		}
+		end := min(start+qualityReportPartSize, len(in.Issues))
+		issues := make([]*proto.QualityIssue, 0, end-start)
+		for _, issue := range in.Issues[start:end] {
+			var item proto.QualityIssue
+			item.SetCategory(toProtoIssueCategory(issue.Category))
+			item.SetProductId(issue.ProductID)
+			item.SetFnrec(issue.Fnrec)
+			item.SetIntegrationId(issue.IntegrationID)
+			item.SetName(issue.Name)
+			item.SetDetail(issue.Detail)
+			issues = append(issues, &item)
+		}
+		part.SetIssues(issues)
+	}
+	return parts
+}
+
+func toProtoIssueCategory(in core.IssueCategory) proto.QualityIssueCategory {
+	for out, category := range issueCategories {
+		if category == in {
+			return out
+		}
+	}
+	return proto.QualityIssueCategory_QUALITY_ISSUE_CATEGORY_UNSPECIFIED
+}
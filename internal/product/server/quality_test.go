@@ -0,0 +1,29 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/DimKa163/dalty/internal/product/core"
+	"github.com/DimKa163/dalty/internal/product/usecase"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQualityReportParts(t *testing.T) {
+	report := &usecase.QualityReport{
+		Scanned: 5000,
+		Counts:  map[core.IssueCategory]int{core.IssueNoGroup: 2*qualityReportPartSize + 1},
+	}
+	for i := 0; i < 2*qualityReportPartSize+1; i++ {
+		report.Issues = append(report.Issues, &usecase.QualityIssue{Category: core.IssueNoGroup})
+	}
+
+	parts := toProtoQualityReport(report)
+
+	assert.Len(t, parts, 3)
+	assert.Equal(t, int32(5000), parts[0].GetScanned())
+	assert.Len(t, parts[0].GetCounts(), 1)
+	assert.Len(t, parts[0].GetIssues(), qualityReportPartSize)
+	assert.Empty(t, parts[2].GetCounts())
+	assert.Len(t, parts[2].GetIssues(), 1)
+	assert.Len(t, toProtoQualityReport(&usecase.QualityReport{}), 1)
+}
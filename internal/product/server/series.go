@@ -0,0 +1,98 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	"github.com/DimKa163/dalty/api/proto"
+	"github.com/DimKa163/dalty/internal/product/usecase"
+	"github.com/DimKa163/dalty/pkg/daltyerrors"
+	"github.com/DimKa163/dalty/pkg/daltyerrors/protoerr"
+	"github.com/beevik/guid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type SeriesServer struct {
+	service *usecase.SeriesService
+	groups  *GroupMapping
+	proto.UnimplementedSeriesServiceServer
+}
+
+func NewSeriesServer(service *usecase.SeriesService, groups *GroupMapping) *SeriesServer {
+	return &SeriesServer{
+		service: service,
+		groups:  groups,
+	}
+}
+
+func (ss *SeriesServer) Bind(server *grpc.Server) {
+	proto.RegisterSeriesServiceServer(server, ss)
+}
+
+func (ss *SeriesServer) ListSeriesProducts(ctx context.Context, in *proto.SeriesRequest) (*proto.ListSeriesProductsResponse, error) {
+	seriesID, err := toSeriesID(in)
+	if err != nil {
+		return nil, err
+	}
+	members, err := ss.service.Products(ctx, seriesID)
+	if err != nil {
+		return nil, handleSeriesError(err)
+	}
+	var response proto.ListSeriesProductsResponse
+	products := make([]*proto.SeriesProduct, len(members))
+	for i, member := range members {
+		var product proto.SeriesProduct
+		product.SetProduct(ss.groups.toProtoProduct(member.Product))
+		assets := make([]*proto.SeriesAsset, len(member.Assets))
+		for j, rel := range member.Assets {
+			var asset proto.SeriesAsset
+			asset.SetProduct(ss.groups.toProtoProduct(rel.Right))
+			asset.SetAmount(rel.Amount)
+			assets[j] = &asset
+		}
+		product.SetAssets(assets)
+		products[i] = &product
+	}
+	response.SetProducts(products)
+	return &response, nil
+}
+
+func (ss *SeriesServer) GetSeriesSummary(ctx context.Context, in *proto.SeriesRequest) (*proto.SeriesSummary, error) {
+	seriesID, err := toSeriesID(in)
+	if err != nil {
+		return nil, err
+	}
+	summary, err := ss.service.Summary(ctx, seriesID)
+	if err != nil {
+		return nil, handleSeriesError(err)
+	}
+	var out proto.SeriesSummary
+	out.SetSeriesId(summary.SeriesID)
+	out.SetProducts(int32(summary.Products))
+	out.SetPackages(summary.Packages)
+	out.SetVolume(summary.Volume)
+	out.SetWeight(summary.Weight)
+	return &out, nil
+}
+
+func toSeriesID(in *proto.SeriesRequest) (string, error) {
+	id, err := guid.ParseString(in.GetSeriesId())
+	if err != nil {
+		return "", protoerr.InvalidArgument("series_id is not a valid guid",
+			&protoerr.ValidationError{
+				Message: err.Error(),
+				Members: []string{"series_id"},
+			})
+	}
+	return id.String(), nil
+}
+
+func handleSeriesError(err error) error {
+	var daltyErr *daltyerrors.DaltyError
+	if errors.As(err, &daltyErr) {
+		return protoerr.Handle(daltyErr)
+	}
+	return status.Error(codes.Internal, err.Error())
+}
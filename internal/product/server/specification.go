@@ -2,21 +2,26 @@ package server
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/DimKa163/dalty/api/proto"
 	"github.com/DimKa163/dalty/internal/product/usecase"
+	"github.com/DimKa163/dalty/pkg/daltyerrors"
 	"github.com/DimKa163/dalty/pkg/daltyerrors/protoerr"
 	"github.com/DimKa163/dalty/pkg/daltymodel"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type SpecificationServer struct {
-	app *usecase.SpecificationService
+	app    *usecase.SpecificationService
+	groups *GroupMapping
 	proto.UnimplementedSpecificationServiceServer
 }
 
-func NewSpecificationServer(app *usecase.SpecificationService) *SpecificationServer {
-	return &SpecificationServer{app: app}
+func NewSpecificationServer(app *usecase.SpecificationService, groups *GroupMapping) *SpecificationServer {
+	return &SpecificationServer{app: app, groups: groups}
 }
 
 func (ss *SpecificationServer) Bind(server *grpc.Server) {
@@ -33,13 +38,18 @@ func (ss *SpecificationServer) Execute(ctx context.Context, in *proto.Specificat
 	}
 	res, err = ss.app.Execute(ctx, &specReq)
 	if err != nil {
-		return nil, err
+		var daltyErr *daltyerrors.DaltyError
+		if errors.As(err, &daltyErr) {
+			return nil, protoerr.Handle(daltyErr)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
 	}
 	specs := make([]*proto.Specification, len(res))
 	for i, r := range res {
-		specs[i] = toSpecification(r)
+		specs[i] = ss.groups.toSpecification(r)
 	}
 	response.SetSpecifications(specs)
+	response.SetTotals(toProtoTotals(daltymodel.TotalsOf(res)))
 	return &response, nil
 }
 
@@ -54,6 +64,7 @@ func toIn(in *proto.SpecificationRequest, request *usecase.SpecRequest) (err err
 		})
 	}
 	request.Specs = make([]*usecase.Spec, len(lines))
+	request.Duplicates = fromProtoDuplicateMode(in.GetDuplicates())
 
 	for i, line := range lines {
 		if !line.HasQuantity() {
@@ -71,39 +82,53 @@ func toIn(in *proto.SpecificationRequest, request *usecase.SpecRequest) (err err
 	return
 }
 
-func toSpecification(spec *daltymodel.Specification) *proto.Specification {
+func (m *GroupMapping) toSpecification(spec *daltymodel.Specification) *proto.Specification {
 	var specification proto.Specification
-	specification.SetProduct(toLine(spec.Product))
+	specification.SetProduct(m.toLine(spec.Product))
 	specification.SetType(proto.SpecificationType(spec.Type))
 	specification.SetStrategy(proto.PickupStrategy(spec.Strategy))
 	childProducts := make([]*proto.Line, len(spec.ChildProducts))
 	for i, childProduct := range spec.ChildProducts {
-		childProducts[i] = toLine(childProduct)
+		childProducts[i] = m.toLine(childProduct)
 	}
 	specification.SetChildProduct(childProducts)
+	specification.SetTotals(toProtoTotals(spec.Totals))
 	return &specification
 }
 
-func toLine(ln *daltymodel.Line) *proto.Line {
+func toProtoTotals(in daltymodel.Totals) *proto.Totals {
+	var out proto.Totals
+	out.SetPackages(in.Packages)
+	out.SetVolume(in.Volume)
+	out.SetWeight(in.Weight)
+	out.SetMaxDimension(in.MaxDimension)
+	return &out
+}
+
+func (m *GroupMapping) toLine(ln *daltymodel.Line) *proto.Line {
 	var line proto.Line
-	line.SetProduct(toProtoProductV2(ln.Product))
+	line.SetProduct(m.toProtoProductV2(ln.Product))
 	line.SetQuantity(ln.Quantity)
 	line.SetStrategy(proto.PickupStrategy(ln.Strategy))
 	return &line
 }
 
-func toProtoProductV2(in *daltymodel.Product) *proto.Product {
+func (m *GroupMapping) toProtoProductV2(in *daltymodel.Product) *proto.Product {
 	var out proto.Product
 	out.SetId(in.ID.String())
 	out.SetName(in.Name)
 	out.SetProductionType(toProtoProductionType(in.ProductionType))
 	out.SetFnrec(in.Fnrec)
 	out.SetIsService(in.IsService)
-	out.SetGroup(toProtoProductGroup(in.Group))
+	out.SetGroup(m.toProtoProductGroup(in.Group))
 	out.SetSeriesId(in.SeriesID)
 	out.SetCategoryId(in.CategoryID)
 	out.SetAccountProvider(in.AccountProviderId)
 	out.SetNonStandardCategoryId(in.NonStandardCategory)
+	out.SetType(toProtoProductType(in.Type))
+	out.SetIsArchive(in.IsArchive)
+	out.SetIntegrationId(in.IntegrationID)
+	out.SetCountMa(in.CountMa)
 	var pack proto.Pack
 	pack.SetLength(in.Length)
 	pack.SetHeight(in.Height)
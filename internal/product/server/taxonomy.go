@@ -0,0 +1,123 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	"github.com/DimKa163/dalty/api/proto"
+	"github.com/DimKa163/dalty/internal/product/core"
+	"github.com/DimKa163/dalty/internal/product/usecase"
+	"github.com/DimKa163/dalty/pkg/daltyerrors"
+	"github.com/DimKa163/dalty/pkg/daltyerrors/protoerr"
+	"github.com/DimKa163/dalty/pkg/daltymodel"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type TaxonomyServer struct {
+	service *usecase.TaxonomyService
+	groups  *GroupMapping
+	proto.UnimplementedTaxonomyServiceServer
+}
+
+func NewTaxonomyServer(service *usecase.TaxonomyService, groups *GroupMapping) *TaxonomyServer {
+	return &TaxonomyServer{
+		service: service,
+		groups:  groups,
+	}
+}
+
+func (ts *TaxonomyServer) Bind(server *grpc.Server) {
+	proto.RegisterTaxonomyServiceServer(server, ts)
+}
+
+func (ts *TaxonomyServer) GetGroupTree(ctx context.Context, _ *proto.GroupTreeRequest) (*proto.GroupTreeResponse, error) {
+	var response proto.GroupTreeResponse
+	taxonomy, err := ts.service.Tree(ctx)
+	if err != nil {
+		return nil, handleTaxonomyError(err)
+	}
+	roots := make([]*proto.ProductGroupNode, len(taxonomy.Roots()))
+	for i, root := range taxonomy.Roots() {
+		roots[i] = ts.groups.toProtoGroupTree(taxonomy, root)
+	}
+	response.SetRoots(roots)
+	return &response, nil
+}
+
+func (ts *TaxonomyServer) AncestorsOf(ctx context.Context, in *proto.GroupRequest) (*proto.GroupListResponse, error) {
+	group, err := ts.groups.toGroup(in)
+	if err != nil {
+		return nil, err
+	}
+	groups, err := ts.service.Ancestors(ctx, group)
+	if err != nil {
+		return nil, handleTaxonomyError(err)
+	}
+	return ts.groups.toProtoGroupList(groups), nil
+}
+
+func (ts *TaxonomyServer) DescendantsOf(ctx context.Context, in *proto.GroupRequest) (*proto.GroupListResponse, error) {
+	group, err := ts.groups.toGroup(in)
+	if err != nil {
+		return nil, err
+	}
+	groups, err := ts.service.Descendants(ctx, group)
+	if err != nil {
+		return nil, handleTaxonomyError(err)
+	}
+	return ts.groups.toProtoGroupList(groups), nil
+}
+
+func (m *GroupMapping) toGroup(in *proto.GroupRequest) (daltymodel.ProductGroup, error) {
+	group := m.fromProtoProductGroup(in.GetGroup())
+	if group == "" {
+		return "", protoerr.InvalidArgument("group is not set",
+			&protoerr.ValidationError{
+				Message: "group must be a known product group",
+				Members: []string{"group"},
+			})
+	}
+	return group, nil
+}
+
+func handleTaxonomyError(err error) error {
+	var daltyErr *daltyerrors.DaltyError
+	if errors.As(err, &daltyErr) {
+		return protoerr.Handle(daltyErr)
+	}
+	if errors.Is(err, usecase.ErrTaxonomyNotLoaded) {
+		return status.Error(codes.Unavailable, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
+
+func (m *GroupMapping) toProtoGroupTree(taxonomy *core.Taxonomy, group *core.GroupNode) *proto.ProductGroupNode {
+	out := m.toProtoGroupNode(group)
+	children := taxonomy.Children(group.ID)
+	nodes := make([]*proto.ProductGroupNode, len(children))
+	for i, child := range children {
+		nodes[i] = m.toProtoGroupTree(taxonomy, child)
+	}
+	out.SetChildren(nodes)
+	return out
+}
+
+func (m *GroupMapping) toProtoGroupList(groups []*core.GroupNode) *proto.GroupListResponse {
+	var response proto.GroupListResponse
+	nodes := make([]*proto.ProductGroupNode, len(groups))
+	for i, group := range groups {
+		nodes[i] = m.toProtoGroupNode(group)
+	}
+	response.SetGroups(nodes)
+	return &response
+}
+
+func (m *GroupMapping) toProtoGroupNode(group *core.GroupNode) *proto.ProductGroupNode {
+	var out proto.ProductGroupNode
+	out.SetId(string(group.ID))
+	out.SetGroup(m.toProtoProductGroup(group.ID))
+	out.SetName(group.Name)
+	return &out
+}
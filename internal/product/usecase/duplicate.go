@@ -0,0 +1,68 @@
+package usecase
+
+import (
+	"github.com/DimKa163/dalty/internal/product/core"
+	"github.com/DimKa163/dalty/pkg/daltyerrors"
+	"github.com/beevik/guid"
+)
+
+// DuplicateMode says what happens to request lines that resolve to a product
+// an earlier line of the same request already resolved to. Lines are compared
+// after resolving, so a fnrec and an integration id of one product are
+// duplicates too.
+type DuplicateMode int
+
+const (
+	// DuplicateAccept handles every line on its own, as if it were the only
+	// line of its product.
+	DuplicateAccept DuplicateMode = iota
+	// DuplicateReject fails the request with code 53 naming every duplicate
+	// line.
+	DuplicateReject
+	// DuplicateMerge folds duplicates into the first line of the product,
+	// adding up quantities where lines have them. Lines without quantities
+	// keep their positions, each holding the merged product.
+	DuplicateMerge
+)
+
+// duplicates returns the positions holding a product an earlier position
+// already holds. Nil products are skipped.
+func duplicates(products []*core.Product) []int {
+	seen := make(map[guid.Guid]bool, len(products))
+	positions := make([]int, 0)
+	for i, product := range products {
+		if product == nil {
+			continue
+		}
+		if seen[product.ID] {
+			positions = append(positions, i)
+			continue
+		}
+		seen[product.ID] = true
+	}
+	return positions
+}
+
+// mergeSpecs applies mode to products resolved from specs, position by
+// position.
+func mergeSpecs(specs []*Spec, products []*ProductSpec, mode DuplicateMode) ([]*ProductSpec, error) {
+	if mode == DuplicateAccept {
+		return products, nil
+	}
+	first := make(map[guid.Guid]*ProductSpec, len(products))
+	merged := make([]*ProductSpec, 0, len(products))
+	dups := make([]*daltyerrors.EntityError, 0)
+	for i, product := range products {
+		if kept, ok := first[product.ID]; ok {
+			dups = append(dups, specs[i].entityError())
+			kept.Quantity += product.Quantity
+			continue
+		}
+		first[product.ID] = product
+		merged = append(merged, product)
+	}
+	if len(dups) > 0 && mode == DuplicateReject {
+		return nil, daltyerrors.New(53, dups...)
+	}
+	return merged, nil
+}
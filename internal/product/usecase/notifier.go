@@ -0,0 +1,40 @@
+package usecase
+
+import "sync"
+
+// LocalNotifier fans Notify calls out to its subscribers. A subscriber that
+// has not consumed the previous signal yet doesn't get a second one, one
+// wake-up is enough to read everything that changed.
+type LocalNotifier struct {
+	mu          sync.Mutex
+	subscribers map[chan struct{}]struct{}
+}
+
+func NewLocalNotifier() *LocalNotifier {
+	return &LocalNotifier{
+		subscribers: make(map[chan struct{}]struct{}),
+	}
+}
+
+func (n *LocalNotifier) Subscribe() (<-chan struct{}, func()) {
+	signals := make(chan struct{}, 1)
+	n.mu.Lock()
+	n.subscribers[signals] = struct{}{}
+	n.mu.Unlock()
+	return signals, func() {
+		n.mu.Lock()
+		delete(n.subscribers, signals)
+		n.mu.Unlock()
+	}
+}
+
+func (n *LocalNotifier) Notify() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	for signals := range n.subscribers {
+		select {
+		case signals <- struct{}{}:
+		default:
+		}
+	}
+}
@@ -3,8 +3,11 @@ package usecase
 import (
 	"context"
 	"errors"
+	"github.com/DimKa163/dalty/internal/logging"
 	"github.com/DimKa163/dalty/internal/product/core"
 	"github.com/DimKa163/dalty/pkg/daltyerrors"
+	"github.com/beevik/guid"
+	"go.uber.org/zap"
 )
 
 var ErrArchiveProduct = errors.New("product is archived")
@@ -16,46 +19,169 @@ type ProductRequest struct {
 	IntegrationID string
 	Fnrec         string
 }
+
+// entityError names the identifier the request was made by.
+func (r *ProductRequest) entityError() *daltyerrors.EntityError {
+	switch {
+	case r.ID != "":
+		return &daltyerrors.EntityError{ID: r.ID, EntityName: "product", Field: "id"}
+	case r.IntegrationID != "":
+		return &daltyerrors.EntityError{ID: r.IntegrationID, EntityName: "product", Field: "integration_id"}
+	default:
+		return &daltyerrors.EntityError{ID: r.Fnrec, EntityName: "product", Field: "fnrec"}
+	}
+}
+
 type ProductService struct {
-	productRepository core.ProductRepository
+	productRepository    core.ProductRepository
+	nameSearchRepository core.NameSearchRepository
+	taxonomyService      *TaxonomyService
+	replacementService   *ReplacementService
 }
 
-func NewProductService(productRepository core.ProductRepository) *ProductService {
+func NewProductService(productRepository core.ProductRepository, nameSearchRepository core.NameSearchRepository,
+	taxonomyService *TaxonomyService, replacementService *ReplacementService) *ProductService {
 	return &ProductService{
-		productRepository: productRepository,
+		productRepository:    productRepository,
+		nameSearchRepository: nameSearchRepository,
+		taxonomyService:      taxonomyService,
+		replacementService:   replacementService,
 	}
 }
 
-func (ps *ProductService) BatchRequest(ctx context.Context, requests []*ProductRequest) ([]*core.Product, error) {
-	results := make([]*core.Product, 0, len(requests))
-	errs := make([]*daltyerrors.StorageError, 0)
-	for _, request := range requests {
-		resp, err := ps.find(ctx, request)
-		if err != nil {
+// BatchItem is the outcome of one request of a partial batch. Exactly one
+// of Product and Err is set.
+type BatchItem struct {
+	Product *core.Product
+	Err     *daltyerrors.DaltyError
+}
 
-			var storageErr *daltyerrors.StorageError
-			if errors.As(err, &storageErr) {
-				errs = append(errs, storageErr)
+// BatchRequest resolves every request with at most one query per kind of
+// identifier and returns the products in request order. All identifiers
+// that match no product are reported in a single error, and so are
+// duplicates when mode rejects them. Otherwise every position holds its
+// product, duplicates included.
+func (ps *ProductService) BatchRequest(ctx context.Context, requests []*ProductRequest, mode DuplicateMode) ([]*core.Product, error) {
+	results, err := ps.lookup(ctx, requests)
+	if err != nil {
+		return nil, err
+	}
+	missing := make([]*daltyerrors.EntityError, 0)
+	for i, product := range results {
+		if product == nil {
+			missing = append(missing, requests[i].entityError())
+		}
+	}
+	if len(missing) > 0 {
+		return nil, daltyerrors.New(6, missing...)
+	}
+	if dups := duplicates(results); len(dups) > 0 && mode == DuplicateReject {
+		errs := make([]*daltyerrors.EntityError, len(dups))
+		for i, position := range dups {
+			errs[i] = requests[position].entityError()
+		}
+		return nil, daltyerrors.New(53, errs...)
+	}
+	if err := ps.validateProducts(ctx, results); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// BatchRequestPartial is BatchRequest that never fails the whole batch for a
+// missing, archived or duplicate product. Such requests get their own error
+// instead. Items keep their positions, so merged duplicates repeat the
+// product.
+func (ps *ProductService) BatchRequestPartial(ctx context.Context, requests []*ProductRequest, mode DuplicateMode) ([]*BatchItem, error) {
+	results, err := ps.lookup(ctx, requests)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*BatchItem, len(results))
+	archived := make([]guid.Guid, 0)
+	for i, product := range results {
+		switch {
+		case product == nil:
+			items[i] = &BatchItem{Err: daltyerrors.New(6, requests[i].entityError())}
+		case product.IsArchive:
+			items[i] = &BatchItem{Err: daltyerrors.New(5, archivedError(product))}
+			archived = append(archived, product.ID)
+		default:
+			items[i] = &BatchItem{Product: product}
+		}
+	}
+	if mode == DuplicateReject {
+		for _, position := range duplicates(results) {
+			if items[position].Err == nil {
+				items[position] = &BatchItem{Err: daltyerrors.New(53, requests[position].entityError())}
 			}
 		}
-		results = append(results, resp)
-	}
-	if len(errs) > 0 {
-		daltyErrs := make([]*daltyerrors.EntityError, len(errs))
-		for i, err := range errs {
-			daltyErrs[i] = &daltyerrors.EntityError{
-				ID:         err.Value[0].(string),
-				EntityName: "product",
+	}
+	if len(archived) == 0 {
+		return items, nil
+	}
+	// archived items are reported without replacements when they cannot be
+	// looked up
+	replacements, err := ps.replacementService.Replacements(ctx, archived)
+	if err != nil {
+		logging.Logger(ctx).Error("error occurred when looking up replacements", zap.Error(err))
+		return items, nil
+	}
+	for i, product := range results {
+		if product == nil || !product.IsArchive {
+			continue
+		}
+		entErr := items[i].Err.EntityErrors[0]
+		for _, replacement := range replacements[product.ID] {
+			entErr.Replacements = append(entErr.Replacements, replacement.ID.String())
+		}
+	}
+	return items, nil
+}
+
+// lookup returns products in request order with nil for requests that match
+// nothing.
+func (ps *ProductService) lookup(ctx context.Context, requests []*ProductRequest) ([]*core.Product, error) {
+	ids := make([]string, 0)
+	fnrecs := make([]string, 0)
+	integrationIDs := make([]string, 0)
+	for _, request := range requests {
+		switch {
+		case request.ID != "":
+			if id, ok := normalizeID(request.ID); ok {
+				ids = append(ids, id)
 			}
+		case request.IntegrationID != "":
+			integrationIDs = append(integrationIDs, request.IntegrationID)
+		default:
+			fnrecs = append(fnrecs, request.Fnrec)
 		}
-		return nil, daltyerrors.New(
-			6,
-			daltyErrs...,
-		)
 	}
-	if err := validateProducts(results); err != nil {
+	byID, err := fetch(ctx, ps.productRepository.GetByIDs, ids)
+	if err != nil {
 		return nil, err
 	}
+	byIntegrationID, err := fetch(ctx, ps.productRepository.GetByIntegrationIDs, integrationIDs)
+	if err != nil {
+		return nil, err
+	}
+	byFnrec, err := fetch(ctx, ps.productRepository.GetByFnrecs, fnrecs)
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*core.Product, len(requests))
+	for i, request := range requests {
+		switch {
+		case request.ID != "":
+			if id, ok := normalizeID(request.ID); ok {
+				results[i] = byID[id]
+			}
+		case request.IntegrationID != "":
+			results[i] = byIntegrationID[request.IntegrationID]
+		default:
+			results[i] = byFnrec[request.Fnrec]
+		}
+	}
 	return results, nil
 }
 
@@ -64,24 +190,44 @@ func (ps *ProductService) Find(ctx context.Context, request *ProductRequest) (*c
 	if err != nil {
 		var storageErr *daltyerrors.StorageError
 		if errors.As(err, &storageErr) {
-			return nil, daltyerrors.New(
-				6,
-				&daltyerrors.EntityError{ID: storageErr.Value[0].(string), EntityName: "product"},
-			)
+			return nil, daltyerrors.New(6, request.entityError())
 		}
+		return nil, err
 	}
-	if err = validateProduct(product); err != nil {
+	if err = ps.validateProduct(ctx, product); err != nil {
 		return nil, err
 	}
 	return product, nil
 }
 
+// Replacements returns the requested product, archived or not, with the
+// active products that can be used instead of it.
+func (ps *ProductService) Replacements(ctx context.Context, request *ProductRequest) (*core.Product, []*core.Product, error) {
+	product, err := ps.find(ctx, request)
+	if err != nil {
+		var storageErr *daltyerrors.StorageError
+		if errors.As(err, &storageErr) {
+			return nil, nil, daltyerrors.New(6, request.entityError())
+		}
+		return nil, nil, err
+	}
+	replacements, err := ps.replacementService.Of(ctx, product.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return product, replacements, nil
+}
+
 func (ps *ProductService) find(ctx context.Context, request *ProductRequest) (*core.Product, error) {
 	var productFunc func(context.Context, string) (*core.Product, error)
 	var filter string
 	if request.ID != "" {
+		id, ok := normalizeID(request.ID)
+		if !ok {
+			return nil, daltyerrors.New(6, request.entityError())
+		}
 		productFunc = ps.productRepository.GetByID
-		filter = request.ID
+		filter = id
 	} else if request.IntegrationID != "" {
 		productFunc = ps.productRepository.GetByIntegrationID
 		filter = request.IntegrationID
@@ -92,18 +238,56 @@ func (ps *ProductService) find(ctx context.Context, request *ProductRequest) (*c
 	return productFunc(ctx, filter)
 }
 
-func validateProduct(product *core.Product) error {
+// normalizeID brings an id to the form products are keyed by. Ids that are
+// not guids are reported as not ok, the database rejects them, so they must
+// not reach a query and are treated as not found instead.
+func normalizeID(id string) (string, bool) {
+	parsed, err := guid.ParseString(id)
+	if err != nil {
+		return "", false
+	}
+	return parsed.String(), true
+}
+
+// fetch queries the distinct values at once and skips the query when there
+// is nothing to look up.
+func fetch(ctx context.Context, get func(context.Context, []string) (map[string]*core.Product, error),
+	values []string) (map[string]*core.Product, error) {
+	if len(values) == 0 {
+		return make(map[string]*core.Product), nil
+	}
+	return get(ctx, unique(values))
+}
+
+func unique(values []string) []string {
+	seen := make(map[string]bool, len(values))
+	result := make([]string, 0, len(values))
+	for _, v := range values {
+		if seen[v] {
+			continue
+		}
+		seen[v] = true
+		result = append(result, v)
+	}
+	return result
+}
+
+func (ps *ProductService) validateProduct(ctx context.Context, product *core.Product) error {
 	if product.IsArchive {
-		return daltyerrors.New(5, &daltyerrors.EntityError{ID: product.ID.String(), EntityName: "product"})
+		return ps.replacementService.archivedError(ctx, product)
 	}
 	return nil
 }
 
-func validateProducts(products []*core.Product) error {
+func (ps *ProductService) validateProducts(ctx context.Context, products []*core.Product) error {
 	for _, product := range products {
-		if product.IsArchive {
-			return daltyerrors.New(5, &daltyerrors.EntityError{ID: product.ID.String(), EntityName: "product"})
+		if err := ps.validateProduct(ctx, product); err != nil {
+			return err
 		}
 	}
 	return nil
 }
+
+func archivedError(product *core.Product) *daltyerrors.EntityError {
+	return &daltyerrors.EntityError{ID: product.ID.String(), EntityName: "product", Field: "id"}
+}
@@ -0,0 +1,256 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"github.com/DimKa163/dalty/internal/product/core"
+	"github.com/DimKa163/dalty/internal/product/mocks"
+	"github.com/DimKa163/dalty/pkg/daltyerrors"
+	"github.com/beevik/guid"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestFindByID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	mockProductRepository := mocks.NewMockProductRepository(ctrl)
+	product := &core.Product{
+		ID:   *guid.New(),
+		Name: "Test",
+	}
+	mockProductRepository.EXPECT().GetByID(ctx, product.ID.String()).Return(product, nil)
+	sut := NewProductService(mockProductRepository, nil, nil, nil)
+
+	result, err := sut.Find(ctx, &ProductRequest{ID: product.ID.String()})
+
+	assert.NoError(t, err)
+	assert.Equal(t, product, result)
+}
+
+func TestFindNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	mockProductRepository := mocks.NewMockProductRepository(ctrl)
+	id := guid.New().String()
+	mockProductRepository.EXPECT().GetByID(ctx, id).
+		Return(nil, daltyerrors.NewNotFoundError(nil, "product not found", id))
+	sut := NewProductService(mockProductRepository, nil, nil, nil)
+
+	_, err := sut.Find(ctx, &ProductRequest{ID: id})
+
+	var daltyErr *daltyerrors.DaltyError
+	assert.ErrorAs(t, err, &daltyErr)
+	assert.Equal(t, 6, daltyErr.Code)
+	assert.Equal(t, id, daltyErr.EntityErrors[0].ID)
+}
+
+func TestFindStorageFailure(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	mockProductRepository := mocks.NewMockProductRepository(ctrl)
+	failure := errors.New("connection refused")
+	mockProductRepository.EXPECT().GetByFnrec(ctx, "1").Return(nil, failure)
+	sut := NewProductService(mockProductRepository, nil, nil, nil)
+
+	_, err := sut.Find(ctx, &ProductRequest{Fnrec: "1"})
+
+	assert.ErrorIs(t, err, failure)
+}
+
+func TestBatchRequestKeepsOrder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	mockProductRepository := mocks.NewMockProductRepository(ctrl)
+	productA := &core.Product{ID: *guid.New(), Fnrec: "A", IntegrationID: "int-A"}
+	productB := &core.Product{ID: *guid.New(), Fnrec: "B", IntegrationID: "int-B"}
+	productC := &core.Product{ID: *guid.New(), Fnrec: "C", IntegrationID: "int-C"}
+	mockProductRepository.EXPECT().GetByFnrecs(ctx, []string{"A"}).
+		Return(map[string]*core.Product{"A": productA}, nil)
+	mockProductRepository.EXPECT().GetByIntegrationIDs(ctx, []string{"int-B"}).
+		Return(map[string]*core.Product{"int-B": productB}, nil)
+	mockProductRepository.EXPECT().GetByIDs(ctx, []string{productC.ID.String()}).
+		Return(map[string]*core.Product{productC.ID.String(): productC}, nil)
+	sut := NewProductService(mockProductRepository, nil, nil, nil)
+
+	result, err := sut.BatchRequest(ctx, []*ProductRequest{
+		{ID: productC.ID.String()},
+		{Fnrec: "A"},
+		{IntegrationID: "int-B"},
+		{Fnrec: "A"},
+	}, DuplicateAccept)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []*core.Product{productC, productA, productB, productA}, result)
+}
+
+func TestBatchRequestMergeKeepsPositions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	mockProductRepository := mocks.NewMockProductRepository(ctrl)
+	product := &core.Product{ID: *guid.New(), Fnrec: "A", IntegrationID: "int-A"}
+	mockProductRepository.EXPECT().GetByFnrecs(ctx, []string{"A"}).
+		Return(map[string]*core.Product{"A": product}, nil)
+	mockProductRepository.EXPECT().GetByIntegrationIDs(ctx, []string{"int-A"}).
+		Return(map[string]*core.Product{"int-A": product}, nil)
+	sut := NewProductService(mockProductRepository, nil, nil, nil)
+
+	result, err := sut.BatchRequest(ctx, []*ProductRequest{{Fnrec: "A"}, {IntegrationID: "int-A"}}, DuplicateMerge)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []*core.Product{product, product}, result)
+}
+
+func TestBatchRequestRejectsDuplicates(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	mockProductRepository := mocks.NewMockProductRepository(ctrl)
+	productA := &core.Product{ID: *guid.New(), Fnrec: "A", IntegrationID: "int-A"}
+	mockProductRepository.EXPECT().GetByFnrecs(ctx, []string{"A"}).
+		Return(map[string]*core.Product{"A": productA}, nil)
+	mockProductRepository.EXPECT().GetByIntegrationIDs(ctx, []string{"int-A"}).
+		Return(map[string]*core.Product{"int-A": productA}, nil)
+	sut := NewProductService(mockProductRepository, nil, nil, nil)
+
+	_, err := sut.BatchRequest(ctx, []*ProductRequest{
+		{Fnrec: "A"},
+		{IntegrationID: "int-A"},
+	}, DuplicateReject)
+
+	var daltyErr *daltyerrors.DaltyError
+	assert.ErrorAs(t, err, &daltyErr)
+	assert.Equal(t, 53, daltyErr.Code)
+	assert.Equal(t, []*daltyerrors.EntityError{{ID: "int-A", EntityName: "product", Field: "integration_id"}},
+		daltyErr.EntityErrors)
+}
+
+func TestBatchRequestPartialRejectsDuplicates(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	mockProductRepository := mocks.NewMockProductRepository(ctrl)
+	productA := &core.Product{ID: *guid.New(), Fnrec: "A", IntegrationID: "int-A"}
+	mockProductRepository.EXPECT().GetByFnrecs(ctx, []string{"A"}).
+		Return(map[string]*core.Product{"A": productA}, nil)
+	mockProductRepository.EXPECT().GetByIntegrationIDs(ctx, []string{"int-A"}).
+		Return(map[string]*core.Product{"int-A": productA}, nil)
+	sut := NewProductService(mockProductRepository, nil, nil, nil)
+
+	items, err := sut.BatchRequestPartial(ctx, []*ProductRequest{
+		{Fnrec: "A"},
+		{IntegrationID: "int-A"},
+	}, DuplicateReject)
+
+	assert.NoError(t, err)
+	assert.Equal(t, productA, items[0].Product)
+	assert.Nil(t, items[1].Product)
+	assert.Equal(t, 53, items[1].Err.Code)
+}
+
+func TestBatchRequestReportsAllMissing(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	mockProductRepository := mocks.NewMockProductRepository(ctrl)
+	productA := &core.Product{ID: *guid.New(), Fnrec: "A"}
+	mockProductRepository.EXPECT().GetByFnrecs(ctx, []string{"A", "X"}).
+		Return(map[string]*core.Product{"A": productA}, nil)
+	mockProductRepository.EXPECT().GetByIntegrationIDs(ctx, []string{"int-Y"}).
+		Return(map[string]*core.Product{}, nil)
+	sut := NewProductService(mockProductRepository, nil, nil, nil)
+
+	_, err := sut.BatchRequest(ctx, []*ProductRequest{
+		{Fnrec: "A"},
+		{Fnrec: "X"},
+		{IntegrationID: "int-Y"},
+	}, DuplicateReject)
+
+	var daltyErr *daltyerrors.DaltyError
+	assert.ErrorAs(t, err, &daltyErr)
+	assert.Equal(t, 6, daltyErr.Code)
+	assert.Equal(t, 2, len(daltyErr.EntityErrors))
+	assert.Equal(t, "X", daltyErr.EntityErrors[0].ID)
+	assert.Equal(t, "int-Y", daltyErr.EntityErrors[1].ID)
+}
+
+func TestBatchRequestInvalidID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	mockProductRepository := mocks.NewMockProductRepository(ctrl)
+	productA := &core.Product{ID: *guid.New(), Fnrec: "A"}
+	mockProductRepository.EXPECT().GetByFnrecs(ctx, []string{"A"}).
+		Return(map[string]*core.Product{"A": productA}, nil)
+	sut := NewProductService(mockProductRepository, nil, nil, nil)
+
+	_, err := sut.BatchRequest(ctx, []*ProductRequest{
+		{Fnrec: "A"},
+		{ID: "not-a-guid"},
+	}, DuplicateReject)
+
+	var daltyErr *daltyerrors.DaltyError
+	assert.ErrorAs(t, err, &daltyErr)
+	assert.Equal(t, 6, daltyErr.Code)
+	assert.Equal(t, []*daltyerrors.EntityError{{ID: "not-a-guid", EntityName: "product", Field: "id"}},
+		daltyErr.EntityErrors)
+
+	_, err = sut.Find(ctx, &ProductRequest{ID: "not-a-guid"})
+
+	assert.ErrorAs(t, err, &daltyErr)
+	assert.Equal(t, 6, daltyErr.Code)
+}
+
+func TestBatchRequestPartial(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	mockProductRepository := mocks.NewMockProductRepository(ctrl)
+	productA := &core.Product{ID: *guid.New(), Fnrec: "A"}
+	archived := &core.Product{ID: *guid.New(), Fnrec: "B", IsArchive: true}
+	mockProductRepository.EXPECT().GetByFnrecs(ctx, []string{"A", "B"}).
+		Return(map[string]*core.Product{"A": productA, "B": archived}, nil)
+	mockProductRepository.EXPECT().GetByIntegrationIDs(ctx, []string{"int-X"}).
+		Return(map[string]*core.Product{}, nil)
+	successor := &core.Product{ID: *guid.New(), Fnrec: "C"}
+	mockSuccessorRepository := mocks.NewMockSuccessorRepository(ctrl)
+	mockSuccessorRepository.EXPECT().GetByProductIDs(ctx, []guid.Guid{archived.ID}).
+		Return(map[guid.Guid][]*core.Successor{
+			archived.ID: {{ProductID: archived.ID, SuccessorID: successor.ID, Rank: 1, Successor: successor}},
+		}, nil)
+	sut := NewProductService(mockProductRepository, nil, nil, NewReplacementService(mockSuccessorRepository))
+
+	items, err := sut.BatchRequestPartial(ctx, []*ProductRequest{
+		{Fnrec: "A"},
+		{IntegrationID: "int-X"},
+		{Fnrec: "B"},
+	}, DuplicateReject)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(items))
+	assert.Equal(t, productA, items[0].Product)
+	assert.Nil(t, items[0].Err)
+	assert.Nil(t, items[1].Product)
+	assert.Equal(t, 6, items[1].Err.Code)
+	assert.Equal(t, &daltyerrors.EntityError{ID: "int-X", EntityName: "product", Field: "integration_id"},
+		items[1].Err.EntityErrors[0])
+	assert.Equal(t, 5, items[2].Err.Code)
+	assert.Equal(t, archived.ID.String(), items[2].Err.EntityErrors[0].ID)
+	assert.Equal(t, []string{successor.ID.String()}, items[2].Err.EntityErrors[0].Replacements)
+}
@@ -0,0 +1,170 @@
+package usecase
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/DimKa163/dalty/internal/product/core"
+)
+
+// QualityIssue is one problem found on one product.
+type QualityIssue struct {
+	Category      core.IssueCategory `json:"category"`
+	ProductID     string             `json:"product_id"`
+	Fnrec         string             `json:"fnrec"`
+	IntegrationID string             `json:"integration_id"`
+	Name          string             `json:"name"`
+	Detail        string             `json:"detail"`
+}
+
+// QualityReport lists issues grouped by category in core.IssueCategories
+// order, products within a category in id order.
+type QualityReport struct {
+	Scanned int                        `json:"scanned"`
+	Counts  map[core.IssueCategory]int `json:"counts"`
+	Issues  []*QualityIssue            `json:"issues"`
+}
+
+type QualityService struct {
+	qualityRepository core.QualityRepository
+}
+
+func NewQualityService(qualityRepository core.QualityRepository) *QualityService {
+	return &QualityService{qualityRepository: qualityRepository}
+}
+
+// Report scans every active product for the given categories, all of them
+// when none are given.
+func (qs *QualityService) Report(ctx context.Context, categories []core.IssueCategory) (*QualityReport, error) {
+	if len(categories) == 0 {
+		categories = core.IssueCategories
+	}
+	wanted := make(map[core.IssueCategory]bool, len(categories))
+	for _, category := range categories {
+		wanted[category] = true
+	}
+	buckets := make(map[core.IssueCategory][]*QualityIssue, len(categories))
+	report := &QualityReport{Counts: make(map[core.IssueCategory]int, len(categories))}
+	err := qs.qualityRepository.Walk(ctx, func(product *core.ScannedProduct) error {
+		report.Scanned++
+		for _, issue := range inspect(product) {
+			if wanted[issue.Category] {
+				buckets[issue.Category] = append(buckets[issue.Category], issue)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	report.Issues = make([]*QualityIssue, 0)
+	for _, category := range core.IssueCategories {
+		if !wanted[category] {
+			continue
+		}
+		report.Counts[category] = len(buckets[category])
+		report.Issues = append(report.Issues, buckets[category]...)
+	}
+	return report, nil
+}
+
+// inspect returns every issue of a product. Services are not shipped nor
+// produced, so only their group and relations are checked. Products with
+// relations ship as their material assets and may have no pack of their own.
+func inspect(product *core.ScannedProduct) []*QualityIssue {
+	issues := make([]*QualityIssue, 0)
+	if !product.IsService && product.Relations == 0 {
+		if zero := zeroDimensions(product.Product); len(zero) > 0 {
+			issues = append(issues, newQualityIssue(core.IssueZeroDimensions, product.Product, strings.Join(zero, ", ")))
+		}
+	}
+	if !product.IsService && product.ProductionType != core.ProductionTypeProducing &&
+		product.ProductionType != core.ProductionTypePurchasing {
+		issues = append(issues, newQualityIssue(core.IssueUnknownProductionType, product.Product, string(product.ProductionType)))
+	}
+	if product.Group == "" {
+		issues = append(issues, newQualityIssue(core.IssueNoGroup, product.Product, ""))
+	}
+	if product.CountMa != product.Relations {
+		issues = append(issues, newQualityIssue(core.IssueCountMaMismatch, product.Product,
+			fmt.Sprintf("count_ma %d, relations %d", product.CountMa, product.Relations)))
+	}
+	return issues
+}
+
+func zeroDimensions(product *core.Product) []string {
+	zero := make([]string, 0)
+	for _, dimension := range []struct {
+		name  string
+		value float64
+	}{
+		{"volume", product.Volume},
+		{"length", product.Length},
+		{"width", product.Width},
+		{"height", product.Height},
+		{"weight", product.Weight},
+	} {
+		if dimension.value <= 0 {
+			zero = append(zero, dimension.name)
+		}
+	}
+	return zero
+}
+
+func newQualityIssue(category core.IssueCategory, product *core.Product, detail string) *QualityIssue {
+	return &QualityIssue{
+		Category:      category,
+		ProductID:     product.ID.String(),
+		Fnrec:         product.Fnrec,
+		IntegrationID: product.IntegrationID,
+		Name:          product.Name,
+		Detail:        detail,
+	}
+}
+
+// ParseIssueCategories reads category names such as "no_group".
+func ParseIssueCategories(values []string) ([]core.IssueCategory, error) {
+	categories := make([]core.IssueCategory, 0, len(values))
+	for _, value := range values {
+		category := core.IssueCategory(strings.TrimSpace(value))
+		known := false
+		for _, c := range core.IssueCategories {
+			if c == category {
+				known = true
+				break
+			}
+		}
+		if !known {
+			return nil, fmt.Errorf("unknown issue category %q", value)
+		}
+		categories = append(categories, category)
+	}
+	return categories, nil
+}
+
+// WriteJSON writes the whole report as one JSON document.
+func (r *QualityReport) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(r)
+}
+
+// WriteCSV writes one row per issue after a header row.
+func (r *QualityReport) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write([]string{"category", "product_id", "fnrec", "integration_id", "name", "detail"}); err != nil {
+		return err
+	}
+	for _, issue := range r.Issues {
+		if err := writer.Write([]string{string(issue.Category), issue.ProductID, issue.Fnrec,
+			issue.IntegrationID, issue.Name, issue.Detail}); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
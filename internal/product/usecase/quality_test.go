@@ -0,0 +1,92 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"github.com/DimKa163/dalty/internal/product/core"
+	"github.com/DimKa163/dalty/internal/product/mocks"
+	"github.com/DimKa163/dalty/pkg/daltymodel"
+	"github.com/beevik/guid"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func walkOver(products ...*core.ScannedProduct) func(context.Context, func(*core.ScannedProduct) error) error {
+	return func(_ context.Context, fn func(*core.ScannedProduct) error) error {
+		for _, product := range products {
+			if err := fn(product); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+func TestQualityReport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	healthy := &core.Product{ID: *guid.New(), Fnrec: "OK", Group: daltymodel.ProductGroupBeds,
+		ProductionType: core.ProductionTypeProducing, Volume: 1, Length: 1, Width: 1, Height: 1, Weight: 1}
+	flat := &core.Product{ID: *guid.New(), Fnrec: "FLAT", Group: daltymodel.ProductGroupBeds,
+		ProductionType: core.ProductionTypePurchasing, Volume: 1, Length: 1, Width: 1}
+	kit := &core.Product{ID: *guid.New(), Fnrec: "KIT", Group: daltymodel.ProductGroupSets,
+		ProductionType: core.ProductionTypeProducing, CountMa: 3}
+	service := &core.Product{ID: *guid.New(), Fnrec: "SRV", IsService: true}
+	mockQualityRepository := mocks.NewMockQualityRepository(ctrl)
+	mockQualityRepository.EXPECT().Walk(ctx, gomock.Any()).DoAndReturn(walkOver(
+		&core.ScannedProduct{Product: healthy},
+		&core.ScannedProduct{Product: flat},
+		&core.ScannedProduct{Product: kit, Relations: 2},
+		&core.ScannedProduct{Product: service},
+	))
+	sut := NewQualityService(mockQualityRepository)
+
+	report, err := sut.Report(ctx, nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 4, report.Scanned)
+	assert.Equal(t, map[core.IssueCategory]int{
+		core.IssueZeroDimensions:        1,
+		core.IssueUnknownProductionType: 0,
+		core.IssueNoGroup:               1,
+		core.IssueCountMaMismatch:       1,
+	}, report.Counts)
+	assert.Equal(t, 3, len(report.Issues))
+	assert.Equal(t, "FLAT", report.Issues[0].Fnrec)
+	assert.Equal(t, "height, weight", report.Issues[0].Detail)
+	assert.Equal(t, "SRV", report.Issues[1].Fnrec)
+	assert.Equal(t, "KIT", report.Issues[2].Fnrec)
+	assert.Equal(t, "count_ma 3, relations 2", report.Issues[2].Detail)
+}
+
+func TestQualityReportCategories(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	product := &core.Product{ID: *guid.New(), Fnrec: "A", Name: "Bed, large"}
+	mockQualityRepository := mocks.NewMockQualityRepository(ctrl)
+	mockQualityRepository.EXPECT().Walk(ctx, gomock.Any()).DoAndReturn(walkOver(&core.ScannedProduct{Product: product}))
+	sut := NewQualityService(mockQualityRepository)
+
+	report, err := sut.Report(ctx, []core.IssueCategory{core.IssueUnknownProductionType})
+
+	assert.NoError(t, err)
+	assert.Equal(t, map[core.IssueCategory]int{core.IssueUnknownProductionType: 1}, report.Counts)
+	var out bytes.Buffer
+	assert.NoError(t, report.WriteCSV(&out))
+	assert.Equal(t, "category,product_id,fnrec,integration_id,name,detail\n"+
+		"unknown_production_type,"+product.ID.String()+",A,,\"Bed, large\",\n", out.String())
+}
+
+func TestParseIssueCategories(t *testing.T) {
+	categories, err := ParseIssueCategories([]string{"no_group", " zero_dimensions"})
+	assert.NoError(t, err)
+	assert.Equal(t, []core.IssueCategory{core.IssueNoGroup, core.IssueZeroDimensions}, categories)
+
+	_, err = ParseIssueCategories([]string{"typo"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,103 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/DimKa163/dalty/internal/logging"
+	"github.com/DimKa163/dalty/internal/product/core"
+	"github.com/DimKa163/dalty/pkg/daltyerrors"
+	"github.com/beevik/guid"
+	"go.uber.org/zap"
+)
+
+// maxReplacementDepth bounds how far a chain of archived successors is
+// followed.
+const maxReplacementDepth = 3
+
+type ReplacementService struct {
+	successorRepository core.SuccessorRepository
+}
+
+func NewReplacementService(successorRepository core.SuccessorRepository) *ReplacementService {
+	return &ReplacementService{successorRepository: successorRepository}
+}
+
+// Replacements returns active products that can be used instead of each of
+// the given ones, best first. An archived successor is replaced by its own
+// successors, up to maxReplacementDepth levels; each level is one query.
+func (rs *ReplacementService) Replacements(ctx context.Context, ids []guid.Guid) (map[guid.Guid][]*core.Product, error) {
+	result := make(map[guid.Guid][]*core.Product, len(ids))
+	if rs == nil || len(ids) == 0 {
+		return result, nil
+	}
+	// level holds the products to expand next, in the order their
+	// replacements should be listed; origins maps each of them to the
+	// requested products it stands in for.
+	level := make([]guid.Guid, 0, len(ids))
+	origins := make(map[guid.Guid][]guid.Guid, len(ids))
+	visited := make(map[guid.Guid]map[guid.Guid]bool, len(ids))
+	for _, id := range ids {
+		if _, ok := visited[id]; ok {
+			continue
+		}
+		visited[id] = map[guid.Guid]bool{id: true}
+		level = append(level, id)
+		origins[id] = []guid.Guid{id}
+	}
+	for depth := 0; depth < maxReplacementDepth && len(level) > 0; depth++ {
+		successors, err := rs.successorRepository.GetByProductIDs(ctx, level)
+		if err != nil {
+			return nil, err
+		}
+		next := make([]guid.Guid, 0)
+		nextOrigins := make(map[guid.Guid][]guid.Guid)
+		for _, id := range level {
+			for _, successor := range successors[id] {
+				if successor.Successor == nil {
+					continue
+				}
+				for _, origin := range origins[id] {
+					if visited[origin][successor.SuccessorID] {
+						continue
+					}
+					visited[origin][successor.SuccessorID] = true
+					if !successor.Successor.IsArchive {
+						result[origin] = append(result[origin], successor.Successor)
+						continue
+					}
+					if _, ok := nextOrigins[successor.SuccessorID]; !ok {
+						next = append(next, successor.SuccessorID)
+					}
+					nextOrigins[successor.SuccessorID] = append(nextOrigins[successor.SuccessorID], origin)
+				}
+			}
+		}
+		level, origins = next, nextOrigins
+	}
+	return result, nil
+}
+
+// Of returns the replacements of a single product.
+func (rs *ReplacementService) Of(ctx context.Context, id guid.Guid) ([]*core.Product, error) {
+	replacements, err := rs.Replacements(ctx, []guid.Guid{id})
+	if err != nil {
+		return nil, err
+	}
+	return replacements[id], nil
+}
+
+// archivedError reports an archived product together with the ids of its
+// replacements. Replacements are only a hint, when they cannot be looked up
+// the product is reported without them.
+func (rs *ReplacementService) archivedError(ctx context.Context, product *core.Product) error {
+	entErr := archivedError(product)
+	replacements, err := rs.Of(ctx, product.ID)
+	if err != nil {
+		logging.Logger(ctx).Error("error occurred when looking up replacements", zap.Error(err))
+		return daltyerrors.New(5, entErr)
+	}
+	for _, replacement := range replacements {
+		entErr.Replacements = append(entErr.Replacements, replacement.ID.String())
+	}
+	return daltyerrors.New(5, entErr)
+}
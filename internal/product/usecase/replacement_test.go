@@ -0,0 +1,158 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"github.com/DimKa163/dalty/internal/logging"
+	"github.com/DimKa163/dalty/internal/product/core"
+	"github.com/DimKa163/dalty/internal/product/mocks"
+	"github.com/DimKa163/dalty/pkg/daltyerrors"
+	"github.com/beevik/guid"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func successorOf(product *core.Product, successor *core.Product, rank int32) *core.Successor {
+	return &core.Successor{ProductID: product.ID, SuccessorID: successor.ID, Rank: rank, Successor: successor}
+}
+
+func TestReplacementsFollowArchivedSuccessors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	old := &core.Product{ID: *guid.New(), IsArchive: true}
+	retired := &core.Product{ID: *guid.New(), IsArchive: true}
+	current := &core.Product{ID: *guid.New()}
+	newest := &core.Product{ID: *guid.New()}
+	mockSuccessorRepository := mocks.NewMockSuccessorRepository(ctrl)
+	mockSuccessorRepository.EXPECT().GetByProductIDs(ctx, []guid.Guid{old.ID}).
+		Return(map[guid.Guid][]*core.Successor{
+			old.ID: {successorOf(old, retired, 1), successorOf(old, current, 2)},
+		}, nil)
+	mockSuccessorRepository.EXPECT().GetByProductIDs(ctx, []guid.Guid{retired.ID}).
+		Return(map[guid.Guid][]*core.Successor{
+			retired.ID: {successorOf(retired, newest, 1), successorOf(retired, old, 2)},
+		}, nil)
+	sut := NewReplacementService(mockSuccessorRepository)
+
+	replacements, err := sut.Of(ctx, old.ID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []*core.Product{current, newest}, replacements)
+}
+
+func TestReplacementsStopAtMaxDepth(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	chain := make([]*core.Product, maxReplacementDepth+2)
+	for i := range chain {
+		chain[i] = &core.Product{ID: *guid.New(), IsArchive: i < len(chain)-1}
+	}
+	mockSuccessorRepository := mocks.NewMockSuccessorRepository(ctrl)
+	for i := 0; i < maxReplacementDepth; i++ {
+		mockSuccessorRepository.EXPECT().GetByProductIDs(ctx, []guid.Guid{chain[i].ID}).
+			Return(map[guid.Guid][]*core.Successor{
+				chain[i].ID: {successorOf(chain[i], chain[i+1], 1)},
+			}, nil)
+	}
+	sut := NewReplacementService(mockSuccessorRepository)
+
+	replacements, err := sut.Of(ctx, chain[0].ID)
+
+	assert.NoError(t, err)
+	assert.Empty(t, replacements)
+}
+
+func TestReplacementsShareSuccessor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	productA := &core.Product{ID: *guid.New(), IsArchive: true}
+	productB := &core.Product{ID: *guid.New(), IsArchive: true}
+	shared := &core.Product{ID: *guid.New()}
+	mockSuccessorRepository := mocks.NewMockSuccessorRepository(ctrl)
+	mockSuccessorRepository.EXPECT().GetByProductIDs(ctx, []guid.Guid{productA.ID, productB.ID}).
+		Return(map[guid.Guid][]*core.Successor{
+			productA.ID: {successorOf(productA, shared, 1)},
+			productB.ID: {successorOf(productB, shared, 1)},
+		}, nil)
+	sut := NewReplacementService(mockSuccessorRepository)
+
+	replacements, err := sut.Replacements(ctx, []guid.Guid{productA.ID, productB.ID})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []*core.Product{shared}, replacements[productA.ID])
+	assert.Equal(t, []*core.Product{shared}, replacements[productB.ID])
+}
+
+func TestFindArchivedSuggestsReplacements(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	archived := &core.Product{ID: *guid.New(), Fnrec: "A", IsArchive: true}
+	successor := &core.Product{ID: *guid.New()}
+	mockProductRepository := mocks.NewMockProductRepository(ctrl)
+	mockProductRepository.EXPECT().GetByFnrec(ctx, "A").Return(archived, nil)
+	mockSuccessorRepository := mocks.NewMockSuccessorRepository(ctrl)
+	mockSuccessorRepository.EXPECT().GetByProductIDs(ctx, []guid.Guid{archived.ID}).
+		Return(map[guid.Guid][]*core.Successor{
+			archived.ID: {successorOf(archived, successor, 1)},
+		}, nil)
+	sut := NewProductService(mockProductRepository, nil, nil, NewReplacementService(mockSuccessorRepository))
+
+	_, err := sut.Find(ctx, &ProductRequest{Fnrec: "A"})
+
+	var daltyErr *daltyerrors.DaltyError
+	assert.ErrorAs(t, err, &daltyErr)
+	assert.Equal(t, 5, daltyErr.Code)
+	assert.Equal(t, []string{successor.ID.String()}, daltyErr.EntityErrors[0].Replacements)
+}
+
+func TestFindArchivedWithoutReplacementsOnLookupError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+	assert.NoError(t, logging.InitializeLogging(&logging.LogConfiguration{}))
+
+	archived := &core.Product{ID: *guid.New(), Fnrec: "A", IsArchive: true}
+	mockProductRepository := mocks.NewMockProductRepository(ctrl)
+	mockProductRepository.EXPECT().GetByFnrec(ctx, "A").Return(archived, nil)
+	mockSuccessorRepository := mocks.NewMockSuccessorRepository(ctrl)
+	mockSuccessorRepository.EXPECT().GetByProductIDs(ctx, []guid.Guid{archived.ID}).Return(nil, errors.New("connection reset"))
+	sut := NewProductService(mockProductRepository, nil, nil, NewReplacementService(mockSuccessorRepository))
+
+	_, err := sut.Find(ctx, &ProductRequest{Fnrec: "A"})
+
+	var daltyErr *daltyerrors.DaltyError
+	assert.ErrorAs(t, err, &daltyErr)
+	assert.Equal(t, 5, daltyErr.Code)
+	assert.Empty(t, daltyErr.EntityErrors[0].Replacements)
+}
+
+func TestBatchRequestPartialWithoutReplacementsOnLookupError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+	assert.NoError(t, logging.InitializeLogging(&logging.LogConfiguration{}))
+
+	archived := &core.Product{ID: *guid.New(), Fnrec: "A", IsArchive: true}
+	active := &core.Product{ID: *guid.New(), Fnrec: "B"}
+	mockProductRepository := mocks.NewMockProductRepository(ctrl)
+	mockProductRepository.EXPECT().GetByFnrecs(ctx, []string{"A", "B"}).Return(map[string]*core.Product{"A": archived, "B": active}, nil)
+	mockSuccessorRepository := mocks.NewMockSuccessorRepository(ctrl)
+	mockSuccessorRepository.EXPECT().GetByProductIDs(ctx, []guid.Guid{archived.ID}).Return(nil, errors.New("connection reset"))
+	sut := NewProductService(mockProductRepository, nil, nil, NewReplacementService(mockSuccessorRepository))
+
+	items, err := sut.BatchRequestPartial(ctx, []*ProductRequest{{Fnrec: "A"}, {Fnrec: "B"}}, DuplicateReject)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 5, items[0].Err.Code)
+	assert.Empty(t, items[0].Err.EntityErrors[0].Replacements)
+	assert.Same(t, active, items[1].Product)
+}
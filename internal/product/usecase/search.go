@@ -0,0 +1,141 @@
+package usecase
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"github.com/DimKa163/dalty/internal/product/core"
+	"github.com/DimKa163/dalty/pkg/trigram"
+	"github.com/beevik/guid"
+	"math"
+	"strings"
+)
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 500
+	// defaultMinScore lets a name through when its best matching part
+	// shares about half of the trigrams with the query, enough for a typo
+	// in a short word.
+	defaultMinScore = 0.4
+)
+
+var ErrInvalidCursor = errors.New("invalid search cursor")
+
+var ErrEmptyNameQuery = errors.New("name query has no letters or digits")
+
+// SearchPage is one page of search results. NextCursor is empty on the last
+// page.
+type SearchPage struct {
+	Products   []*core.Product
+	NextCursor string
+}
+
+type searchCursor struct {
+	Name string `json:"n"`
+	ID   string `json:"i"`
+}
+
+// Search returns the page of products matching filter that follows cursor.
+// An empty cursor starts from the beginning.
+func (ps *ProductService) Search(ctx context.Context, filter *core.SearchFilter, cursor string, pageSize int) (*SearchPage, error) {
+	after, err := decodeCursor(cursor)
+	if err != nil {
+		return nil, err
+	}
+	if filter, err = ps.expandFilter(ctx, filter); err != nil {
+		return nil, err
+	}
+	pageSize = boundPageSize(pageSize)
+	// one extra row tells whether there is a next page
+	products, err := ps.productRepository.Search(ctx, &core.SearchQuery{
+		Filter: filter,
+		After:  after,
+		Limit:  pageSize + 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	page := &SearchPage{Products: products}
+	if len(products) > pageSize {
+		page.Products = products[:pageSize]
+		last := page.Products[pageSize-1]
+		page.NextCursor = encodeCursor(&core.SearchCursor{Name: last.Name, ID: last.ID})
+	}
+	return page, nil
+}
+
+// SearchByName ranks the products matching filter by how well their name
+// matches text, which may be partial or misspelled. Products scoring below
+// minScore are left out, zero or less means defaultMinScore.
+func (ps *ProductService) SearchByName(ctx context.Context, text string, filter *core.SearchFilter,
+	minScore float64, limit int) ([]*core.ScoredProduct, error) {
+	if len(trigram.Trigrams(text)) == 0 {
+		return nil, ErrEmptyNameQuery
+	}
+	filter, err := ps.expandFilter(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	if minScore <= 0 {
+		minScore = defaultMinScore
+	}
+	return ps.nameSearchRepository.SearchByName(ctx, &core.NameSearchQuery{
+		Text:     strings.TrimSpace(text),
+		Filter:   filter,
+		MinScore: math.Min(minScore, 1),
+		Limit:    boundPageSize(limit),
+	})
+}
+
+// expandFilter returns a copy of filter that lists the subtree of its group
+// when subgroups are included.
+func (ps *ProductService) expandFilter(ctx context.Context, filter *core.SearchFilter) (*core.SearchFilter, error) {
+	if filter == nil || !filter.IncludeSubgroups || filter.Group == "" {
+		return filter, nil
+	}
+	if ps.taxonomyService == nil {
+		return nil, ErrTaxonomyNotLoaded
+	}
+	expanded := *filter
+	var err error
+	if expanded.Groups, err = ps.taxonomyService.Subtree(ctx, filter.Group); err != nil {
+		return nil, err
+	}
+	return &expanded, nil
+}
+
+func boundPageSize(pageSize int) int {
+	if pageSize <= 0 {
+		return defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		return maxPageSize
+	}
+	return pageSize
+}
+
+func encodeCursor(cursor *core.SearchCursor) string {
+	data, _ := json.Marshal(&searchCursor{Name: cursor.Name, ID: cursor.ID.String()})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeCursor(value string) (*core.SearchCursor, error) {
+	if value == "" {
+		return nil, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	var cursor searchCursor
+	if err = json.Unmarshal(data, &cursor); err != nil {
+		return nil, ErrInvalidCursor
+	}
+	id, err := guid.ParseString(cursor.ID)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	return &core.SearchCursor{Name: cursor.Name, ID: *id}, nil
+}
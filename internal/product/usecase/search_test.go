@@ -0,0 +1,251 @@
+package usecase
+
+import (
+	"context"
+	"github.com/DimKa163/dalty/internal/product/core"
+	"github.com/DimKa163/dalty/internal/product/mocks"
+	"github.com/DimKa163/dalty/pkg/daltyerrors"
+	"github.com/DimKa163/dalty/pkg/daltymodel"
+	"github.com/DimKa163/dalty/pkg/trigram"
+	"github.com/beevik/guid"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestSearchPaging(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	mockProductRepository := mocks.NewMockProductRepository(ctrl)
+	first := &core.Product{ID: *guid.New(), Name: "A"}
+	second := &core.Product{ID: *guid.New(), Name: "B"}
+	third := &core.Product{ID: *guid.New(), Name: "C"}
+	filter := &core.SearchFilter{Name: "a", NamePrefix: true}
+	mockProductRepository.EXPECT().Search(ctx, &core.SearchQuery{Filter: filter, Limit: 3}).
+		Return([]*core.Product{first, second, third}, nil)
+	mockProductRepository.EXPECT().Search(ctx, &core.SearchQuery{
+		Filter: filter,
+		After:  &core.SearchCursor{Name: second.Name, ID: second.ID},
+		Limit:  3,
+	}).Return([]*core.Product{third}, nil)
+	sut := NewProductService(mockProductRepository, nil, nil, nil)
+
+	page, err := sut.Search(ctx, filter, "", 2)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []*core.Product{first, second}, page.Products)
+	assert.NotEmpty(t, page.NextCursor)
+
+	page, err = sut.Search(ctx, filter, page.NextCursor, 2)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []*core.Product{third}, page.Products)
+	assert.Empty(t, page.NextCursor)
+}
+
+func TestSearchPageSizeBounds(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	mockProductRepository := mocks.NewMockProductRepository(ctrl)
+	mockProductRepository.EXPECT().Search(ctx, &core.SearchQuery{Limit: defaultPageSize + 1}).Return(nil, nil)
+	mockProductRepository.EXPECT().Search(ctx, &core.SearchQuery{Limit: maxPageSize + 1}).Return(nil, nil)
+	sut := NewProductService(mockProductRepository, nil, nil, nil)
+
+	_, err := sut.Search(ctx, nil, "", 0)
+	assert.NoError(t, err)
+	_, err = sut.Search(ctx, nil, "", maxPageSize*2)
+	assert.NoError(t, err)
+}
+
+func TestSearchInvalidCursor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sut := NewProductService(mocks.NewMockProductRepository(ctrl), nil, nil, nil)
+
+	_, err := sut.Search(context.Background(), nil, "not a cursor", 10)
+
+	assert.ErrorIs(t, err, ErrInvalidCursor)
+}
+
+func TestSearchIncludeSubgroups(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	mockTaxonomyRepository := mocks.NewMockTaxonomyRepository(ctrl)
+	mockTaxonomyRepository.EXPECT().GetGroups(ctx).Return([]*core.GroupNode{
+		{ID: daltymodel.ProductGroupBeds, Name: "Beds"},
+		{ID: daltymodel.ProductGroupBedAccessories, ParentID: daltymodel.ProductGroupBeds, Name: "Bed accessories"},
+	}, nil)
+	taxonomyService := NewTaxonomyService(mockTaxonomyRepository)
+	assert.NoError(t, taxonomyService.Update(ctx))
+	mockProductRepository := mocks.NewMockProductRepository(ctrl)
+	filter := &core.SearchFilter{Group: daltymodel.ProductGroupBeds, IncludeSubgroups: true}
+	mockProductRepository.EXPECT().Search(ctx, &core.SearchQuery{
+		Filter: &core.SearchFilter{
+			Group:            daltymodel.ProductGroupBeds,
+			IncludeSubgroups: true,
+			Groups:           []daltymodel.ProductGroup{daltymodel.ProductGroupBeds, daltymodel.ProductGroupBedAccessories},
+		},
+		Limit: defaultPageSize + 1,
+	}).Return(nil, nil)
+	sut := NewProductService(mockProductRepository, nil, taxonomyService, nil)
+
+	_, err := sut.Search(ctx, filter, "", 0)
+
+	assert.NoError(t, err)
+	assert.Empty(t, filter.Groups)
+}
+
+func TestSearchUnknownGroup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	mockTaxonomyRepository := mocks.NewMockTaxonomyRepository(ctrl)
+	mockTaxonomyRepository.EXPECT().GetGroups(ctx).Return([]*core.GroupNode{}, nil)
+	taxonomyService := NewTaxonomyService(mockTaxonomyRepository)
+	assert.NoError(t, taxonomyService.Update(ctx))
+	sut := NewProductService(mocks.NewMockProductRepository(ctrl), nil, taxonomyService, nil)
+
+	_, err := sut.Search(ctx, &core.SearchFilter{Group: daltymodel.ProductGroupBeds, IncludeSubgroups: true}, "", 0)
+
+	var daltyErr *daltyerrors.DaltyError
+	assert.ErrorAs(t, err, &daltyErr)
+	assert.Equal(t, 6, daltyErr.Code)
+}
+
+func TestSearchByNameRanksMisspelledNames(t *testing.T) {
+	ctx := context.Background()
+
+	mattress := &core.Product{ID: *guid.New(), Name: "Матрас Ортопед Комфорт", Group: daltymodel.ProductGroupMattresses}
+	topper := &core.Product{ID: *guid.New(), Name: "Топпер Матрас Лайт", Group: daltymodel.ProductGroupMattressToppers}
+	pillow := &core.Product{ID: *guid.New(), Name: "Подушка Комфорт", Group: daltymodel.ProductGroupPillows}
+	archived := &core.Product{ID: *guid.New(), Name: "Матрас Ортопед", Group: daltymodel.ProductGroupMattresses, IsArchive: true}
+	repository := newMemoryNameSearchRepository([]*core.Product{pillow, topper, archived, mattress})
+	sut := NewProductService(nil, repository, nil, nil)
+	isArchive := false
+
+	found, err := sut.SearchByName(ctx, "матрац", &core.SearchFilter{IsArchive: &isArchive}, 0, 0)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(found))
+	assert.Equal(t, mattress, found[0].Product)
+	assert.Equal(t, topper, found[1].Product)
+
+	found, err = sut.SearchByName(ctx, "матрац ортапед", &core.SearchFilter{IsArchive: &isArchive}, 0.3, 0)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(found))
+	assert.Equal(t, mattress, found[0].Product)
+	assert.Greater(t, found[0].Score, found[1].Score)
+
+	found, err = sut.SearchByName(ctx, "матрац", &core.SearchFilter{Group: daltymodel.ProductGroupMattressToppers}, 0, 0)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(found))
+	assert.Equal(t, topper, found[0].Product)
+}
+
+func TestSearchByNameQuery(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	mockNameSearchRepository := mocks.NewMockNameSearchRepository(ctrl)
+	mockNameSearchRepository.EXPECT().SearchByName(ctx, &core.NameSearchQuery{
+		Text:     "bed",
+		MinScore: 1,
+		Limit:    maxPageSize,
+	}).Return(nil, nil)
+	sut := NewProductService(nil, mockNameSearchRepository, nil, nil)
+
+	_, err := sut.SearchByName(ctx, " bed ", nil, 2, maxPageSize*2)
+	assert.NoError(t, err)
+
+	_, err = sut.SearchByName(ctx, " - ", nil, 0, 0)
+	assert.ErrorIs(t, err, ErrEmptyNameQuery)
+}
+
+// memoryNameSearchRepository scores a fixed set of products in memory the way
+// persistence.SearchByNameStmt scores the table.
+type memoryNameSearchRepository struct {
+	products []*core.Product
+}
+
+func newMemoryNameSearchRepository(products []*core.Product) *memoryNameSearchRepository {
+	return &memoryNameSearchRepository{products: products}
+}
+
+func (r *memoryNameSearchRepository) SearchByName(_ context.Context, query *core.NameSearchQuery) ([]*core.ScoredProduct, error) {
+	products := make([]*core.ScoredProduct, 0)
+	for _, product := range r.products {
+		if !matchesFilter(query.Filter, product) {
+			continue
+		}
+		score := trigram.WordSimilarity(query.Text, product.Name)
+		if score < query.MinScore {
+			continue
+		}
+		products = append(products, &core.ScoredProduct{Product: product, Score: score})
+	}
+	sort.SliceStable(products, func(i, j int) bool {
+		a, b := products[i], products[j]
+		if a.Score != b.Score {
+			return a.Score > b.Score
+		}
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		return a.ID.String() < b.ID.String()
+	})
+	if len(products) > query.Limit {
+		products = products[:query.Limit]
+	}
+	return products, nil
+}
+
+// matchesFilter tells whether product passes filter the way the database
+// applies it. A nil filter passes everything.
+func matchesFilter(f *core.SearchFilter, product *core.Product) bool {
+	if f == nil {
+		return true
+	}
+	if len(f.Groups) > 0 {
+		found := false
+		for _, group := range f.Groups {
+			if group == product.Group {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	} else if f.Group != "" && f.Group != product.Group {
+		return false
+	}
+	switch {
+	case f.SeriesID != "" && f.SeriesID != product.SeriesID,
+		f.CategoryID != "" && f.CategoryID != product.CategoryID,
+		f.ProductionType != "" && f.ProductionType != product.ProductionType,
+		f.IsService != nil && *f.IsService != product.IsService,
+		f.IsArchive != nil && *f.IsArchive != product.IsArchive:
+		return false
+	}
+	if f.Name != "" {
+		name, part := strings.ToLower(product.Name), strings.ToLower(f.Name)
+		if f.NamePrefix {
+			return strings.HasPrefix(name, part)
+		}
+		return strings.Contains(name, part)
+	}
+	return true
+}
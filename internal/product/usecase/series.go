@@ -0,0 +1,90 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/DimKa163/dalty/internal/product/core"
+	"github.com/DimKa163/dalty/pkg/daltyerrors"
+	"github.com/beevik/guid"
+)
+
+// SeriesMember is a sku of a series with the material assets it ships in.
+// A sku without assets ships as itself.
+type SeriesMember struct {
+	Product *core.Product
+	Assets  []*core.Relation
+}
+
+// SeriesSummary totals the packages of every sku in a series.
+type SeriesSummary struct {
+	SeriesID string
+	Products int
+	Packages int32
+	Volume   float64
+	Weight   float64
+}
+
+type SeriesService struct {
+	productRepository  core.ProductRepository
+	relationRepository core.RelationRepository
+}
+
+func NewSeriesService(productRepository core.ProductRepository, relationRepository core.RelationRepository) *SeriesService {
+	return &SeriesService{
+		productRepository:  productRepository,
+		relationRepository: relationRepository,
+	}
+}
+
+// Products lists the skus of the series that are not archived, with their
+// material assets.
+func (ss *SeriesService) Products(ctx context.Context, seriesID string) ([]*SeriesMember, error) {
+	products, err := ss.productRepository.GetBySeriesID(ctx, seriesID)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]guid.Guid, 0, len(products))
+	members := make([]*SeriesMember, 0, len(products))
+	for _, product := range products {
+		if product.IsArchive {
+			continue
+		}
+		ids = append(ids, product.ID)
+		members = append(members, &SeriesMember{Product: product})
+	}
+	if len(members) == 0 {
+		return nil, daltyerrors.New(6, &daltyerrors.EntityError{ID: seriesID, EntityName: "series"})
+	}
+	relations, err := ss.relationRepository.GetByLeftIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, member := range members {
+		member.Assets = relations[member.Product.ID]
+	}
+	return members, nil
+}
+
+func (ss *SeriesService) Summary(ctx context.Context, seriesID string) (*SeriesSummary, error) {
+	members, err := ss.Products(ctx, seriesID)
+	if err != nil {
+		return nil, err
+	}
+	summary := &SeriesSummary{SeriesID: seriesID, Products: len(members)}
+	for _, member := range members {
+		if len(member.Assets) == 0 {
+			summary.add(member.Product, 1)
+			continue
+		}
+		for _, asset := range member.Assets {
+			summary.add(asset.Right, asset.Amount)
+		}
+	}
+	return summary, nil
+}
+
+func (s *SeriesSummary) add(product *core.Product, amount int32) {
+	s.Packages += amount
+	s.Volume += product.Volume * float64(amount)
+	s.Weight += product.Weight * float64(amount)
+}
@@ -0,0 +1,60 @@
+package usecase
+
+import (
+	"context"
+	"github.com/DimKa163/dalty/internal/product/core"
+	"github.com/DimKa163/dalty/internal/product/mocks"
+	"github.com/DimKa163/dalty/pkg/daltyerrors"
+	"github.com/beevik/guid"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestSeriesSummary(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	seriesID := guid.New().String()
+	bed := &core.Product{ID: *guid.New(), Name: "Bed", SeriesID: seriesID}
+	mattress := &core.Product{ID: *guid.New(), Name: "Mattress", SeriesID: seriesID, Volume: 0.5, Weight: 20}
+	archived := &core.Product{ID: *guid.New(), Name: "Old bed", SeriesID: seriesID, IsArchive: true}
+	frame := &core.Product{ID: *guid.New(), Volume: 0.2, Weight: 15}
+	slats := &core.Product{ID: *guid.New(), Volume: 0.1, Weight: 8}
+	assets := []*core.Relation{
+		{LeftID: bed.ID, RightID: frame.ID, Right: frame, Amount: 1},
+		{LeftID: bed.ID, RightID: slats.ID, Right: slats, Amount: 2},
+	}
+	mockProductRepository := mocks.NewMockProductRepository(ctrl)
+	mockProductRepository.EXPECT().GetBySeriesID(ctx, seriesID).Return([]*core.Product{bed, mattress, archived}, nil)
+	mockRelationRepository := mocks.NewMockRelationRepository(ctrl)
+	mockRelationRepository.EXPECT().GetByLeftIDs(ctx, []guid.Guid{bed.ID, mattress.ID}).
+		Return(map[guid.Guid][]*core.Relation{bed.ID: assets}, nil)
+	sut := NewSeriesService(mockProductRepository, mockRelationRepository)
+
+	summary, err := sut.Summary(ctx, seriesID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, summary.Products)
+	assert.Equal(t, int32(4), summary.Packages)
+	assert.InDelta(t, 0.9, summary.Volume, 1e-9)
+	assert.InDelta(t, 51.0, summary.Weight, 1e-9)
+}
+
+func TestSeriesNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	seriesID := guid.New().String()
+	mockProductRepository := mocks.NewMockProductRepository(ctrl)
+	mockProductRepository.EXPECT().GetBySeriesID(ctx, seriesID).Return([]*core.Product{}, nil)
+	sut := NewSeriesService(mockProductRepository, mocks.NewMockRelationRepository(ctrl))
+
+	_, err := sut.Products(ctx, seriesID)
+
+	var daltyErr *daltyerrors.DaltyError
+	assert.ErrorAs(t, err, &daltyErr)
+	assert.Equal(t, 6, daltyErr.Code)
+}
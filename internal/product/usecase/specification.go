@@ -17,7 +17,8 @@ type (
 		Quantity      int32  `json:"quantity"`
 	}
 	SpecRequest struct {
-		Specs []*Spec `json:"specs"`
+		Specs      []*Spec       `json:"specs"`
+		Duplicates DuplicateMode `json:"duplicates"`
 	}
 	SpecResponse struct {
 		Specifications []*daltymodel.Specification `json:"specifications"`
@@ -27,13 +28,24 @@ type (
 		Quantity int32 `json:"quantity"`
 	}
 )
+
+// entityError names the identifier the line was given by.
+func (s *Spec) entityError() *daltyerrors.EntityError {
+	if s.IntegrationID != "" {
+		return &daltyerrors.EntityError{ID: s.IntegrationID, EntityName: "product", Field: "integration_id"}
+	}
+	return &daltyerrors.EntityError{ID: s.Fnrec, EntityName: "product", Field: "fnrec"}
+}
+
 type SpecificationService struct {
 	productRepository  core.ProductRepository
 	relationRepository core.RelationRepository
+	replacementService *ReplacementService
 }
 
-func NewSpecificationService(productRepository core.ProductRepository, relationRepository core.RelationRepository) *SpecificationService {
-	return &SpecificationService{productRepository, relationRepository}
+func NewSpecificationService(productRepository core.ProductRepository, relationRepository core.RelationRepository,
+	replacementService *ReplacementService) *SpecificationService {
+	return &SpecificationService{productRepository, relationRepository, replacementService}
 }
 
 func (ss *SpecificationService) Execute(ctx context.Context, request *SpecRequest) ([]*daltymodel.Specification, error) {
@@ -41,6 +53,10 @@ func (ss *SpecificationService) Execute(ctx context.Context, request *SpecReques
 	if err != nil {
 		return nil, err
 	}
+	prdSpecs, err = mergeSpecs(request.Specs, prdSpecs, request.Duplicates)
+	if err != nil {
+		return nil, err
+	}
 	specs := make([]*daltymodel.Specification, 0, len(prdSpecs))
 	reverseSpecs := make([]*ProductSpec, 0, len(prdSpecs))
 	for _, spec := range prdSpecs {
@@ -73,6 +89,7 @@ func (ss *SpecificationService) Execute(ctx context.Context, request *SpecReques
 		}
 		for j := i + 1; j < len(reverseSpecs); j++ {
 			right := reverseSpecs[j]
+			// accepted duplicates of a product do not pair with each other
 			if left.ID == right.ID {
 				continue
 			}
@@ -99,8 +116,8 @@ func (ss *SpecificationService) Execute(ctx context.Context, request *SpecReques
 				specs = append(specs,
 					daltymodel.NewReverseSpecification(daltymodel.NewLine(toDaltyProduct(l.Left), 1, daltymodel.PickupStrategyNearest),
 						daltymodel.PickupStrategyNearest, []*daltymodel.Line{
-							daltymodel.NewLine(toDaltyProduct(left.Product), left.Quantity, daltymodel.PickupStrategyNearest),
-							daltymodel.NewLine(toDaltyProduct(right.Product), right.Quantity, daltymodel.PickupStrategyNearest),
+							daltymodel.NewLine(toDaltyProduct(left.Product), l.Amount, daltymodel.PickupStrategyNearest),
+							daltymodel.NewLine(toDaltyProduct(right.Product), r.Amount, daltymodel.PickupStrategyNearest),
 						}))
 				left.Quantity = lq
 				right.Quantity = rq
@@ -166,7 +183,7 @@ func (ss *SpecificationService) getProductSpec(ctx context.Context, specs []*Spe
 			daltyErrs...,
 		)
 	}
-	if err := validateSpecs(products); err != nil {
+	if err := ss.validateSpecs(ctx, products); err != nil {
 		return nil, err
 	}
 	return products, nil
@@ -190,10 +207,10 @@ func toDaltyProduct(product *core.Product) *daltymodel.Product {
 	return &v
 }
 
-func validateSpecs(products []*ProductSpec) error {
+func (ss *SpecificationService) validateSpecs(ctx context.Context, products []*ProductSpec) error {
 	for _, product := range products {
 		if product.IsArchive {
-			return daltyerrors.New(5, &daltyerrors.EntityError{ID: product.ID.String(), EntityName: "product"})
+			return ss.replacementService.archivedError(ctx, product.Product)
 		}
 	}
 	return nil
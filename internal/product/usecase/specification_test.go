@@ -38,7 +38,11 @@ func TestExecuteDirectSpecification(t *testing.T) {
 					Group:         daltymodel.ProductGroupArmchairs,
 					IntegrationID: "11",
 					Fnrec:         "11",
+					Volume:        0.5,
+					Weight:        25,
+					Length:        1.2,
 				},
+				Amount: 1,
 			},
 			&core.Relation{
 				ID:      *guid.New(),
@@ -49,7 +53,11 @@ func TestExecuteDirectSpecification(t *testing.T) {
 					Group:         daltymodel.ProductGroupArmchairs,
 					IntegrationID: "12",
 					Fnrec:         "12",
+					Volume:        0.1,
+					Weight:        5,
+					Height:        0.6,
 				},
+				Amount: 2,
 			},
 		},
 	}
@@ -58,6 +66,7 @@ func TestExecuteDirectSpecification(t *testing.T) {
 	}
 	req.Specs[0] = &Spec{
 		IntegrationID: product.IntegrationID,
+		Quantity:      2,
 	}
 	mockProductRepository.EXPECT().GetByIntegrationID(ctx, product.IntegrationID).Return(product, nil)
 
@@ -65,12 +74,16 @@ func TestExecuteDirectSpecification(t *testing.T) {
 		mockRelateRepository.EXPECT().GetByLeftID(ctx, i).Return(r, nil)
 	}
 
-	sut := NewSpecificationService(mockProductRepository, mockRelateRepository)
+	sut := NewSpecificationService(mockProductRepository, mockRelateRepository, nil)
 
 	r, err := sut.Execute(ctx, req)
 
 	assert.NoError(t, err)
 	assert.NotEmpty(t, r, "")
+	assert.Equal(t, int32(6), r[0].Totals.Packages)
+	assert.InDelta(t, 1.4, r[0].Totals.Volume, 1e-9)
+	assert.InDelta(t, 70.0, r[0].Totals.Weight, 1e-9)
+	assert.Equal(t, 1.2, r[0].Totals.MaxDimension)
 }
 
 func TestExecuteReverseSpecification(t *testing.T) {
@@ -87,6 +100,11 @@ func TestExecuteReverseSpecification(t *testing.T) {
 		ProductionType: core.ProductionTypeProducing,
 		IntegrationID:  "1",
 		Fnrec:          "1",
+		Volume:         0.4,
+		Weight:         30,
+		Length:         2,
+		Width:          0.9,
+		Height:         0.2,
 	}
 	productB := &core.Product{
 		ID:             *guid.New(),
@@ -95,6 +113,11 @@ func TestExecuteReverseSpecification(t *testing.T) {
 		ProductionType: core.ProductionTypeProducing,
 		IntegrationID:  "2",
 		Fnrec:          "2",
+		Volume:         0.3,
+		Weight:         20,
+		Length:         1.9,
+		Width:          0.8,
+		Height:         0.3,
 	}
 	relation := map[guid.Guid][]*core.Relation{
 		productA.ID: make([]*core.Relation, 0),
@@ -117,26 +140,79 @@ func TestExecuteReverseSpecification(t *testing.T) {
 	for i, r := range relation {
 		mockRelateRepository.EXPECT().GetByLeftID(ctx, i).Return(r, nil)
 	}
-	left := *guid.New()
+	kit := &core.Product{
+		ID:             *guid.New(),
+		Name:           "Test kit",
+		Group:          daltymodel.ProductGroupSets,
+		ProductionType: core.ProductionTypeProducing,
+	}
 	mockRelateRepository.EXPECT().GetByRightID(ctx, productA.ID, productB.ID).Return(&core.Relation{
 		ID:      *guid.New(),
-		LeftID:  left,
+		LeftID:  kit.ID,
 		RightID: productA.ID,
 		Amount:  1,
+		Left:    kit,
 	}, &core.Relation{
 		ID:      *guid.New(),
-		LeftID:  left,
+		LeftID:  kit.ID,
 		RightID: productB.ID,
 		Amount:  1,
+		Left:    kit,
 	}, nil)
 
-	sut := NewSpecificationService(mockProductRepository, mockRelateRepository)
+	sut := NewSpecificationService(mockProductRepository, mockRelateRepository, nil)
 
 	r, err := sut.Execute(ctx, req)
 
 	assert.NoError(t, err)
 	assert.NotEmpty(t, r, "")
 	assert.Equal(t, 1, len(r))
+	assert.Equal(t, kit.ID, r[0].Product.Product.ID)
+	assert.Equal(t, int32(2), r[0].Totals.Packages)
+	assert.InDelta(t, 0.7, r[0].Totals.Volume, 1e-9)
+	assert.InDelta(t, 50.0, r[0].Totals.Weight, 1e-9)
+	assert.Equal(t, 2.0, r[0].Totals.MaxDimension)
+}
+
+func TestExecuteReverseSpecificationTotals(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	mockProductRepository := mocks.NewMockProductRepository(ctrl)
+	mockRelateRepository := mocks.NewMockRelationRepository(ctrl)
+	productA := &core.Product{ID: *guid.New(), IntegrationID: "1", Group: daltymodel.ProductGroupBeds,
+		ProductionType: core.ProductionTypeProducing, Volume: 0.4, Weight: 30, Length: 2, Width: 0.9, Height: 0.2}
+	productB := &core.Product{ID: *guid.New(), IntegrationID: "2", Group: daltymodel.ProductGroupBedBases,
+		ProductionType: core.ProductionTypeProducing, Volume: 0.3, Weight: 20, Length: 1.9, Width: 0.8, Height: 0.3}
+	kit := &core.Product{ID: *guid.New(), Group: daltymodel.ProductGroupSets, ProductionType: core.ProductionTypeProducing}
+	req := &SpecRequest{Specs: []*Spec{
+		{IntegrationID: productA.IntegrationID, Quantity: 2},
+		{IntegrationID: productB.IntegrationID, Quantity: 2},
+	}}
+	mockProductRepository.EXPECT().GetByIntegrationID(ctx, productA.IntegrationID).Return(productA, nil)
+	mockProductRepository.EXPECT().GetByIntegrationID(ctx, productB.IntegrationID).Return(productB, nil)
+	mockRelateRepository.EXPECT().GetByLeftID(ctx, productA.ID).Return(make([]*core.Relation, 0), nil)
+	mockRelateRepository.EXPECT().GetByLeftID(ctx, productB.ID).Return(make([]*core.Relation, 0), nil)
+	mockRelateRepository.EXPECT().GetByRightID(ctx, productA.ID, productB.ID).Return(
+		&core.Relation{ID: *guid.New(), LeftID: kit.ID, RightID: productA.ID, Amount: 1, Left: kit},
+		&core.Relation{ID: *guid.New(), LeftID: kit.ID, RightID: productB.ID, Amount: 1, Left: kit}, nil)
+
+	sut := NewSpecificationService(mockProductRepository, mockRelateRepository, nil)
+
+	r, err := sut.Execute(ctx, req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(r))
+	for _, spec := range r {
+		assert.Equal(t, kit.ID, spec.Product.Product.ID)
+		assert.Equal(t, int32(1), spec.ChildProducts[0].Quantity)
+		assert.Equal(t, int32(1), spec.ChildProducts[1].Quantity)
+	}
+	totals := daltymodel.TotalsOf(r)
+	assert.Equal(t, int32(4), totals.Packages)
+	assert.InDelta(t, 1.4, totals.Volume, 1e-9)
+	assert.InDelta(t, 100.0, totals.Weight, 1e-9)
 }
 
 func TestExecuteDefaultSpecification(t *testing.T) {
@@ -185,7 +261,7 @@ func TestExecuteDefaultSpecification(t *testing.T) {
 	}
 	mockRelateRepository.EXPECT().GetByRightID(ctx, productA.ID, productB.ID).Return(nil, nil, daltyerrors.ErrNotFound).Times(1)
 
-	sut := NewSpecificationService(mockProductRepository, mockRelateRepository)
+	sut := NewSpecificationService(mockProductRepository, mockRelateRepository, nil)
 
 	r, err := sut.Execute(ctx, req)
 
@@ -383,7 +459,7 @@ func TestExecuteCombineSpecification(t *testing.T) {
 	mockRelateRepository.EXPECT().GetByRightID(ctx, productE.ID, productG.ID).Return(nil, nil, daltyerrors.ErrNotFound).AnyTimes()
 
 	mockRelateRepository.EXPECT().GetByRightID(ctx, productF.ID, productG.ID).Return(nil, nil, daltyerrors.ErrNotFound).AnyTimes()
-	sut := NewSpecificationService(mockProductRepository, mockRelateRepository)
+	sut := NewSpecificationService(mockProductRepository, mockRelateRepository, nil)
 
 	r, err := sut.Execute(ctx, req)
 
@@ -404,3 +480,92 @@ func TestExecuteCombineSpecification(t *testing.T) {
 	assert.Equal(t, daltymodel.SpecificationTypeDefault, r[5].Type)
 	assert.Equal(t, 0, len(r[5].ChildProducts))
 }
+
+func TestExecuteAcceptsDuplicateLines(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	mockProductRepository := mocks.NewMockProductRepository(ctrl)
+	mockRelateRepository := mocks.NewMockRelationRepository(ctrl)
+	product := &core.Product{ID: *guid.New(), Name: "Test", IntegrationID: "1", Fnrec: "F1"}
+	mockProductRepository.EXPECT().GetByIntegrationID(ctx, product.IntegrationID).Return(product, nil)
+	mockProductRepository.EXPECT().GetByFnrec(ctx, product.Fnrec).Return(product, nil)
+	mockRelateRepository.EXPECT().GetByLeftID(ctx, product.ID).Return(make([]*core.Relation, 0), nil).Times(2)
+	req := &SpecRequest{
+		Specs: []*Spec{
+			{IntegrationID: product.IntegrationID, Quantity: 1},
+			{Fnrec: product.Fnrec, Quantity: 2},
+		},
+	}
+
+	sut := NewSpecificationService(mockProductRepository, mockRelateRepository, nil)
+
+	r, err := sut.Execute(ctx, req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(r))
+	for _, spec := range r {
+		assert.Equal(t, daltymodel.SpecificationTypeDefault, spec.Type)
+	}
+	assert.Equal(t, int32(1), r[0].Product.Quantity)
+	assert.Equal(t, int32(2), r[1].Product.Quantity)
+}
+
+func TestExecuteRejectsDuplicateLines(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	mockProductRepository := mocks.NewMockProductRepository(ctrl)
+	mockRelateRepository := mocks.NewMockRelationRepository(ctrl)
+	product := &core.Product{ID: *guid.New(), Name: "Test", IntegrationID: "1", Fnrec: "F1"}
+	mockProductRepository.EXPECT().GetByIntegrationID(ctx, product.IntegrationID).Return(product, nil)
+	mockProductRepository.EXPECT().GetByFnrec(ctx, product.Fnrec).Return(product, nil)
+	req := &SpecRequest{
+		Specs: []*Spec{
+			{IntegrationID: product.IntegrationID, Quantity: 1},
+			{Fnrec: product.Fnrec, Quantity: 2},
+		},
+		Duplicates: DuplicateReject,
+	}
+
+	sut := NewSpecificationService(mockProductRepository, mockRelateRepository, nil)
+
+	_, err := sut.Execute(ctx, req)
+
+	var daltyErr *daltyerrors.DaltyError
+	assert.ErrorAs(t, err, &daltyErr)
+	assert.Equal(t, 53, daltyErr.Code)
+	assert.Equal(t, []*daltyerrors.EntityError{{ID: "F1", EntityName: "product", Field: "fnrec"}},
+		daltyErr.EntityErrors)
+}
+
+func TestExecuteMergesDuplicateLines(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	mockProductRepository := mocks.NewMockProductRepository(ctrl)
+	mockRelateRepository := mocks.NewMockRelationRepository(ctrl)
+	product := &core.Product{ID: *guid.New(), Name: "Test", IntegrationID: "1", Fnrec: "F1"}
+	mockProductRepository.EXPECT().GetByIntegrationID(ctx, product.IntegrationID).Return(product, nil)
+	mockProductRepository.EXPECT().GetByFnrec(ctx, product.Fnrec).Return(product, nil)
+	mockRelateRepository.EXPECT().GetByLeftID(ctx, product.ID).Return([]*core.Relation{}, nil)
+	req := &SpecRequest{
+		Specs: []*Spec{
+			{IntegrationID: product.IntegrationID, Quantity: 1},
+			{Fnrec: product.Fnrec, Quantity: 2},
+		},
+		Duplicates: DuplicateMerge,
+	}
+
+	sut := NewSpecificationService(mockProductRepository, mockRelateRepository, nil)
+
+	r, err := sut.Execute(ctx, req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(r))
+	assert.Equal(t, daltymodel.SpecificationTypeDefault, r[0].Type)
+	assert.Equal(t, int32(3), r[0].Product.Quantity)
+}
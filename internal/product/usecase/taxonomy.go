@@ -0,0 +1,82 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+
+	"github.com/DimKa163/dalty/internal/product/core"
+	"github.com/DimKa163/dalty/pkg/daltyerrors"
+	"github.com/DimKa163/dalty/pkg/daltymodel"
+)
+
+var ErrTaxonomyNotLoaded = errors.New("product group taxonomy is not loaded")
+
+// TaxonomyService serves the product group tree loaded by Update.
+type TaxonomyService struct {
+	taxonomyRepository core.TaxonomyRepository
+	taxonomy           atomic.Pointer[core.Taxonomy]
+}
+
+func NewTaxonomyService(taxonomyRepository core.TaxonomyRepository) *TaxonomyService {
+	return &TaxonomyService{
+		taxonomyRepository: taxonomyRepository,
+	}
+}
+
+func (ts *TaxonomyService) Update(ctx context.Context) error {
+	groups, err := ts.taxonomyRepository.GetGroups(ctx)
+	if err != nil {
+		return err
+	}
+	taxonomy, err := core.NewTaxonomy(groups)
+	if err != nil {
+		return err
+	}
+	ts.taxonomy.Store(taxonomy)
+	return nil
+}
+
+func (ts *TaxonomyService) Tree(_ context.Context) (*core.Taxonomy, error) {
+	taxonomy := ts.taxonomy.Load()
+	if taxonomy == nil {
+		return nil, ErrTaxonomyNotLoaded
+	}
+	return taxonomy, nil
+}
+
+func (ts *TaxonomyService) Ancestors(ctx context.Context, id daltymodel.ProductGroup) ([]*core.GroupNode, error) {
+	taxonomy, err := ts.find(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return taxonomy.Ancestors(id), nil
+}
+
+func (ts *TaxonomyService) Descendants(ctx context.Context, id daltymodel.ProductGroup) ([]*core.GroupNode, error) {
+	taxonomy, err := ts.find(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return taxonomy.Descendants(id), nil
+}
+
+// Subtree is the group and every group below it.
+func (ts *TaxonomyService) Subtree(ctx context.Context, id daltymodel.ProductGroup) ([]daltymodel.ProductGroup, error) {
+	taxonomy, err := ts.find(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return taxonomy.Subtree(id), nil
+}
+
+func (ts *TaxonomyService) find(ctx context.Context, id daltymodel.ProductGroup) (*core.Taxonomy, error) {
+	taxonomy, err := ts.Tree(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := taxonomy.Find(id); !ok {
+		return nil, daltyerrors.New(6, &daltyerrors.EntityError{ID: string(id), EntityName: "product group"})
+	}
+	return taxonomy, nil
+}
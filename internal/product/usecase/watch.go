@@ -0,0 +1,258 @@
+package usecase
+
+import (
+	"container/list"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"github.com/DimKa163/dalty/internal/product/core"
+	"github.com/beevik/guid"
+)
+
+const (
+	watchBatchSize = 500
+
+	// watchOverlap is how far back every read starts before the newest
+	// change sent. updated_at is set when a row is written, not when its
+	// transaction commits, so a change committed late can land behind
+	// changes already sent; rereading the window catches it as long as the
+	// transaction took less than watchOverlap.
+	watchOverlap = time.Minute
+
+	// watchSentSize bounds how many products a stream remembers to diff
+	// against. A product that is no longer remembered is sent without
+	// Fields, as if it were sent for the first time.
+	watchSentSize = 10000
+)
+
+// ProductDelta is one product change. Fields lists what changed since the
+// stream last sent the product, it is empty the first time a product is
+// sent. Cursor resumes the stream after this change; changes of the last
+// watchOverlap before it may be sent again.
+type ProductDelta struct {
+	Product   *core.Product
+	Fields    []string
+	UpdatedAt time.Time
+	Cursor    string
+}
+
+// WatchService streams product changes. It reads them by update time and
+// polls every interval, a notifier signal triggers a read right away.
+type WatchService struct {
+	changeRepository core.ChangeRepository
+	notifier         core.ChangeNotifier
+	interval         time.Duration
+}
+
+func NewWatchService(changeRepository core.ChangeRepository, notifier core.ChangeNotifier, interval time.Duration) *WatchService {
+	return &WatchService{
+		changeRepository: changeRepository,
+		notifier:         notifier,
+		interval:         interval,
+	}
+}
+
+// Watch sends the changes after cursor until ctx is done or send fails. An
+// empty cursor starts from the latest change, so only new changes are sent.
+// A resumed stream first sends again the changes within watchOverlap before
+// the cursor.
+func (ws *WatchService) Watch(ctx context.Context, cursor string, send func(*ProductDelta) error) error {
+	after, err := decodeChangeCursor(cursor)
+	if err != nil {
+		return err
+	}
+	floor := &core.ChangeCursor{}
+	if after == nil {
+		if after, err = ws.changeRepository.LatestChange(ctx); err != nil {
+			return err
+		}
+		if after == nil {
+			after = &core.ChangeCursor{}
+		}
+		floor = after
+	}
+	signals, cancel := ws.notifier.Subscribe()
+	defer cancel()
+	ticker := time.NewTicker(ws.interval)
+	defer ticker.Stop()
+	stream := &watchStream{
+		newest: after,
+		floor:  floor,
+		seen:   make(map[changeKey]bool),
+		sent:   newSentProducts(watchSentSize),
+	}
+	for {
+		if err = ws.drain(ctx, stream, send); err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-signals:
+		case <-ticker.C:
+		}
+	}
+}
+
+// changeKey tells one change of a product from another.
+type changeKey struct {
+	id        guid.Guid
+	updatedAt time.Time
+}
+
+// watchStream is what a stream knows about the changes it sent: the newest
+// one, the ones within watchOverlap of it, and the last sent state of the
+// recently sent products. Changes up to floor are never sent.
+type watchStream struct {
+	newest *core.ChangeCursor
+	floor  *core.ChangeCursor
+	seen   map[changeKey]bool
+	sent   *sentProducts
+}
+
+// drain sends every change from watchOverlap before the newest change sent
+// that was not sent yet.
+func (ws *WatchService) drain(ctx context.Context, stream *watchStream, send func(*ProductDelta) error) error {
+	from := &core.ChangeCursor{UpdatedAt: stream.newest.UpdatedAt.Add(-watchOverlap)}
+	for {
+		changes, err := ws.changeRepository.GetChanges(ctx, from, watchBatchSize)
+		if err != nil {
+			return err
+		}
+		for _, change := range changes {
+			from = &core.ChangeCursor{UpdatedAt: change.UpdatedAt, ID: change.Product.ID}
+			key := changeKey{id: change.Product.ID, updatedAt: change.UpdatedAt}
+			if stream.seen[key] || !isAfter(from, stream.floor) {
+				continue
+			}
+			if isAfter(from, stream.newest) {
+				stream.newest = from
+			}
+			delta := &ProductDelta{
+				Product:   change.Product,
+				UpdatedAt: change.UpdatedAt,
+				Cursor:    encodeChangeCursor(stream.newest),
+			}
+			if previous, ok := stream.sent.get(change.Product.ID); ok {
+				delta.Fields = changedFields(previous, change.Product)
+			}
+			if err = send(delta); err != nil {
+				return err
+			}
+			stream.seen[key] = true
+			stream.sent.put(change.Product)
+		}
+		if len(changes) < watchBatchSize {
+			break
+		}
+	}
+	horizon := stream.newest.UpdatedAt.Add(-watchOverlap)
+	for key := range stream.seen {
+		if key.updatedAt.Before(horizon) {
+			delete(stream.seen, key)
+		}
+	}
+	return nil
+}
+
+func isAfter(a, b *core.ChangeCursor) bool {
+	if !a.UpdatedAt.Equal(b.UpdatedAt) {
+		return a.UpdatedAt.After(b.UpdatedAt)
+	}
+	return a.ID.String() > b.ID.String()
+}
+
+// sentProducts keeps the last sent state of up to capacity products and
+// forgets the least recently sent one first.
+type sentProducts struct {
+	capacity int
+	items    map[guid.Guid]*list.Element
+	order    *list.List
+}
+
+func newSentProducts(capacity int) *sentProducts {
+	return &sentProducts{
+		capacity: capacity,
+		items:    make(map[guid.Guid]*list.Element),
+		order:    list.New(),
+	}
+}
+
+func (s *sentProducts) get(id guid.Guid) (*core.Product, bool) {
+	if el, ok := s.items[id]; ok {
+		return el.Value.(*core.Product), true
+	}
+	return nil, false
+}
+
+func (s *sentProducts) put(product *core.Product) {
+	if el, ok := s.items[product.ID]; ok {
+		el.Value = product
+		s.order.MoveToFront(el)
+		return
+	}
+	s.items[product.ID] = s.order.PushFront(product)
+	if s.order.Len() > s.capacity {
+		oldest := s.order.Back()
+		s.order.Remove(oldest)
+		delete(s.items, oldest.Value.(*core.Product).ID)
+	}
+}
+
+// changedFields names the fields that differ, using the names of the proto
+// Product message.
+func changedFields(old, new *core.Product) []string {
+	fields := make([]string, 0)
+	add := func(changed bool, name string) {
+		if changed {
+			fields = append(fields, name)
+		}
+	}
+	add(old.Name != new.Name, "name")
+	add(old.Type != new.Type, "type")
+	add(old.ProductionType != new.ProductionType, "production_type")
+	add(old.Fnrec != new.Fnrec, "fnrec")
+	add(old.IsService != new.IsService, "is_service")
+	add(old.Group != new.Group, "group")
+	add(old.SeriesID != new.SeriesID, "series_id")
+	add(old.CategoryID != new.CategoryID, "category_id")
+	add(old.AccountProviderId != new.AccountProviderId, "account_provider")
+	add(old.NonStandardCategory != new.NonStandardCategory, "non_standard_category_id")
+	add(old.IsArchive != new.IsArchive, "is_archive")
+	add(old.IntegrationID != new.IntegrationID, "integration_id")
+	add(old.CountMa != new.CountMa, "count_ma")
+	add(old.Volume != new.Volume || old.Length != new.Length || old.Width != new.Width ||
+		old.Height != new.Height || old.Weight != new.Weight, "pack")
+	return fields
+}
+
+type changeCursor struct {
+	UpdatedAt time.Time `json:"t"`
+	ID        string    `json:"i"`
+}
+
+func encodeChangeCursor(cursor *core.ChangeCursor) string {
+	data, _ := json.Marshal(&changeCursor{UpdatedAt: cursor.UpdatedAt, ID: cursor.ID.String()})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeChangeCursor(value string) (*core.ChangeCursor, error) {
+	if value == "" {
+		return nil, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	var cursor changeCursor
+	if err = json.Unmarshal(data, &cursor); err != nil {
+		return nil, ErrInvalidCursor
+	}
+	id, err := guid.ParseString(cursor.ID)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	return &core.ChangeCursor{UpdatedAt: cursor.UpdatedAt, ID: *id}, nil
+}
@@ -0,0 +1,123 @@
+package usecase
+
+import (
+	"context"
+	"github.com/DimKa163/dalty/internal/product/core"
+	"github.com/DimKa163/dalty/internal/product/mocks"
+	"github.com/beevik/guid"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestWatchSendsDeltas(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	start := &core.ChangeCursor{UpdatedAt: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), ID: *guid.New()}
+	product := &core.Product{ID: *guid.New(), Name: "Bed", Weight: 10}
+	archived := *product
+	archived.IsArchive = true
+	archived.Weight = 12
+	first := &core.ProductChange{Product: product, UpdatedAt: start.UpdatedAt.Add(time.Minute)}
+	second := &core.ProductChange{Product: &archived, UpdatedAt: start.UpdatedAt.Add(2 * time.Minute)}
+	mockChangeRepository := mocks.NewMockChangeRepository(ctrl)
+	mockChangeRepository.EXPECT().LatestChange(ctx).Return(start, nil)
+	gomock.InOrder(
+		mockChangeRepository.EXPECT().GetChanges(ctx, &core.ChangeCursor{UpdatedAt: start.UpdatedAt.Add(-watchOverlap)}, watchBatchSize).
+			Return([]*core.ProductChange{first}, nil),
+		mockChangeRepository.EXPECT().GetChanges(ctx, &core.ChangeCursor{UpdatedAt: first.UpdatedAt.Add(-watchOverlap)}, watchBatchSize).
+			Return([]*core.ProductChange{first, second}, nil),
+	)
+	notifier := NewLocalNotifier()
+	sut := NewWatchService(mockChangeRepository, notifier, time.Hour)
+
+	deltas := make([]*ProductDelta, 0)
+	err := sut.Watch(ctx, "", func(delta *ProductDelta) error {
+		deltas = append(deltas, delta)
+		if len(deltas) == 1 {
+			notifier.Notify()
+		} else {
+			cancel()
+		}
+		return nil
+	})
+
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Len(t, deltas, 2)
+	assert.Empty(t, deltas[0].Fields)
+	assert.Equal(t, []string{"is_archive", "pack"}, deltas[1].Fields)
+	resumed, err := decodeChangeCursor(deltas[1].Cursor)
+	assert.NoError(t, err)
+	assert.True(t, second.UpdatedAt.Equal(resumed.UpdatedAt))
+	assert.Equal(t, product.ID, resumed.ID)
+}
+
+func TestWatchSendsLateCommits(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	start := &core.ChangeCursor{UpdatedAt: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), ID: *guid.New()}
+	early := &core.ProductChange{Product: &core.Product{ID: *guid.New()}, UpdatedAt: start.UpdatedAt.Add(time.Second)}
+	later := &core.ProductChange{Product: &core.Product{ID: *guid.New()}, UpdatedAt: start.UpdatedAt.Add(2 * time.Second)}
+	mockChangeRepository := mocks.NewMockChangeRepository(ctrl)
+	gomock.InOrder(
+		mockChangeRepository.EXPECT().GetChanges(ctx, &core.ChangeCursor{UpdatedAt: start.UpdatedAt.Add(-watchOverlap)}, watchBatchSize).
+			Return([]*core.ProductChange{later}, nil),
+		// early committed after later was read
+		mockChangeRepository.EXPECT().GetChanges(ctx, &core.ChangeCursor{UpdatedAt: later.UpdatedAt.Add(-watchOverlap)}, watchBatchSize).
+			Return([]*core.ProductChange{early, later}, nil),
+	)
+	notifier := NewLocalNotifier()
+	sut := NewWatchService(mockChangeRepository, notifier, time.Hour)
+
+	deltas := make([]*ProductDelta, 0)
+	err := sut.Watch(ctx, encodeChangeCursor(start), func(delta *ProductDelta) error {
+		deltas = append(deltas, delta)
+		if len(deltas) == 1 {
+			notifier.Notify()
+		} else {
+			cancel()
+		}
+		return nil
+	})
+
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Len(t, deltas, 2)
+	assert.Equal(t, later.Product, deltas[0].Product)
+	assert.Equal(t, early.Product, deltas[1].Product)
+	assert.Equal(t, deltas[0].Cursor, deltas[1].Cursor)
+}
+
+func TestSentProductsForgetsLeastRecentlySent(t *testing.T) {
+	sent := newSentProducts(2)
+	productA := &core.Product{ID: *guid.New()}
+	productB := &core.Product{ID: *guid.New()}
+	productC := &core.Product{ID: *guid.New()}
+	sent.put(productA)
+	sent.put(productB)
+	sent.put(productA)
+	sent.put(productC)
+
+	_, ok := sent.get(productB.ID)
+	assert.False(t, ok)
+	previous, ok := sent.get(productA.ID)
+	assert.True(t, ok)
+	assert.Same(t, productA, previous)
+}
+
+func TestWatchInvalidCursor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sut := NewWatchService(mocks.NewMockChangeRepository(ctrl), NewLocalNotifier(), time.Hour)
+
+	err := sut.Watch(context.Background(), "???", func(*ProductDelta) error { return nil })
+
+	assert.ErrorIs(t, err, ErrInvalidCursor)
+}
@@ -0,0 +1,72 @@
+package core
+
+import (
+	"math"
+
+	graph2 "github.com/DimKa163/dalty/pkg/graph"
+)
+
+// Objective picks one route out of the Pareto front.
+type Objective int
+
+const (
+	ObjectiveFastest Objective = iota
+	ObjectiveCheapest
+	ObjectiveFewestHops
+	ObjectiveBalanced
+)
+
+// DeliveryPlan is the best route for the objective next to the other routes
+// of the Pareto front. Best is nil when the target can't be reached.
+type DeliveryPlan struct {
+	Source       *graph2.Node
+	Best         *graph2.ParetoPath
+	Alternatives []*graph2.ParetoPath
+}
+
+// Pick returns the index of the best route in front, or -1 if it is empty.
+// Balanced scales time and money to [0, 1] over the front and minimizes
+// their sum.
+func (o Objective) Pick(front []*graph2.ParetoPath) int {
+	if len(front) == 0 {
+		return -1
+	}
+	score := func(p *graph2.ParetoPath) float64 {
+		switch o {
+		case ObjectiveCheapest:
+			return p.Cost.Money
+		case ObjectiveFewestHops:
+			return float64(p.Hops())
+		case ObjectiveBalanced:
+			return balancedScore(front, p)
+		default:
+			return p.Cost.Time
+		}
+	}
+	best := 0
+	bestScore := score(front[0])
+	for i := 1; i < len(front); i++ {
+		s := score(front[i])
+		if s < bestScore || (s == bestScore && front[i].Hops() < front[best].Hops()) {
+			best, bestScore = i, s
+		}
+	}
+	return best
+}
+
+func balancedScore(front []*graph2.ParetoPath, p *graph2.ParetoPath) float64 {
+	minTime, maxTime := math.Inf(1), math.Inf(-1)
+	minMoney, maxMoney := math.Inf(1), math.Inf(-1)
+	for _, it := range front {
+		minTime, maxTime = math.Min(minTime, it.Cost.Time), math.Max(maxTime, it.Cost.Time)
+		minMoney, maxMoney = math.Min(minMoney, it.Cost.Money), math.Max(maxMoney, it.Cost.Money)
+	}
+	return scale(p.Cost.Time, minTime, maxTime) + scale(p.Cost.Money, minMoney, maxMoney)
+}
+
+func scale(value, low, high float64) float64 {
+	if high == low {
+		return 0
+	}
+	return (value - low) / (high - low)
+}
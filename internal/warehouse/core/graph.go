@@ -18,15 +18,24 @@ func NewPathFinder(graphContext *graph2.GraphContext) *PathFinder {
 }
 
 func (ws *PathFinder) Path(ctx context.Context, destination *graph2.Node) (*Path, error) {
+	gr, err := ws.Context.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return ws.PathIn(ctx, gr, destination)
+}
+
+// PathIn walks the chain of destination on the given view instead of the
+// current snapshot, e.g. on an overlay with simulated changes.
+func (ws *PathFinder) PathIn(ctx context.Context, view graph2.View, destination *graph2.Node) (*Path, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
 	path := NewPath()
 	queue := list.New()
 	visited := make(map[string]bool)
 	subD := make(map[string]int)
 	subD[destination.ID] = 1
-	gr, err := ws.Context.Get(ctx)
-	if err != nil {
-		return nil, err
-	}
 	queue.PushFront(&PathNode{
 		Node: destination,
 	})
@@ -40,8 +49,7 @@ func (ws *PathFinder) Path(ctx context.Context, destination *graph2.Node) (*Path
 		item.Level = subD[item.ID]
 		path.AddNode(item)
 		// идём назад
-		edges := gr.AllIncomeTo(item.Node)
-		for _, edge := range edges {
+		for edge := range view.Incoming(item.Node) {
 			node := edge.From
 			subD[node.ID] = item.Level + 1
 			queue.PushFront(&PathNode{
@@ -0,0 +1,41 @@
+package core
+
+import (
+	graph2 "github.com/DimKa163/dalty/pkg/graph"
+)
+
+// GroupScope keeps routing inside one descriptor group. An empty Group means
+// the group of the destination.
+type GroupScope struct {
+	Group           string
+	AllowCrossGroup bool
+}
+
+// Restrict returns the part of view routing may use for dest.
+func (s GroupScope) Restrict(view graph2.View, dest *graph2.Node) graph2.View {
+	if s.AllowCrossGroup {
+		return view
+	}
+	group := s.Group
+	if group == "" {
+		group = DescriptorGroupOf(dest)
+	}
+	return graph2.NewSubgraph(view, func(n *graph2.Node) bool {
+		return DescriptorGroupOf(n) == group
+	})
+}
+
+func DescriptorGroupOf(n *graph2.Node) string {
+	w, ok := n.Value.(*Warehouse)
+	if !ok || w.Info == nil {
+		return ""
+	}
+	return w.Info.DescriptorGroup
+}
+
+type GroupStatistics struct {
+	Group           string
+	Nodes           int
+	Edges           int
+	CrossGroupEdges int
+}
@@ -0,0 +1,61 @@
+package core
+
+import (
+	"math"
+	"sort"
+)
+
+// Pack holds the dimensions of a product pack in the units of the product
+// card.
+type Pack struct {
+	Length float64
+	Width  float64
+	Height float64
+	Weight float64
+	Volume float64
+}
+
+// HandlingLimits is the largest pack a warehouse can handle. Zero means the
+// dimension is not limited.
+type HandlingLimits struct {
+	MaxLength float64
+	MaxWidth  float64
+	MaxHeight float64
+	MaxWeight float64
+	MaxVolume float64
+}
+
+// Fits reports whether the pack can be handled. The pack may be rotated, so
+// its sides are compared with the limits from the longest to the shortest.
+func (l *HandlingLimits) Fits(p *Pack) bool {
+	if l == nil || p == nil {
+		return true
+	}
+	if exceeds(p.Weight, l.MaxWeight) || exceeds(p.Volume, l.MaxVolume) {
+		return false
+	}
+	sides := sortedDesc(p.Length, p.Width, p.Height)
+	limits := sortedDesc(unlimited(l.MaxLength), unlimited(l.MaxWidth), unlimited(l.MaxHeight))
+	for i := range sides {
+		if sides[i] > limits[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func exceeds(value, limit float64) bool {
+	return limit > 0 && value > limit
+}
+
+func unlimited(limit float64) float64 {
+	if limit <= 0 {
+		return math.Inf(1)
+	}
+	return limit
+}
+
+func sortedDesc(values ...float64) []float64 {
+	sort.Sort(sort.Reverse(sort.Float64Slice(values)))
+	return values
+}
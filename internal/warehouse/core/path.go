@@ -103,3 +103,61 @@ func NewUnsuccessDeliveryPathResult(path *Path) *DeliveryPathResult {
 		Path:    NewDeliveryPath(path),
 	}
 }
+
+type RejectReason int
+
+const (
+	RejectReasonUnspecified RejectReason = iota
+	RejectReasonNotFound
+	RejectReasonDestinationNotInChain
+	RejectReasonOutsideGroup
+	RejectReasonOversize
+)
+
+func (r RejectReason) String() string {
+	switch r {
+	case RejectReasonNotFound:
+		return "NOT_FOUND"
+	case RejectReasonDestinationNotInChain:
+		return "DESTINATION_NOT_IN_CHAIN"
+	case RejectReasonOutsideGroup:
+		return "OUTSIDE_GROUP"
+	case RejectReasonOversize:
+		return "OVERSIZE"
+	default:
+		return "UNSPECIFIED"
+	}
+}
+
+type RejectedCandidate struct {
+	ID     string
+	Reason RejectReason
+}
+
+// PathResult is a chain picked for a destination. UsedWarehouseID is the
+// fallback candidate that was accepted, Rejected lists candidates tried before
+// it in request order. Oversized lists warehouses of the chain skipped
+// because they can't handle the pack.
+type PathResult struct {
+	Path            *Path
+	UsedWarehouseID string
+	Rejected        []*RejectedCandidate
+	Oversized       []*Warehouse
+}
+
+// PathOptions narrows the part of the graph routing may use.
+type PathOptions struct {
+	Scope GroupScope
+	Pack  *Pack
+}
+
+// Fitting leaves out warehouses that can't handle the pack.
+func (o PathOptions) Fitting(view graph.View) graph.View {
+	if o.Pack == nil {
+		return view
+	}
+	return graph.NewSubgraph(view, func(n *graph.Node) bool {
+		w, ok := n.Value.(*Warehouse)
+		return !ok || w.Limits.Fits(o.Pack)
+	})
+}
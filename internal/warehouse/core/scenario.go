@@ -0,0 +1,49 @@
+package core
+
+import (
+	"github.com/DimKa163/dalty/pkg/daltyerrors"
+	"github.com/DimKa163/dalty/pkg/graph"
+)
+
+type EdgeChange struct {
+	FromID string
+	ToID   string
+}
+
+// Scenario is a set of what-if changes to the warehouse graph: closed
+// warehouses and added or removed sender links.
+type Scenario struct {
+	Name         string
+	MaskedNodes  []string
+	AddedEdges   []*EdgeChange
+	RemovedEdges []*EdgeChange
+}
+
+// Apply records the scenario changes in overlay. Added edges may only connect
+// warehouses known to base.
+func (s *Scenario) Apply(overlay *graph.Overlay, base graph.View) error {
+	for _, id := range s.MaskedNodes {
+		overlay.MaskNode(id)
+	}
+	for _, edge := range s.RemovedEdges {
+		overlay.RemoveEdge(edge.FromID, edge.ToID)
+	}
+	entityErrors := make([]*daltyerrors.EntityError, 0)
+	for _, edge := range s.AddedEdges {
+		from, fromOk := base.Find(edge.FromID)
+		if !fromOk {
+			entityErrors = append(entityErrors, &daltyerrors.EntityError{ID: edge.FromID, EntityName: "warehouse"})
+		}
+		to, toOk := base.Find(edge.ToID)
+		if !toOk {
+			entityErrors = append(entityErrors, &daltyerrors.EntityError{ID: edge.ToID, EntityName: "warehouse"})
+		}
+		if fromOk && toOk {
+			overlay.AddEdge(from, to, 0)
+		}
+	}
+	if len(entityErrors) > 0 {
+		return daltyerrors.New(6, entityErrors...)
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package core
+
+import (
+	"database/sql"
+
+	"github.com/beevik/guid"
+	"github.com/jackc/pgx/v5"
+)
+
+// Transit is the lane between a sender and a recipient warehouse. Hours is
+// the transit time, Cost is the price of moving a shipment along the lane.
+type Transit struct {
+	SenderID    guid.Guid
+	RecipientID guid.Guid
+	Hours       float64
+	Cost        float64
+}
+
+func (t *Transit) Scan(dest pgx.Rows) error {
+	var senderID string
+	var recipientID string
+	var hours sql.NullFloat64
+	var cost sql.NullFloat64
+	if err := dest.Scan(&senderID, &recipientID, &hours, &cost); err != nil {
+		return err
+	}
+	sender, err := guid.ParseString(senderID)
+	if err != nil {
+		return err
+	}
+	recipient, err := guid.ParseString(recipientID)
+	if err != nil {
+		return err
+	}
+	t.SenderID = *sender
+	t.RecipientID = *recipient
+	t.Hours = hours.Float64
+	t.Cost = cost.Float64
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 
 	"github.com/DimKa163/dalty/internal/shared"
+	"github.com/DimKa163/dalty/pkg/geo"
 
 	"github.com/beevik/guid"
 	"github.com/jackc/pgx/v5"
@@ -21,6 +22,7 @@ type Warehouse struct {
 	Type                   WarehouseType
 	AvailableForBalance    bool
 	Info                   *WarehouseInfo
+	Limits                 *HandlingLimits
 }
 
 func (w *Warehouse) Scan(dest pgx.Rows) error {
@@ -37,8 +39,15 @@ func (w *Warehouse) Scan(dest pgx.Rows) error {
 	var warehouseInfoFnrec sql.NullString
 	var warehouseInfoAddress sql.NullString
 	var warehouseInfoDescriptorGroup sql.NullString
+	var warehouseInfoLatitude sql.NullFloat64
+	var warehouseInfoLongitude sql.NullFloat64
 	var tzID sql.NullString
 	var tzCode sql.NullString
+	var maxLength sql.NullFloat64
+	var maxWidth sql.NullFloat64
+	var maxHeight sql.NullFloat64
+	var maxWeight sql.NullFloat64
+	var maxVolume sql.NullFloat64
 	if err := dest.Scan(&warehouseID,
 		&warehouseFnrec,
 		&name,
@@ -52,8 +61,15 @@ func (w *Warehouse) Scan(dest pgx.Rows) error {
 		&warehouseInfoFnrec,
 		&warehouseInfoAddress,
 		&warehouseInfoDescriptorGroup,
+		&warehouseInfoLatitude,
+		&warehouseInfoLongitude,
 		&tzID,
-		&tzCode); err != nil {
+		&tzCode,
+		&maxLength,
+		&maxWidth,
+		&maxHeight,
+		&maxWeight,
+		&maxVolume); err != nil {
 		return err
 	}
 	var err error
@@ -100,8 +116,23 @@ func (w *Warehouse) Scan(dest pgx.Rows) error {
 		warehouseInfo.Fnrec = warehouseInfoFnrec.String
 		warehouseInfo.DescriptorGroup = warehouseInfoDescriptorGroup.String
 		warehouseInfo.TimeZone = tz
+		if warehouseInfoLatitude.Valid && warehouseInfoLongitude.Valid {
+			location := geo.NewPoint(warehouseInfoLatitude.Float64, warehouseInfoLongitude.Float64)
+			if location.Valid() {
+				warehouseInfo.Location = &location
+			}
+		}
 	}
 	w.Info = warehouseInfo
+	if maxLength.Valid || maxWidth.Valid || maxHeight.Valid || maxWeight.Valid || maxVolume.Valid {
+		w.Limits = &HandlingLimits{
+			MaxLength: maxLength.Float64,
+			MaxWidth:  maxWidth.Float64,
+			MaxHeight: maxHeight.Float64,
+			MaxWeight: maxWeight.Float64,
+			MaxVolume: maxVolume.Float64,
+		}
+	}
 	return nil
 }
 
@@ -111,6 +142,7 @@ type WarehouseInfo struct {
 	Address         string
 	DescriptorGroup string
 	TimeZone        *TimeZone
+	Location        *geo.Point
 }
 
 type TimeZone struct {
@@ -120,6 +152,7 @@ type TimeZone struct {
 
 type WarehouseRepository interface {
 	GetAll(ctx context.Context) ([]*Warehouse, error)
+	GetTransits(ctx context.Context) ([]*Transit, error)
 }
 
 type WarehouseType int
@@ -0,0 +1,66 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: I:\Goland\dalty\internal\warehouse\core\warehouse.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	core "github.com/DimKa163/dalty/internal/warehouse/core"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockWarehouseRepository is a mock of WarehouseRepository interface.
+type MockWarehouseRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockWarehouseRepositoryMockRecorder
+}
+
+// MockWarehouseRepositoryMockRecorder is the mock recorder for MockWarehouseRepository.
+type MockWarehouseRepositoryMockRecorder struct {
+	mock *MockWarehouseRepository
+}
+
+// NewMockWarehouseRepository creates a new mock instance.
+func NewMockWarehouseRepository(ctrl *gomock.Controller) *MockWarehouseRepository {
+	mock := &MockWarehouseRepository{ctrl: ctrl}
+	mock.recorder = &MockWarehouseRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWarehouseRepository) EXPECT() *MockWarehouseRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetAll mocks base method.
+func (m *MockWarehouseRepository) GetAll(ctx context.Context) ([]*core.Warehouse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx)
+	ret0, _ := ret[0].([]*core.Warehouse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockWarehouseRepositoryMockRecorder) GetAll(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockWarehouseRepository)(nil).GetAll), ctx)
+}
+
+// GetTransits mocks base method.
+func (m *MockWarehouseRepository) GetTransits(ctx context.Context) ([]*core.Transit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransits", ctx)
+	ret0, _ := ret[0].([]*core.Transit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransits indicates an expected call of GetTransits.
+func (mr *MockWarehouseRepositoryMockRecorder) GetTransits(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransits", reflect.TypeOf((*MockWarehouseRepository)(nil).GetTransits), ctx)
+}
@@ -21,13 +21,27 @@ const (
        	nw.nrb_fnrec,
        	nw.nrb_address,
        	nw.bpm_descriptor_group_name,
+       	nw.nrb_latitude,
+       	nw.nrb_longitude,
        	tz.id,
-       	tz.code
+       	tz.code,
+       	hl.max_length,
+       	hl.max_width,
+       	hl.max_height,
+       	hl.max_weight,
+       	hl.max_volume
 		FROM public.nrb_sub_warehouse
 		JOIN nrb_sub_warehouse_categories sc on sc.id=nrb_sub_warehouse.nrb_category_id
 		JOIN public.nrb_warehouse nw on nw.id = nrb_sub_warehouse.nrb_warehouse_id
 		LEFT JOIN public.time_zone tz on tz.id=nw.ask_time_zone_id
+		LEFT JOIN public.warehouse_handling_limit hl on hl.sub_warehouse_id=nrb_sub_warehouse.id
 		WHERE nrb_is_active = true`
+
+	GetAllTransit = `SELECT wt.sender_id,
+       	wt.recipient_id,
+       	wt.transit_hours,
+       	wt.transit_cost
+		FROM public.warehouse_transit wt`
 )
 
 type WarehouseRepository struct {
@@ -51,6 +65,23 @@ func (w WarehouseRepository) GetAll(ctx context.Context) ([]*core.Warehouse, err
 	return warehouses, nil
 }
 
+func (w WarehouseRepository) GetTransits(ctx context.Context) ([]*core.Transit, error) {
+	var transits []*core.Transit
+	rows, err := w.db.Query(ctx, GetAllTransit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var transit core.Transit
+		if err := transit.Scan(rows); err != nil {
+			return nil, err
+		}
+		transits = append(transits, &transit)
+	}
+	return transits, nil
+}
+
 func NewWarehouseRepository(db db.QueryExecutor) *WarehouseRepository {
 	return &WarehouseRepository{
 		db: db,
@@ -0,0 +1,75 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	"github.com/DimKa163/dalty/api/proto"
+	"github.com/DimKa163/dalty/internal/warehouse/usecase"
+	"github.com/DimKa163/dalty/pkg/daltyerrors"
+	"github.com/DimKa163/dalty/pkg/daltyerrors/protoerr"
+	"github.com/beevik/guid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ImpactServer struct {
+	service *usecase.ImpactService
+	proto.UnimplementedImpactServiceServer
+}
+
+func NewImpactServer(service *usecase.ImpactService) *ImpactServer {
+	return &ImpactServer{
+		service: service,
+	}
+}
+
+func (is *ImpactServer) Bind(server *grpc.Server) {
+	proto.RegisterImpactServiceServer(server, is)
+}
+
+func (is *ImpactServer) GetImpact(ctx context.Context, in *proto.ImpactRequest) (*proto.Impact, error) {
+	id, err := guid.ParseString(in.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	impact, err := is.service.Impact(ctx, id)
+	if err != nil {
+		return nil, handleImpactError(err)
+	}
+	return mapImpactToProto(impact), nil
+}
+
+func (is *ImpactServer) GetSinglePointsOfFailure(ctx context.Context, _ *proto.SinglePointsOfFailureRequest) (*proto.SinglePointsOfFailureResponse, error) {
+	var response proto.SinglePointsOfFailureResponse
+	report, err := is.service.SinglePointsOfFailure(ctx)
+	if err != nil {
+		return nil, handleImpactError(err)
+	}
+	items := make([]*proto.Impact, len(report))
+	for i, impact := range report {
+		items[i] = mapImpactToProto(impact)
+	}
+	response.SetItems(items)
+	return &response, nil
+}
+
+func handleImpactError(err error) error {
+	var daltyErr *daltyerrors.DaltyError
+	if errors.As(err, &daltyErr) {
+		return protoerr.Handle(daltyErr)
+	}
+	return status.Error(codes.Internal, err.Error())
+}
+
+func mapImpactToProto(impact *usecase.Impact) *proto.Impact {
+	var result proto.Impact
+	result.SetWarehouse(mapWarehouseToProto(impact.Warehouse))
+	dependents := make([]*proto.Warehouse, len(impact.Dependents))
+	for i, w := range impact.Dependents {
+		dependents[i] = mapWarehouseToProto(w)
+	}
+	result.SetDependents(dependents)
+	return &result
+}
@@ -0,0 +1,21 @@
+package interceptor
+
+import (
+	"context"
+
+	"github.com/DimKa163/dalty/pkg/graph"
+	"google.golang.org/grpc"
+)
+
+// UnaryServerSnapshotInterceptor pins the current warehouse graph to the
+// request context, so a graph refresh in the middle of a request can't mix
+// two snapshots in one response.
+func UnaryServerSnapshotInterceptor(graphContext *graph.GraphContext) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, _, err := graphContext.Pin(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
@@ -0,0 +1,67 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	"github.com/DimKa163/dalty/api/proto"
+	"github.com/DimKa163/dalty/internal/warehouse/core"
+	"github.com/DimKa163/dalty/internal/warehouse/usecase"
+	"github.com/DimKa163/dalty/pkg/daltyerrors"
+	"github.com/DimKa163/dalty/pkg/daltyerrors/protoerr"
+	"github.com/DimKa163/dalty/pkg/geo"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type LocationServer struct {
+	service *usecase.LocationService
+	proto.UnimplementedLocationServiceServer
+}
+
+func NewLocationServer(service *usecase.LocationService) *LocationServer {
+	return &LocationServer{
+		service: service,
+	}
+}
+
+func (ls *LocationServer) Bind(server *grpc.Server) {
+	proto.RegisterLocationServiceServer(server, ls)
+}
+
+func (ls *LocationServer) FindNearestWarehouses(ctx context.Context, in *proto.FindNearestWarehousesRequest) (*proto.FindNearestWarehousesResponse, error) {
+	var response proto.FindNearestWarehousesResponse
+	if !in.HasLatitude() || !in.HasLongitude() {
+		return nil, protoerr.Handle(daltyerrors.New(2))
+	}
+	query := &usecase.NearestQuery{
+		Location:          geo.NewPoint(in.GetLatitude(), in.GetLongitude()),
+		Limit:             int(in.GetLimit()),
+		MaxDistance:       in.GetMaxDistanceKm(),
+		OnlyAvailableRest: in.GetOnlyAvailableRest(),
+		DescriptorGroup:   in.GetDescriptorGroup(),
+	}
+	types := in.GetTypes()
+	query.Types = make([]core.WarehouseType, len(types))
+	for i, t := range types {
+		query.Types[i] = mapTypeFromProto(t)
+	}
+	nearest, err := ls.service.FindNearest(ctx, query)
+	if err != nil {
+		var daltyErr *daltyerrors.DaltyError
+		if errors.As(err, &daltyErr) {
+			return nil, protoerr.Handle(daltyErr)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	warehouses := make([]*proto.NearestWarehouse, len(nearest))
+	for i, n := range nearest {
+		var item proto.NearestWarehouse
+		item.SetWarehouse(mapWarehouseToProto(n.Warehouse))
+		item.SetDistanceKm(n.Distance)
+		warehouses[i] = &item
+	}
+	response.SetWarehouses(warehouses)
+	return &response, nil
+}
@@ -2,10 +2,14 @@ package server
 
 import (
 	"context"
+	"errors"
 
 	"github.com/DimKa163/dalty/api/proto"
 	"github.com/DimKa163/dalty/internal/warehouse/core"
 	"github.com/DimKa163/dalty/internal/warehouse/usecase"
+	"github.com/DimKa163/dalty/pkg/daltyerrors"
+	"github.com/DimKa163/dalty/pkg/daltyerrors/protoerr"
+	"github.com/DimKa163/dalty/pkg/graph"
 	"github.com/beevik/guid"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -26,33 +30,207 @@ func (ps *PathServer) Bind(server *grpc.Server) {
 	proto.RegisterPathServiceServer(server, ps)
 }
 func (ps *PathServer) Get(ctx context.Context, in *proto.GetPath) (*proto.Path, error) {
-	var protoPath proto.Path
+	id, candidates, err := parseGetPath(in)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := ps.service.GetPath(ctx, id, candidates, mapPathOptionsFromProto(in))
+	if err != nil {
+		var daltyErr *daltyerrors.DaltyError
+		if errors.As(err, &daltyErr) {
+			return nil, protoerr.Handle(daltyErr)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return mapPathResultToProto(result), nil
+}
+
+func parseGetPath(in *proto.GetPath) (*guid.Guid, []*guid.Guid, error) {
 	id, err := guid.ParseString(in.GetId())
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	fallbacks := in.GetFallbackWarehouseIds()
+	candidates := make([]*guid.Guid, 0, len(fallbacks)+1)
+	if in.GetDefaultWarehouseId() != "" {
+		defWarehouse, err := guid.ParseString(in.GetDefaultWarehouseId())
+		if err != nil {
+			return nil, nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		candidates = append(candidates, defWarehouse)
+	}
+	for _, fallback := range fallbacks {
+		candidate, err := guid.ParseString(fallback)
+		if err != nil {
+			return nil, nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		candidates = append(candidates, candidate)
+	}
+	return id, candidates, nil
+}
+
+func (ps *PathServer) GetDeliveryPath(ctx context.Context, in *proto.DeliveryPathRequest) (*proto.DeliveryPathResult, error) {
+	var response proto.DeliveryPathResult
+	from, err := parseWarehouseID(in.GetFrom().GetId(), "from")
+	if err != nil {
+		return nil, err
+	}
+	to, err := parseWarehouseID(in.GetTo().GetId(), "to")
+	if err != nil {
+		return nil, err
 	}
-	defWarehouse, err := guid.ParseString(in.GetDefaultWarehouseId())
+	allowed := make([]*guid.Guid, len(in.GetNode()))
+	for i, n := range in.GetNode() {
+		allowed[i], err = parseWarehouseID(n.GetId(), "node")
+		if err != nil {
+			return nil, err
+		}
+	}
+	plan, err := ps.service.GetDeliveryPath(ctx, from, to, allowed, mapObjectiveFromProto(in.GetObjective()))
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		var daltyErr *daltyerrors.DaltyError
+		if errors.As(err, &daltyErr) {
+			return nil, protoerr.Handle(daltyErr)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
 	}
+	if plan.Best == nil {
+		response.SetSuccess(false)
+		return &response, nil
+	}
+	response.SetSuccess(true)
+	response.SetDeliveryPath(mapDeliveryPathToProto(plan.Source, plan.Best))
+	alternatives := make([]*proto.DeliveryPath, len(plan.Alternatives))
+	for i, p := range plan.Alternatives {
+		alternatives[i] = mapDeliveryPathToProto(plan.Source, p)
+	}
+	response.SetAlternatives(alternatives)
+	return &response, nil
+}
+
+func mapObjectiveFromProto(objective proto.Objective) core.Objective {
+	switch objective {
+	case proto.Objective_OBJECTIVE_CHEAPEST:
+		return core.ObjectiveCheapest
+	case proto.Objective_OBJECTIVE_FEWEST_HOPS:
+		return core.ObjectiveFewestHops
+	case proto.Objective_OBJECTIVE_BALANCED:
+		return core.ObjectiveBalanced
+	default:
+		return core.ObjectiveFastest
+	}
+}
 
-	path, err := ps.service.GetPath(ctx, id, defWarehouse)
+func mapDeliveryPathToProto(source *graph.Node, route *graph.ParetoPath) *proto.DeliveryPath {
+	var result proto.DeliveryPath
+	var path proto.Path
+	nodes := route.Nodes(source)
+	warehouses := make([]*proto.Warehouse, len(nodes))
+	for i, n := range nodes {
+		warehouses[i] = mapWarehouseToProto(n.Value.(*core.Warehouse))
+		warehouses[i].SetLevel(int32(i + 1))
+	}
+	path.SetNodes(warehouses)
+	result.SetFirst(warehouses[0])
+	result.SetLast(warehouses[len(warehouses)-1])
+	result.SetPath(&path)
+	result.SetTransitHours(route.Cost.Time)
+	result.SetCost(route.Cost.Money)
+	result.SetHops(int32(route.Hops()))
+	return &result
+}
+
+func (ps *PathServer) GetGroupStatistics(ctx context.Context, _ *proto.GroupStatisticsRequest) (*proto.GroupStatisticsResponse, error) {
+	var response proto.GroupStatisticsResponse
+	statistics, err := ps.service.GroupStatistics(ctx)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
-	list := path.GetList()
+	groups := make([]*proto.GroupStatistics, len(statistics))
+	for i, s := range statistics {
+		var group proto.GroupStatistics
+		group.SetDescriptorGroup(s.Group)
+		group.SetNodes(int32(s.Nodes))
+		group.SetEdges(int32(s.Edges))
+		group.SetCrossGroupEdges(int32(s.CrossGroupEdges))
+		groups[i] = &group
+	}
+	response.SetGroups(groups)
+	return &response, nil
+}
+
+func mapPathOptionsFromProto(in *proto.GetPath) core.PathOptions {
+	opts := core.PathOptions{
+		Scope: core.GroupScope{
+			Group:           in.GetDescriptorGroup(),
+			AllowCrossGroup: in.GetAllowCrossGroup(),
+		},
+	}
+	if in.HasPack() {
+		pack := in.GetPack()
+		opts.Pack = &core.Pack{
+			Length: pack.GetLength(),
+			Width:  pack.GetWidth(),
+			Height: pack.GetHeight(),
+			Weight: pack.GetWeight(),
+			Volume: pack.GetVolume(),
+		}
+	}
+	return opts
+}
+
+func mapPathResultToProto(result *core.PathResult) *proto.Path {
+	var protoPath proto.Path
+	list := result.Path.GetList()
 	nodes := make([]*proto.Warehouse, len(list))
 	for i, node := range list {
 		nodes[i] = mapNodeToProto(node)
 	}
 	protoPath.SetNodes(nodes)
-	return &protoPath, nil
+	protoPath.SetUsedWarehouseId(result.UsedWarehouseID)
+	rejected := make([]*proto.RejectedWarehouse, len(result.Rejected))
+	for i, r := range result.Rejected {
+		var item proto.RejectedWarehouse
+		item.SetId(r.ID)
+		item.SetReason(mapRejectReasonToProto(r.Reason))
+		rejected[i] = &item
+	}
+	protoPath.SetRejected(rejected)
+	oversized := make([]*proto.Warehouse, len(result.Oversized))
+	for i, w := range result.Oversized {
+		oversized[i] = mapWarehouseToProto(w)
+	}
+	protoPath.SetOversized(oversized)
+	return &protoPath
+}
+
+func mapRejectReasonToProto(reason core.RejectReason) proto.RejectReason {
+	switch reason {
+	case core.RejectReasonNotFound:
+		return proto.RejectReason_REJECT_REASON_NOT_FOUND
+	case core.RejectReasonDestinationNotInChain:
+		return proto.RejectReason_REJECT_REASON_DESTINATION_NOT_IN_CHAIN
+	case core.RejectReasonOutsideGroup:
+		return proto.RejectReason_REJECT_REASON_OUTSIDE_GROUP
+	case core.RejectReasonOversize:
+		return proto.RejectReason_REJECT_REASON_OVERSIZE
+	default:
+		return proto.RejectReason_REJECT_REASON_UNSPECIFIED
+	}
 }
 
 func mapNodeToProto(node *core.PathNode) *proto.Warehouse {
-	var nodeProto proto.Warehouse
-	nodeProto.SetId(node.ID)
 	it := node.Value.(*core.Warehouse)
+	nodeProto := mapWarehouseToProto(it)
+	nodeProto.SetId(node.ID)
+	nodeProto.SetLevel(int32(node.Level))
+	return nodeProto
+}
+
+func mapWarehouseToProto(it *core.Warehouse) *proto.Warehouse {
+	var nodeProto proto.Warehouse
+	nodeProto.SetId(it.ID.String())
 	nodeProto.SetName(it.Name)
 	nodeProto.SetType(mapTypeToProto(it))
 	if it.Info != nil {
@@ -60,11 +238,23 @@ func mapNodeToProto(node *core.PathNode) *proto.Warehouse {
 			nodeProto.SetTimeZone(it.Info.TimeZone.Code)
 		}
 		nodeProto.SetDescriptorGroup(it.Info.DescriptorGroup)
+		nodeProto.SetAddress(it.Info.Address)
+		if it.Info.Location != nil {
+			nodeProto.SetLatitude(it.Info.Location.Latitude)
+			nodeProto.SetLongitude(it.Info.Location.Longitude)
+		}
 	}
 
-	nodeProto.SetLevel(int32(node.Level))
+	if it.Limits != nil {
+		var limits proto.HandlingLimits
+		limits.SetMaxLength(it.Limits.MaxLength)
+		limits.SetMaxWidth(it.Limits.MaxWidth)
+		limits.SetMaxHeight(it.Limits.MaxHeight)
+		limits.SetMaxWeight(it.Limits.MaxWeight)
+		limits.SetMaxVolume(it.Limits.MaxVolume)
+		nodeProto.SetHandlingLimits(&limits)
+	}
 	nodeProto.SetAvailableRest(it.AvailableForBalance)
-	nodeProto.SetAddress(it.Info.Address)
 	nodeProto.SetOnlyStockPickupAllowed(it.OnlyStockPickupAllowed)
 
 	return &nodeProto
@@ -126,3 +316,60 @@ func mapTypeToProto(n *core.Warehouse) proto.WarehouseType {
 		return proto.WarehouseType_UNRECOGNIZED
 	}
 }
+
+func mapTypeFromProto(t proto.WarehouseType) core.WarehouseType {
+	switch t {
+	case proto.WarehouseType_FREE:
+		return core.NodeFree
+	case proto.WarehouseType_MAIN:
+		return core.NodeMain
+	case proto.WarehouseType_CENTRAL:
+		return core.NodeCenter
+	case proto.WarehouseType_MALL:
+		return core.NodeMall
+	case proto.WarehouseType_TRANSIT:
+		return core.NodeTransit
+	case proto.WarehouseType_RESERVATION:
+		return core.NodeReservation
+	case proto.WarehouseType_LOSES:
+		return core.NodeLoses
+	case proto.WarehouseType_MARKETING:
+		return core.NodeMarketing
+	case proto.WarehouseType_EXPOSITION:
+		return core.NodeExposition
+	case proto.WarehouseType_PARTNER:
+		return core.NodePartner
+	case proto.WarehouseType_PARTNER2:
+		return core.NodePartner2
+	case proto.WarehouseType_FREE2:
+		return core.NodeFree2
+	case proto.WarehouseType_PROBLEM:
+		return core.NodeProblem
+	case proto.WarehouseType_REFUND:
+		return core.NodeRefund
+	case proto.WarehouseType_PRODUCTION:
+		return core.NodeProduction
+	case proto.WarehouseType_RECYCLING:
+		return core.NodeRecycling
+	case proto.WarehouseType_SERVICE:
+		return core.NodeService
+	case proto.WarehouseType_MATERIAL:
+		return core.NodeMaterial
+	case proto.WarehouseType_MARKDOWN:
+		return core.NodeMarkdown
+	case proto.WarehouseType_BUFFER:
+		return core.NodeBuffer
+	case proto.WarehouseType_DISCOUNT:
+		return core.NodeDiscount
+	case proto.WarehouseType_CENTRAL_MAIN_INTERMEDIATE:
+		return core.NodeCentralMainIntermediate
+	case proto.WarehouseType_MAIN_CENTRAL_INTERMEDIATE:
+		return core.NodeMainCentralIntermediate
+	case proto.WarehouseType_CENTRAL_FREE_INTERMEDIATE:
+		return core.NodeCentralFreeIntermediate
+	case proto.WarehouseType_FREE_CENTRAL_INTERMEDIATE:
+		return core.NodeFreeCentralIntermediate
+	default:
+		return core.NodeUnrecognized
+	}
+}
@@ -0,0 +1,137 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	"github.com/DimKa163/dalty/api/proto"
+	"github.com/DimKa163/dalty/internal/warehouse/core"
+	"github.com/DimKa163/dalty/internal/warehouse/usecase"
+	"github.com/DimKa163/dalty/pkg/daltyerrors"
+	"github.com/DimKa163/dalty/pkg/daltyerrors/protoerr"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type SimulationServer struct {
+	service *usecase.SimulationService
+	proto.UnimplementedSimulationServiceServer
+}
+
+func NewSimulationServer(service *usecase.SimulationService) *SimulationServer {
+	return &SimulationServer{
+		service: service,
+	}
+}
+
+func (ss *SimulationServer) Bind(server *grpc.Server) {
+	proto.RegisterSimulationServiceServer(server, ss)
+}
+
+func (ss *SimulationServer) SimulatePath(ctx context.Context, in *proto.SimulatePathRequest) (*proto.SimulatePathResponse, error) {
+	var response proto.SimulatePathResponse
+	if !in.HasPath() {
+		return nil, protoerr.InvalidArgument("path request is required", &protoerr.ValidationError{
+			Message: "path request is required",
+			Members: []string{"path"},
+		})
+	}
+	id, candidates, err := parseGetPath(in.GetPath())
+	if err != nil {
+		return nil, err
+	}
+	var inline *core.Scenario
+	if in.HasScenario() {
+		inline = mapScenarioFromProto(in.GetScenario())
+	}
+	simulation, err := ss.service.SimulatePath(ctx, id, candidates, mapPathOptionsFromProto(in.GetPath()), in.GetScenarioName(), inline)
+	if err != nil {
+		var daltyErr *daltyerrors.DaltyError
+		if errors.As(err, &daltyErr) {
+			return nil, protoerr.Handle(daltyErr)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	response.SetActual(mapPathOutcomeToProto(simulation.Actual, simulation.ActualErr))
+	response.SetSimulated(mapPathOutcomeToProto(simulation.Simulated, simulation.SimulatedErr))
+	return &response, nil
+}
+
+func (ss *SimulationServer) PutScenario(ctx context.Context, in *proto.Scenario) (*proto.Scenario, error) {
+	if err := ss.service.PutScenario(ctx, mapScenarioFromProto(in)); err != nil {
+		if errors.Is(err, usecase.ErrScenarioNameRequired) {
+			return nil, protoerr.InvalidArgument(err.Error(), &protoerr.ValidationError{
+				Message: err.Error(),
+				Members: []string{"name"},
+			})
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return in, nil
+}
+
+func (ss *SimulationServer) DeleteScenario(ctx context.Context, in *proto.DeleteScenarioRequest) (*proto.DeleteScenarioResponse, error) {
+	var response proto.DeleteScenarioResponse
+	response.SetDeleted(ss.service.DeleteScenario(ctx, in.GetName()))
+	return &response, nil
+}
+
+func (ss *SimulationServer) ListScenarios(ctx context.Context, _ *proto.ListScenariosRequest) (*proto.ListScenariosResponse, error) {
+	var response proto.ListScenariosResponse
+	scenarios := ss.service.Scenarios(ctx)
+	items := make([]*proto.Scenario, len(scenarios))
+	for i, scenario := range scenarios {
+		items[i] = mapScenarioToProto(scenario)
+	}
+	response.SetScenarios(items)
+	return &response, nil
+}
+
+func mapPathOutcomeToProto(result *core.PathResult, err *daltyerrors.DaltyError) *proto.PathOutcome {
+	var outcome proto.PathOutcome
+	if err != nil {
+		outcome.SetError(protoerr.Detail(err))
+		return &outcome
+	}
+	outcome.SetPath(mapPathResultToProto(result))
+	return &outcome
+}
+
+func mapScenarioFromProto(in *proto.Scenario) *core.Scenario {
+	scenario := &core.Scenario{
+		Name:        in.GetName(),
+		MaskedNodes: in.GetMaskedWarehouseIds(),
+	}
+	scenario.AddedEdges = mapEdgeChangesFromProto(in.GetAddedEdges())
+	scenario.RemovedEdges = mapEdgeChangesFromProto(in.GetRemovedEdges())
+	return scenario
+}
+
+func mapEdgeChangesFromProto(in []*proto.EdgeChange) []*core.EdgeChange {
+	changes := make([]*core.EdgeChange, len(in))
+	for i, change := range in {
+		changes[i] = &core.EdgeChange{FromID: change.GetFromId(), ToID: change.GetToId()}
+	}
+	return changes
+}
+
+func mapScenarioToProto(in *core.Scenario) *proto.Scenario {
+	var scenario proto.Scenario
+	scenario.SetName(in.Name)
+	scenario.SetMaskedWarehouseIds(in.MaskedNodes)
+	scenario.SetAddedEdges(mapEdgeChangesToProto(in.AddedEdges))
+	scenario.SetRemovedEdges(mapEdgeChangesToProto(in.RemovedEdges))
+	return &scenario
+}
+
+func mapEdgeChangesToProto(in []*core.EdgeChange) []*proto.EdgeChange {
+	changes := make([]*proto.EdgeChange, len(in))
+	for i, change := range in {
+		var item proto.EdgeChange
+		item.SetFromId(change.FromID)
+		item.SetToId(change.ToID)
+		changes[i] = &item
+	}
+	return changes
+}
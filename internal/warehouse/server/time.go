@@ -0,0 +1,148 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/DimKa163/dalty/api/proto"
+	"github.com/DimKa163/dalty/internal/warehouse/usecase"
+	"github.com/DimKa163/dalty/pkg/daltyerrors"
+	"github.com/DimKa163/dalty/pkg/daltyerrors/protoerr"
+	"github.com/beevik/guid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	cutOffLayout    = "15:04"
+	wallClockLayout = "2006-01-02T15:04:05"
+)
+
+type TimeServer struct {
+	service *usecase.TimeService
+	proto.UnimplementedTimeServiceServer
+}
+
+func NewTimeServer(service *usecase.TimeService) *TimeServer {
+	return &TimeServer{
+		service: service,
+	}
+}
+
+func (ts *TimeServer) Bind(server *grpc.Server) {
+	proto.RegisterTimeServiceServer(server, ts)
+}
+
+func (ts *TimeServer) LocalNow(ctx context.Context, in *proto.LocalNowRequest) (*proto.LocalTime, error) {
+	id, err := parseWarehouseID(in.GetWarehouseId(), "warehouse_id")
+	if err != nil {
+		return nil, err
+	}
+	local, err := ts.service.LocalNow(ctx, id)
+	if err != nil {
+		return nil, handleTimeError(err)
+	}
+	return mapLocalTimeToProto(local), nil
+}
+
+func (ts *TimeServer) IsBeforeCutOff(ctx context.Context, in *proto.CutOffRequest) (*proto.CutOffResponse, error) {
+	var response proto.CutOffResponse
+	id, err := parseWarehouseID(in.GetWarehouseId(), "warehouse_id")
+	if err != nil {
+		return nil, err
+	}
+	cutOff, err := time.Parse(cutOffLayout, in.GetCutOff())
+	if err != nil {
+		return nil, invalidArgument("cut_off must be HH:MM", "cut_off")
+	}
+	result, err := ts.service.IsBeforeCutOff(ctx, id, time.Duration(cutOff.Hour())*time.Hour+time.Duration(cutOff.Minute())*time.Minute)
+	if err != nil {
+		return nil, handleTimeError(err)
+	}
+	response.SetBeforeCutOff(result.Before)
+	response.SetNow(mapLocalTimeToProto(result.Now))
+	response.SetCutOff(mapLocalTimeToProto(result.CutOff))
+	return &response, nil
+}
+
+func (ts *TimeServer) NextBusinessDay(ctx context.Context, in *proto.NextBusinessDayRequest) (*proto.LocalTime, error) {
+	id, err := parseWarehouseID(in.GetWarehouseId(), "warehouse_id")
+	if err != nil {
+		return nil, err
+	}
+	var day *usecase.LocalTime
+	if in.HasFrom() {
+		day, err = ts.service.NextBusinessDay(ctx, id, in.GetFrom().AsTime())
+	} else {
+		var now *usecase.LocalTime
+		now, err = ts.service.LocalNow(ctx, id)
+		if err == nil {
+			day, err = ts.service.NextBusinessDay(ctx, id, now.Time)
+		}
+	}
+	if err != nil {
+		return nil, handleTimeError(err)
+	}
+	return mapLocalTimeToProto(day), nil
+}
+
+func (ts *TimeServer) ConvertTime(ctx context.Context, in *proto.ConvertTimeRequest) (*proto.ConvertTimeResponse, error) {
+	var response proto.ConvertTimeResponse
+	fromID, err := parseWarehouseID(in.GetFromWarehouseId(), "from_warehouse_id")
+	if err != nil {
+		return nil, err
+	}
+	toID, err := parseWarehouseID(in.GetToWarehouseId(), "to_warehouse_id")
+	if err != nil {
+		return nil, err
+	}
+	wall, err := time.Parse(wallClockLayout, in.GetLocalTime())
+	if err != nil {
+		return nil, invalidArgument("local_time must be YYYY-MM-DDTHH:MM:SS", "local_time")
+	}
+	from, to, err := ts.service.Convert(ctx, fromID, toID, wall)
+	if err != nil {
+		return nil, handleTimeError(err)
+	}
+	response.SetFrom(mapLocalTimeToProto(from))
+	response.SetTo(mapLocalTimeToProto(to))
+	return &response, nil
+}
+
+func parseWarehouseID(value, field string) (*guid.Guid, error) {
+	id, err := guid.ParseString(value)
+	if err != nil {
+		return nil, invalidArgument(err.Error(), field)
+	}
+	return id, nil
+}
+
+func invalidArgument(message, field string) error {
+	return protoerr.InvalidArgument(message, &protoerr.ValidationError{
+		Message: message,
+		Members: []string{field},
+	})
+}
+
+func handleTimeError(err error) error {
+	if errors.Is(err, usecase.ErrTimeZoneNotSet) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	var daltyErr *daltyerrors.DaltyError
+	if errors.As(err, &daltyErr) {
+		return protoerr.Handle(daltyErr)
+	}
+	return status.Error(codes.Internal, err.Error())
+}
+
+func mapLocalTimeToProto(local *usecase.LocalTime) *proto.LocalTime {
+	var result proto.LocalTime
+	result.SetWarehouseId(local.Warehouse.ID.String())
+	result.SetTimeZone(local.Time.Location().String())
+	result.SetTime(timestamppb.New(local.Time))
+	result.SetLocalTime(local.Time.Format(time.RFC3339))
+	return &result
+}
@@ -0,0 +1,97 @@
+package usecase
+
+import (
+	"context"
+	"sort"
+
+	"github.com/DimKa163/dalty/internal/warehouse/core"
+	"github.com/DimKa163/dalty/pkg/daltyerrors"
+	graph2 "github.com/DimKa163/dalty/pkg/graph"
+	"github.com/beevik/guid"
+)
+
+// Impact lists the warehouses that have no chain to a CENTRAL warehouse
+// bypassing Warehouse. They lose supply once it is removed.
+type Impact struct {
+	Warehouse  *core.Warehouse
+	Dependents []*core.Warehouse
+}
+
+type ImpactService struct {
+	graphContext *graph2.GraphContext
+}
+
+func NewImpactService(graphContext *graph2.GraphContext) *ImpactService {
+	return &ImpactService{graphContext: graphContext}
+}
+
+func (is *ImpactService) Impact(ctx context.Context, id *guid.Guid) (*Impact, error) {
+	tree, gr, err := is.dominators(ctx)
+	if err != nil {
+		return nil, err
+	}
+	node, ok := gr.Find(id.String())
+	if !ok {
+		return nil, daltyerrors.New(6, &daltyerrors.EntityError{ID: id.String(), EntityName: "warehouse"})
+	}
+	return newImpact(node, tree.Dominated(node)), nil
+}
+
+// SinglePointsOfFailure returns every warehouse whose removal cuts at least
+// one other warehouse off from all CENTRAL warehouses, the most critical
+// first.
+func (is *ImpactService) SinglePointsOfFailure(ctx context.Context) ([]*Impact, error) {
+	tree, gr, err := is.dominators(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*Impact, 0)
+	for node := range gr.Nodes() {
+		dependents := tree.Dominated(node)
+		if len(dependents) == 0 {
+			continue
+		}
+		result = append(result, newImpact(node, dependents))
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if len(result[i].Dependents) != len(result[j].Dependents) {
+			return len(result[i].Dependents) > len(result[j].Dependents)
+		}
+		return result[i].Warehouse.Name < result[j].Warehouse.Name
+	})
+	return result, nil
+}
+
+func (is *ImpactService) dominators(ctx context.Context) (*graph2.DominatorTree, *graph2.Graph, error) {
+	_, gr, err := is.graphContext.Pin(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if gr == nil {
+		return nil, nil, ErrGraphNotLoaded
+	}
+	roots := make([]*graph2.Node, 0)
+	for node := range gr.Nodes() {
+		if w, ok := node.Value.(*core.Warehouse); ok && w.Type == core.NodeCenter {
+			roots = append(roots, node)
+		}
+	}
+	if len(roots) == 0 {
+		return nil, nil, daltyerrors.New(32)
+	}
+	return graph2.Dominators(gr, roots), gr, nil
+}
+
+func newImpact(node *graph2.Node, dependents []*graph2.Node) *Impact {
+	impact := &Impact{
+		Warehouse:  node.Value.(*core.Warehouse),
+		Dependents: make([]*core.Warehouse, len(dependents)),
+	}
+	for i, d := range dependents {
+		impact.Dependents[i] = d.Value.(*core.Warehouse)
+	}
+	sort.Slice(impact.Dependents, func(i, j int) bool {
+		return impact.Dependents[i].Name < impact.Dependents[j].Name
+	})
+	return impact
+}
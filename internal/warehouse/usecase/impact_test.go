@@ -0,0 +1,41 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DimKa163/dalty/internal/warehouse/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImpactSinglePointsOfFailure(t *testing.T) {
+	ctx := context.Background()
+	central := newTestWarehouse("central", core.NodeCenter)
+	reserveCentral := newTestWarehouse("reserve central", core.NodeCenter)
+	transit := newTestWarehouse("transit", core.NodeTransit)
+	hub := newTestWarehouse("hub", core.NodeTransit)
+	store := newTestWarehouse("store", core.NodeMall)
+	hubStore := newTestWarehouse("hub store", core.NodeMall)
+	pathService := newTestPathService(
+		[]*core.Warehouse{central, reserveCentral, transit, hub, store, hubStore},
+		[][2]*core.Warehouse{
+			{central, transit}, {transit, store},
+			{central, hub}, {reserveCentral, hub}, {hub, hubStore},
+		},
+	)
+	sut := NewImpactService(pathService.graphContext)
+
+	impact, err := sut.Impact(ctx, &transit.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, []*core.Warehouse{store}, impact.Dependents)
+
+	impact, err = sut.Impact(ctx, &reserveCentral.ID)
+	assert.NoError(t, err)
+	assert.Empty(t, impact.Dependents)
+
+	report, err := sut.SinglePointsOfFailure(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(report))
+	assert.Equal(t, central, report[0].Warehouse)
+	assert.Equal(t, []*core.Warehouse{store, transit}, report[0].Dependents)
+}
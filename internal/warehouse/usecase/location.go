@@ -0,0 +1,119 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/DimKa163/dalty/internal/logging"
+	"github.com/DimKa163/dalty/internal/warehouse/core"
+	"github.com/DimKa163/dalty/pkg/daltyerrors"
+	"github.com/DimKa163/dalty/pkg/geo"
+	graph2 "github.com/DimKa163/dalty/pkg/graph"
+	"go.uber.org/zap"
+)
+
+const defaultNearestLimit = 10
+
+type NearestQuery struct {
+	Location          geo.Point
+	Limit             int
+	MaxDistance       float64
+	Types             []core.WarehouseType
+	OnlyAvailableRest bool
+	DescriptorGroup   string
+}
+
+type NearestWarehouse struct {
+	Warehouse *core.Warehouse
+	Distance  float64
+}
+
+type LocationService struct {
+	graphContext *graph2.GraphContext
+	indexContext *geo.IndexContext
+}
+
+func NewLocationService(graphContext *graph2.GraphContext, indexContext *geo.IndexContext) *LocationService {
+	return &LocationService{graphContext: graphContext, indexContext: indexContext}
+}
+
+func (ls *LocationService) FindNearest(ctx context.Context, query *NearestQuery) ([]*NearestWarehouse, error) {
+	if !query.Location.Valid() {
+		return nil, daltyerrors.New(2)
+	}
+	index, err := ls.indexContext.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if index == nil {
+		return make([]*NearestWarehouse, 0), nil
+	}
+	limit := query.Limit
+	if limit <= 0 {
+		limit = defaultNearestLimit
+	}
+	neighbors := index.Nearest(query.Location, limit, query.MaxDistance, func(it *geo.Item) bool {
+		w, ok := geo.Cast[*core.Warehouse](it)
+		return ok && query.match(w)
+	})
+	result := make([]*NearestWarehouse, len(neighbors))
+	for i, n := range neighbors {
+		w, _ := geo.Cast[*core.Warehouse](n.Item)
+		result[i] = &NearestWarehouse{Warehouse: w, Distance: n.Distance}
+	}
+	return result, nil
+}
+
+// UpdateIndex indexes the located warehouses of the current graph. It has to
+// run after every graph update, so that the index finds the same warehouses
+// the graph routes.
+func (ls *LocationService) UpdateIndex(ctx context.Context) error {
+	logger := logging.Logger(ctx)
+	logger.Info("start to update warehouse location index")
+	startTime := time.Now()
+	gr, err := ls.graphContext.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if gr == nil {
+		return ErrGraphNotLoaded
+	}
+	items := make([]*geo.Item, 0)
+	total := 0
+	for node := range gr.Nodes() {
+		w, ok := node.Value.(*core.Warehouse)
+		if !ok {
+			continue
+		}
+		total++
+		if w.Info == nil || w.Info.Location == nil {
+			continue
+		}
+		items = append(items, &geo.Item{
+			ID:       w.ID.String(),
+			Location: *w.Info.Location,
+			Value:    w,
+		})
+	}
+	ls.indexContext.Update(geo.NewKDTree(items))
+	elapsed := time.Since(startTime)
+	logger.Info("warehouse location index updated successfully",
+		zap.Int("located", len(items)),
+		zap.Int("total", total),
+		zap.Duration("elapsed", elapsed))
+	return nil
+}
+
+func (q *NearestQuery) match(w *core.Warehouse) bool {
+	if len(q.Types) > 0 && !slices.Contains(q.Types, w.Type) {
+		return false
+	}
+	if q.OnlyAvailableRest && !w.AvailableForBalance {
+		return false
+	}
+	if q.DescriptorGroup != "" && (w.Info == nil || w.Info.DescriptorGroup != q.DescriptorGroup) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DimKa163/dalty/internal/logging"
+	"github.com/DimKa163/dalty/internal/warehouse/core"
+	"github.com/DimKa163/dalty/pkg/geo"
+	"github.com/DimKa163/dalty/pkg/graph"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateIndexFollowsGraph(t *testing.T) {
+	ctx := context.Background()
+	assert.NoError(t, logging.InitializeLogging(&logging.LogConfiguration{}))
+	newLocatedWarehouse := func(name string, latitude, longitude float64) *core.Warehouse {
+		w := newTestWarehouse(name, core.NodeMall)
+		location := geo.NewPoint(latitude, longitude)
+		w.Info = &core.WarehouseInfo{Location: &location}
+		return w
+	}
+	moscow := newLocatedWarehouse("moscow", 55.75, 37.62)
+	kazan := newLocatedWarehouse("kazan", 55.79, 49.12)
+	pathService := newTestPathService([]*core.Warehouse{moscow}, nil)
+	sut := NewLocationService(pathService.graphContext, geo.NewIndexContext())
+	assert.NoError(t, sut.UpdateIndex(ctx))
+	query := &NearestQuery{Location: geo.NewPoint(55.8, 49.1), Limit: 1}
+
+	found, err := sut.FindNearest(ctx, query)
+	assert.NoError(t, err)
+	assert.Equal(t, moscow, found[0].Warehouse)
+
+	gr := graph.NewGraph()
+	gr.AddNode(createNode(moscow))
+	gr.AddNode(createNode(kazan))
+	pathService.graphContext.Update(gr)
+	assert.NoError(t, sut.UpdateIndex(ctx))
+
+	found, err = sut.FindNearest(ctx, query)
+	assert.NoError(t, err)
+	assert.Equal(t, kazan, found[0].Warehouse)
+}
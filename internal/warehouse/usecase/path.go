@@ -3,16 +3,20 @@ package usecase
 import (
 	"context"
 	"errors"
+	"sort"
 	"time"
 
 	graph2 "github.com/DimKa163/dalty/pkg/graph"
 
 	"github.com/DimKa163/dalty/internal/logging"
 	"github.com/DimKa163/dalty/internal/warehouse/core"
+	"github.com/DimKa163/dalty/pkg/daltyerrors"
 	"github.com/beevik/guid"
 	"go.uber.org/zap"
 )
 
+var ErrGraphNotLoaded = errors.New("warehouse graph is not loaded")
+
 type PathService struct {
 	warehouseRepository core.WarehouseRepository
 	pathFinder          *core.PathFinder
@@ -23,27 +27,199 @@ func NewPathService(warehouseRepository core.WarehouseRepository, pathFinder *co
 	return &PathService{warehouseRepository: warehouseRepository, pathFinder: pathFinder, graphContext: graphContext}
 }
 
-func (ps *PathService) GetPath(ctx context.Context, dest, defaultWh *guid.Guid) (*core.Path, error) {
-	gr, err := ps.graphContext.Get(ctx)
+func (ps *PathService) GetPath(ctx context.Context, dest *guid.Guid, candidates []*guid.Guid,
+	opts core.PathOptions) (*core.PathResult, error) {
+	ctx, gr, err := ps.graphContext.Pin(ctx)
 	if err != nil {
 		return nil, err
 	}
-	node, ok := gr.Find(dest.String())
+	if gr == nil {
+		return nil, ErrGraphNotLoaded
+	}
+	return ps.GetPathIn(ctx, gr, dest, candidates, opts)
+}
+
+// GetPathIn builds the chain for dest and picks the first fallback candidate
+// that shares a chain with it. A candidate is accepted when the destination
+// chain contains it or when its own chain contains the destination; otherwise
+// it is rejected and the next one is tried. Warehouses outside the descriptor
+// group of the options are invisible to routing, and so are warehouses that
+// can't handle the pack.
+func (ps *PathService) GetPathIn(ctx context.Context, view graph2.View, dest *guid.Guid, candidates []*guid.Guid,
+	opts core.PathOptions) (*core.PathResult, error) {
+	node, ok := view.Find(dest.String())
 	if !ok {
-		return nil, errors.New("dest not found")
+		return nil, daltyerrors.New(6, &daltyerrors.EntityError{ID: dest.String(), EntityName: "warehouse"})
+	}
+	scoped := opts.Scope.Restrict(view, node)
+	if _, ok = scoped.Find(node.ID); !ok {
+		return nil, daltyerrors.New(6, &daltyerrors.EntityError{ID: dest.String(), EntityName: "warehouse"})
 	}
-	path, err := ps.pathFinder.Path(ctx, node)
+	routable := opts.Fitting(scoped)
+	if _, ok = routable.Find(node.ID); !ok {
+		return nil, daltyerrors.New(61, &daltyerrors.EntityError{ID: dest.String(), EntityName: "warehouse"})
+	}
+	path, err := ps.pathFinder.PathIn(ctx, routable, node)
 	if err != nil {
 		return nil, err
 	}
-	if !path.Contains(defaultWh.String()) {
-		node, _ = gr.Find(defaultWh.String())
-		path, err = ps.pathFinder.Path(ctx, node)
+	result := &core.PathResult{
+		Path:     path,
+		Rejected: make([]*core.RejectedCandidate, 0),
+	}
+	if len(candidates) == 0 {
+		return ps.withOversized(ctx, scoped, node, opts.Pack, result)
+	}
+	for _, candidate := range candidates {
+		id := candidate.String()
+		if path.Contains(id) {
+			result.UsedWarehouseID = id
+			return ps.withOversized(ctx, scoped, node, opts.Pack, result)
+		}
+		candidateNode, ok := routable.Find(id)
+		if !ok {
+			result.Rejected = append(result.Rejected, &core.RejectedCandidate{ID: id, Reason: rejectReason(id, view, scoped)})
+			continue
+		}
+		candidatePath, err := ps.pathFinder.PathIn(ctx, routable, candidateNode)
 		if err != nil {
 			return nil, err
 		}
+		if !candidatePath.Contains(node.ID) {
+			result.Rejected = append(result.Rejected, &core.RejectedCandidate{ID: id, Reason: core.RejectReasonDestinationNotInChain})
+			continue
+		}
+		result.Path = candidatePath
+		result.UsedWarehouseID = id
+		return ps.withOversized(ctx, scoped, candidateNode, opts.Pack, result)
+	}
+	entityErrors := make([]*daltyerrors.EntityError, len(result.Rejected))
+	for i, rejected := range result.Rejected {
+		entityErrors[i] = &daltyerrors.EntityError{ID: rejected.ID, EntityName: "warehouse"}
+	}
+	return nil, daltyerrors.New(35, entityErrors...)
+}
+
+// rejectReason tells why a candidate is missing from the routable view.
+func rejectReason(id string, view, scoped graph2.View) core.RejectReason {
+	if _, ok := scoped.Find(id); ok {
+		return core.RejectReasonOversize
+	}
+	if _, ok := view.Find(id); ok {
+		return core.RejectReasonOutsideGroup
+	}
+	return core.RejectReasonNotFound
+}
+
+// withOversized reports the warehouses of the chain from start that were left
+// out because they can't handle the pack.
+func (ps *PathService) withOversized(ctx context.Context, view graph2.View, start *graph2.Node, pack *core.Pack,
+	result *core.PathResult) (*core.PathResult, error) {
+	result.Oversized = make([]*core.Warehouse, 0)
+	if pack == nil {
+		return result, nil
+	}
+	path, err := ps.pathFinder.PathIn(ctx, view, start)
+	if err != nil {
+		return nil, err
+	}
+	for _, n := range path.GetList() {
+		w, ok := n.Value.(*core.Warehouse)
+		if ok && !w.Limits.Fits(pack) {
+			result.Oversized = append(result.Oversized, w)
+		}
+	}
+	return result, nil
+}
+
+// GetDeliveryPath finds the Pareto front of routes from one warehouse to
+// another and picks the best of them for the objective. When allowed is not
+// empty, routes may pass only through the listed warehouses.
+func (ps *PathService) GetDeliveryPath(ctx context.Context, from, to *guid.Guid, allowed []*guid.Guid,
+	objective core.Objective) (*core.DeliveryPlan, error) {
+	gr, err := ps.graphContext.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if gr == nil {
+		return nil, ErrGraphNotLoaded
+	}
+	var view graph2.View = gr
+	if len(allowed) > 0 {
+		keep := make(map[string]bool, len(allowed)+2)
+		keep[from.String()] = true
+		keep[to.String()] = true
+		for _, id := range allowed {
+			keep[id.String()] = true
+		}
+		view = graph2.NewSubgraph(gr, func(n *graph2.Node) bool {
+			return keep[n.ID]
+		})
+	}
+	source, ok := view.Find(from.String())
+	if !ok {
+		return nil, daltyerrors.New(6, &daltyerrors.EntityError{ID: from.String(), EntityName: "warehouse"})
 	}
-	return path, nil
+	target, ok := view.Find(to.String())
+	if !ok {
+		return nil, daltyerrors.New(6, &daltyerrors.EntityError{ID: to.String(), EntityName: "warehouse"})
+	}
+	front := graph2.ParetoPaths(view, source, target)
+	plan := &core.DeliveryPlan{
+		Source:       source,
+		Alternatives: make([]*graph2.ParetoPath, 0, len(front)),
+	}
+	best := objective.Pick(front)
+	for i, p := range front {
+		if i == best {
+			plan.Best = p
+			continue
+		}
+		plan.Alternatives = append(plan.Alternatives, p)
+	}
+	return plan, nil
+}
+
+// GroupStatistics counts nodes and edges of every descriptor group. An edge
+// between two groups is counted as cross-group for both of them.
+func (ps *PathService) GroupStatistics(ctx context.Context) ([]*core.GroupStatistics, error) {
+	gr, err := ps.graphContext.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if gr == nil {
+		return nil, ErrGraphNotLoaded
+	}
+	groups := make(map[string]*core.GroupStatistics)
+	stats := func(group string) *core.GroupStatistics {
+		s, ok := groups[group]
+		if !ok {
+			s = &core.GroupStatistics{Group: group}
+			groups[group] = s
+		}
+		return s
+	}
+	for node := range gr.Nodes() {
+		group := core.DescriptorGroupOf(node)
+		stats(group).Nodes++
+		for edge := range gr.Outgoing(node) {
+			other := core.DescriptorGroupOf(edge.To)
+			if other == group {
+				stats(group).Edges++
+				continue
+			}
+			stats(group).CrossGroupEdges++
+			stats(other).CrossGroupEdges++
+		}
+	}
+	result := make([]*core.GroupStatistics, 0, len(groups))
+	for _, s := range groups {
+		result = append(result, s)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Group < result[j].Group
+	})
+	return result, nil
 }
 
 func (ps *PathService) UpdateGraph(ctx context.Context) error {
@@ -56,6 +232,16 @@ func (ps *PathService) UpdateGraph(ctx context.Context) error {
 		logger.Error("error occurred when GetAll Warehouses", zap.Error(err))
 		return err
 	}
+	// transit costs only rank routes, a graph without them still routes
+	transits, err := ps.warehouseRepository.GetTransits(ctx)
+	if err != nil {
+		logger.Error("error occurred when GetTransits, building graph without transit costs", zap.Error(err))
+		transits = nil
+	}
+	costs := make(map[[2]string]graph2.Cost, len(transits))
+	for _, t := range transits {
+		costs[[2]string{t.SenderID.String(), t.RecipientID.String()}] = graph2.Cost{Time: t.Hours, Money: t.Cost}
+	}
 	warehouseMap := make(map[string]*core.Warehouse)
 	for _, w := range warehouses {
 		warehouseMap[w.ID.String()] = w
@@ -73,7 +259,7 @@ func (ps *PathService) UpdateGraph(ctx context.Context) error {
 			}
 			sender := createNode(wS)
 			gr.AddNode(sender)
-			gr.AddEdge(sender, node, 0)
+			gr.AddEdgeWithCost(sender, node, 0, costs[[2]string{sender.ID, node.ID}])
 			loggerSug.Debugf("%s send to %s", wS.Name, w.Name)
 		}
 		if w.RecipientID != nil {
@@ -84,7 +270,7 @@ func (ps *PathService) UpdateGraph(ctx context.Context) error {
 			}
 			recipient := createNode(wR)
 			gr.AddNode(recipient)
-			gr.AddEdge(node, recipient, 0)
+			gr.AddEdgeWithCost(node, recipient, 0, costs[[2]string{node.ID, recipient.ID}])
 			loggerSug.Debugf("%s send to %s", w.Name, wR.Name)
 		}
 	}
@@ -0,0 +1,252 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DimKa163/dalty/internal/logging"
+	"github.com/DimKa163/dalty/internal/warehouse/core"
+	"github.com/DimKa163/dalty/internal/warehouse/mocks"
+	"github.com/DimKa163/dalty/pkg/daltyerrors"
+	"github.com/DimKa163/dalty/pkg/graph"
+	"github.com/beevik/guid"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestWarehouse(name string, tp core.WarehouseType) *core.Warehouse {
+	return &core.Warehouse{
+		ID:   *guid.New(),
+		Name: name,
+		Type: tp,
+	}
+}
+
+func newTestPathService(warehouses []*core.Warehouse, edges [][2]*core.Warehouse) *PathService {
+	gr := graph.NewGraph()
+	for _, w := range warehouses {
+		gr.AddNode(createNode(w))
+	}
+	for _, e := range edges {
+		gr.AddEdge(createNode(e[0]), createNode(e[1]), 0)
+	}
+	graphContext := graph.NewGraphContext()
+	graphContext.Update(gr)
+	return NewPathService(nil, core.NewPathFinder(graphContext), graphContext)
+}
+
+func TestGetPathFallbackChain(t *testing.T) {
+	ctx := context.Background()
+	central := newTestWarehouse("central", core.NodeCenter)
+	store := newTestWarehouse("store", core.NodeMall)
+	otherCentral := newTestWarehouse("other central", core.NodeCenter)
+	otherStore := newTestWarehouse("other store", core.NodeMall)
+	sut := newTestPathService(
+		[]*core.Warehouse{central, store, otherCentral, otherStore},
+		[][2]*core.Warehouse{{central, store}, {otherCentral, otherStore}},
+	)
+	unknown := guid.New()
+
+	result, err := sut.GetPath(ctx, &store.ID, []*guid.Guid{unknown, &otherStore.ID, &central.ID}, core.PathOptions{})
+
+	assert.NoError(t, err)
+	assert.Equal(t, central.ID.String(), result.UsedWarehouseID)
+	assert.Equal(t, 2, result.Path.Len())
+	assert.Equal(t, []*core.RejectedCandidate{
+		{ID: unknown.String(), Reason: core.RejectReasonNotFound},
+		{ID: otherStore.ID.String(), Reason: core.RejectReasonDestinationNotInChain},
+	}, result.Rejected)
+}
+
+func TestGetPathUsesCandidateChain(t *testing.T) {
+	ctx := context.Background()
+	central := newTestWarehouse("central", core.NodeCenter)
+	transit := newTestWarehouse("transit", core.NodeTransit)
+	store := newTestWarehouse("store", core.NodeMall)
+	sut := newTestPathService(
+		[]*core.Warehouse{central, transit, store},
+		[][2]*core.Warehouse{{central, transit}, {transit, store}},
+	)
+
+	result, err := sut.GetPath(ctx, &transit.ID, []*guid.Guid{&store.ID}, core.PathOptions{})
+
+	assert.NoError(t, err)
+	assert.Equal(t, store.ID.String(), result.UsedWarehouseID)
+	assert.Equal(t, 3, result.Path.Len())
+	assert.Empty(t, result.Rejected)
+}
+
+func TestGetPathAllCandidatesRejected(t *testing.T) {
+	ctx := context.Background()
+	store := newTestWarehouse("store", core.NodeMall)
+	sut := newTestPathService([]*core.Warehouse{store}, nil)
+
+	result, err := sut.GetPath(ctx, &store.ID, []*guid.Guid{guid.New()}, core.PathOptions{})
+
+	assert.Nil(t, result)
+	var daltyErr *daltyerrors.DaltyError
+	assert.ErrorAs(t, err, &daltyErr)
+	assert.Equal(t, 35, daltyErr.Code)
+	assert.Equal(t, 1, len(daltyErr.EntityErrors))
+}
+
+func TestGetPathDestinationNotFound(t *testing.T) {
+	ctx := context.Background()
+	sut := newTestPathService(nil, nil)
+
+	_, err := sut.GetPath(ctx, guid.New(), nil, core.PathOptions{})
+
+	var daltyErr *daltyerrors.DaltyError
+	assert.ErrorAs(t, err, &daltyErr)
+	assert.Equal(t, 6, daltyErr.Code)
+}
+
+func TestGetPathDescriptorGroup(t *testing.T) {
+	ctx := context.Background()
+	newGroupWarehouse := func(name string, tp core.WarehouseType, group string) *core.Warehouse {
+		w := newTestWarehouse(name, tp)
+		w.Info = &core.WarehouseInfo{DescriptorGroup: group}
+		return w
+	}
+	central := newGroupWarehouse("central", core.NodeCenter, "brand")
+	partnerCentral := newGroupWarehouse("partner central", core.NodeCenter, "partner")
+	transit := newGroupWarehouse("transit", core.NodeTransit, "brand")
+	store := newGroupWarehouse("store", core.NodeMall, "brand")
+	sut := newTestPathService(
+		[]*core.Warehouse{central, partnerCentral, transit, store},
+		[][2]*core.Warehouse{{central, transit}, {partnerCentral, transit}, {transit, store}},
+	)
+
+	result, err := sut.GetPath(ctx, &store.ID, []*guid.Guid{&partnerCentral.ID, &central.ID}, core.PathOptions{})
+
+	assert.NoError(t, err)
+	assert.False(t, result.Path.Contains(partnerCentral.ID.String()))
+	assert.Equal(t, central.ID.String(), result.UsedWarehouseID)
+	assert.Equal(t, core.RejectReasonOutsideGroup, result.Rejected[0].Reason)
+
+	result, err = sut.GetPath(ctx, &store.ID, []*guid.Guid{&partnerCentral.ID}, core.PathOptions{Scope: core.GroupScope{AllowCrossGroup: true}})
+
+	assert.NoError(t, err)
+	assert.Equal(t, partnerCentral.ID.String(), result.UsedWarehouseID)
+
+	_, err = sut.GetPath(ctx, &store.ID, nil, core.PathOptions{Scope: core.GroupScope{Group: "partner"}})
+
+	var daltyErr *daltyerrors.DaltyError
+	assert.ErrorAs(t, err, &daltyErr)
+	assert.Equal(t, 6, daltyErr.Code)
+
+	statistics, err := sut.GroupStatistics(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []*core.GroupStatistics{
+		{Group: "brand", Nodes: 3, Edges: 2, CrossGroupEdges: 1},
+		{Group: "partner", Nodes: 1, Edges: 0, CrossGroupEdges: 1},
+	}, statistics)
+}
+
+func TestGetPathOversize(t *testing.T) {
+	ctx := context.Background()
+	central := newTestWarehouse("central", core.NodeCenter)
+	transit := newTestWarehouse("transit", core.NodeTransit)
+	smallTransit := newTestWarehouse("small transit", core.NodeTransit)
+	smallTransit.Limits = &core.HandlingLimits{MaxLength: 1.2, MaxWidth: 0.8, MaxHeight: 1, MaxWeight: 50}
+	store := newTestWarehouse("store", core.NodeMall)
+	sut := newTestPathService(
+		[]*core.Warehouse{central, transit, smallTransit, store},
+		[][2]*core.Warehouse{{central, transit}, {central, smallTransit}, {transit, store}, {smallTransit, store}},
+	)
+	// fits only when laid on its side
+	wardrobe := &core.Pack{Length: 0.7, Width: 1.1, Height: 0.9, Weight: 40}
+
+	result, err := sut.GetPath(ctx, &store.ID, []*guid.Guid{&central.ID}, core.PathOptions{Pack: wardrobe})
+
+	assert.NoError(t, err)
+	assert.True(t, result.Path.Contains(smallTransit.ID.String()))
+	assert.Empty(t, result.Oversized)
+
+	sofa := &core.Pack{Length: 2.1, Width: 0.9, Height: 0.8, Weight: 70}
+
+	result, err = sut.GetPath(ctx, &store.ID, []*guid.Guid{&smallTransit.ID, &central.ID}, core.PathOptions{Pack: sofa})
+
+	assert.NoError(t, err)
+	assert.False(t, result.Path.Contains(smallTransit.ID.String()))
+	assert.True(t, result.Path.Contains(transit.ID.String()))
+	assert.Equal(t, []*core.Warehouse{smallTransit}, result.Oversized)
+	assert.Equal(t, core.RejectReasonOversize, result.Rejected[0].Reason)
+	assert.Equal(t, central.ID.String(), result.UsedWarehouseID)
+}
+
+func TestGetDeliveryPathObjective(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+	assert.NoError(t, logging.InitializeLogging(&logging.LogConfiguration{}))
+	central := newTestWarehouse("central", core.NodeCenter)
+	air := newTestWarehouse("air", core.NodeTransit)
+	truck := newTestWarehouse("truck", core.NodeTransit)
+	store := newTestWarehouse("store", core.NodeMall)
+	air.SenderID, air.RecipientID = &central.ID, &store.ID
+	truck.SenderID, truck.RecipientID = &central.ID, &store.ID
+	store.SenderID = &central.ID
+	mockWarehouseRepository := mocks.NewMockWarehouseRepository(ctrl)
+	mockWarehouseRepository.EXPECT().GetAll(ctx).Return([]*core.Warehouse{central, air, truck, store}, nil)
+	mockWarehouseRepository.EXPECT().GetTransits(ctx).Return([]*core.Transit{
+		{SenderID: central.ID, RecipientID: air.ID, Hours: 2, Cost: 100},
+		{SenderID: air.ID, RecipientID: store.ID, Hours: 2, Cost: 100},
+		{SenderID: central.ID, RecipientID: truck.ID, Hours: 24, Cost: 10},
+		{SenderID: truck.ID, RecipientID: store.ID, Hours: 24, Cost: 10},
+		{SenderID: central.ID, RecipientID: store.ID, Hours: 10, Cost: 60},
+	}, nil)
+	graphContext := graph.NewGraphContext()
+	sut := NewPathService(mockWarehouseRepository, core.NewPathFinder(graphContext), graphContext)
+	assert.NoError(t, sut.UpdateGraph(ctx))
+
+	plan, err := sut.GetDeliveryPath(ctx, &central.ID, &store.ID, nil, core.ObjectiveFastest)
+	assert.NoError(t, err)
+	assert.Equal(t, graph.Cost{Time: 4, Money: 200}, plan.Best.Cost)
+	assert.Equal(t, 2, len(plan.Alternatives))
+
+	plan, err = sut.GetDeliveryPath(ctx, &central.ID, &store.ID, nil, core.ObjectiveCheapest)
+	assert.NoError(t, err)
+	assert.Equal(t, graph.Cost{Time: 48, Money: 20}, plan.Best.Cost)
+
+	plan, err = sut.GetDeliveryPath(ctx, &central.ID, &store.ID, nil, core.ObjectiveFewestHops)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, plan.Best.Hops())
+
+	plan, err = sut.GetDeliveryPath(ctx, &central.ID, &store.ID, nil, core.ObjectiveBalanced)
+	assert.NoError(t, err)
+	assert.Equal(t, graph.Cost{Time: 10, Money: 60}, plan.Best.Cost)
+
+	plan, err = sut.GetDeliveryPath(ctx, &central.ID, &store.ID, []*guid.Guid{&truck.ID}, core.ObjectiveFastest)
+	assert.NoError(t, err)
+	assert.Equal(t, graph.Cost{Time: 10, Money: 60}, plan.Best.Cost)
+	assert.Equal(t, 1, len(plan.Alternatives))
+
+	plan, err = sut.GetDeliveryPath(ctx, &store.ID, &central.ID, nil, core.ObjectiveFastest)
+	assert.NoError(t, err)
+	assert.Nil(t, plan.Best)
+}
+
+func TestUpdateGraphWithoutTransits(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+	assert.NoError(t, logging.InitializeLogging(&logging.LogConfiguration{}))
+	central := newTestWarehouse("central", core.NodeCenter)
+	store := newTestWarehouse("store", core.NodeMall)
+	store.SenderID = &central.ID
+	mockWarehouseRepository := mocks.NewMockWarehouseRepository(ctrl)
+	mockWarehouseRepository.EXPECT().GetAll(ctx).Return([]*core.Warehouse{central, store}, nil)
+	mockWarehouseRepository.EXPECT().GetTransits(ctx).Return(nil, errors.New("relation does not exist"))
+	graphContext := graph.NewGraphContext()
+	sut := NewPathService(mockWarehouseRepository, core.NewPathFinder(graphContext), graphContext)
+
+	assert.NoError(t, sut.UpdateGraph(ctx))
+
+	plan, err := sut.GetDeliveryPath(ctx, &central.ID, &store.ID, nil, core.ObjectiveFastest)
+	assert.NoError(t, err)
+	assert.Equal(t, graph.Cost{}, plan.Best.Cost)
+	assert.Equal(t, 1, plan.Best.Hops())
+}
@@ -0,0 +1,126 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+
+	"github.com/DimKa163/dalty/internal/warehouse/core"
+	"github.com/DimKa163/dalty/pkg/daltyerrors"
+	graph2 "github.com/DimKa163/dalty/pkg/graph"
+	"github.com/beevik/guid"
+)
+
+var ErrScenarioNameRequired = errors.New("scenario name is required")
+
+// Simulation holds the path for the live graph next to the path for the
+// same request with scenario changes applied. Business errors such as an
+// unknown destination or a broken chain are reported per side.
+type Simulation struct {
+	Actual       *core.PathResult
+	ActualErr    *daltyerrors.DaltyError
+	Simulated    *core.PathResult
+	SimulatedErr *daltyerrors.DaltyError
+}
+
+type SimulationService struct {
+	pathService  *PathService
+	graphContext *graph2.GraphContext
+	scenarios    map[string]*core.Scenario
+	mutex        *sync.RWMutex
+}
+
+func NewSimulationService(pathService *PathService, graphContext *graph2.GraphContext) *SimulationService {
+	return &SimulationService{
+		pathService:  pathService,
+		graphContext: graphContext,
+		scenarios:    make(map[string]*core.Scenario),
+		mutex:        &sync.RWMutex{},
+	}
+}
+
+func (ss *SimulationService) PutScenario(_ context.Context, scenario *core.Scenario) error {
+	if scenario.Name == "" {
+		return ErrScenarioNameRequired
+	}
+	ss.mutex.Lock()
+	defer ss.mutex.Unlock()
+	ss.scenarios[scenario.Name] = scenario
+	return nil
+}
+
+func (ss *SimulationService) DeleteScenario(_ context.Context, name string) bool {
+	ss.mutex.Lock()
+	defer ss.mutex.Unlock()
+	_, ok := ss.scenarios[name]
+	delete(ss.scenarios, name)
+	return ok
+}
+
+func (ss *SimulationService) Scenarios(_ context.Context) []*core.Scenario {
+	ss.mutex.RLock()
+	defer ss.mutex.RUnlock()
+	result := make([]*core.Scenario, 0, len(ss.scenarios))
+	for _, s := range ss.scenarios {
+		result = append(result, s)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+	return result
+}
+
+// SimulatePath resolves the path on the live snapshot and on an overlay of it
+// built from the named scenario and the inline changes, in that order. Either
+// of them may be empty.
+func (ss *SimulationService) SimulatePath(ctx context.Context, dest *guid.Guid, candidates []*guid.Guid,
+	opts core.PathOptions, scenarioName string, inline *core.Scenario) (*Simulation, error) {
+	ctx, gr, err := ss.graphContext.Pin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if gr == nil {
+		return nil, ErrGraphNotLoaded
+	}
+	overlay := graph2.NewOverlay(gr)
+	if scenarioName != "" {
+		ss.mutex.RLock()
+		scenario, ok := ss.scenarios[scenarioName]
+		ss.mutex.RUnlock()
+		if !ok {
+			return nil, daltyerrors.New(6, &daltyerrors.EntityError{ID: scenarioName, EntityName: "scenario"})
+		}
+		if err = scenario.Apply(overlay, gr); err != nil {
+			return nil, err
+		}
+	}
+	if inline != nil {
+		if err = inline.Apply(overlay, gr); err != nil {
+			return nil, err
+		}
+	}
+	var simulation Simulation
+	simulation.Actual, simulation.ActualErr, err = ss.getPath(ctx, gr, dest, candidates, opts)
+	if err != nil {
+		return nil, err
+	}
+	simulation.Simulated, simulation.SimulatedErr, err = ss.getPath(ctx, overlay, dest, candidates, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &simulation, nil
+}
+
+func (ss *SimulationService) getPath(ctx context.Context, view graph2.View, dest *guid.Guid,
+	candidates []*guid.Guid, opts core.PathOptions) (*core.PathResult, *daltyerrors.DaltyError, error) {
+	result, err := ss.pathService.GetPathIn(ctx, view, dest, candidates, opts)
+	if err != nil {
+		var daltyErr *daltyerrors.DaltyError
+		if errors.As(err, &daltyErr) {
+			return nil, daltyErr, nil
+		}
+		return nil, nil, err
+	}
+	return result, nil, nil
+}
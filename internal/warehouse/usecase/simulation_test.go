@@ -0,0 +1,72 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DimKa163/dalty/internal/warehouse/core"
+	"github.com/beevik/guid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSimulatePathClosedTransit(t *testing.T) {
+	ctx := context.Background()
+	central := newTestWarehouse("central", core.NodeCenter)
+	transit := newTestWarehouse("transit", core.NodeTransit)
+	reserve := newTestWarehouse("reserve transit", core.NodeTransit)
+	store := newTestWarehouse("store", core.NodeMall)
+	pathService := newTestPathService(
+		[]*core.Warehouse{central, transit, reserve, store},
+		[][2]*core.Warehouse{{central, transit}, {transit, store}, {central, reserve}},
+	)
+	sut := NewSimulationService(pathService, pathService.graphContext)
+	assert.NoError(t, sut.PutScenario(ctx, &core.Scenario{
+		Name:        "close transit",
+		MaskedNodes: []string{transit.ID.String()},
+	}))
+	inline := &core.Scenario{
+		AddedEdges: []*core.EdgeChange{{FromID: reserve.ID.String(), ToID: store.ID.String()}},
+	}
+
+	simulation, err := sut.SimulatePath(ctx, &store.ID, []*guid.Guid{&central.ID}, core.PathOptions{}, "close transit", inline)
+
+	assert.NoError(t, err)
+	assert.Nil(t, simulation.ActualErr)
+	assert.Nil(t, simulation.SimulatedErr)
+	assert.True(t, simulation.Actual.Path.Contains(transit.ID.String()))
+	assert.False(t, simulation.Simulated.Path.Contains(transit.ID.String()))
+	assert.True(t, simulation.Simulated.Path.Contains(reserve.ID.String()))
+	assert.Equal(t, central.ID.String(), simulation.Simulated.UsedWarehouseID)
+}
+
+func TestSimulatePathBrokenChain(t *testing.T) {
+	ctx := context.Background()
+	central := newTestWarehouse("central", core.NodeCenter)
+	store := newTestWarehouse("store", core.NodeMall)
+	pathService := newTestPathService(
+		[]*core.Warehouse{central, store},
+		[][2]*core.Warehouse{{central, store}},
+	)
+	sut := NewSimulationService(pathService, pathService.graphContext)
+	inline := &core.Scenario{
+		RemovedEdges: []*core.EdgeChange{{FromID: central.ID.String(), ToID: store.ID.String()}},
+	}
+
+	simulation, err := sut.SimulatePath(ctx, &store.ID, []*guid.Guid{&central.ID}, core.PathOptions{}, "", inline)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, simulation.Actual)
+	assert.Nil(t, simulation.Simulated)
+	assert.Equal(t, 35, simulation.SimulatedErr.Code)
+}
+
+func TestSimulatePathUnknownScenario(t *testing.T) {
+	ctx := context.Background()
+	store := newTestWarehouse("store", core.NodeMall)
+	pathService := newTestPathService([]*core.Warehouse{store}, nil)
+	sut := NewSimulationService(pathService, pathService.graphContext)
+
+	_, err := sut.SimulatePath(ctx, &store.ID, nil, core.PathOptions{}, "missing", nil)
+
+	assert.Error(t, err)
+}
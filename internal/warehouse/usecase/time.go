@@ -0,0 +1,174 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	"github.com/DimKa163/dalty/internal/logging"
+	"github.com/DimKa163/dalty/internal/warehouse/core"
+	"github.com/DimKa163/dalty/pkg/daltyerrors"
+	graph2 "github.com/DimKa163/dalty/pkg/graph"
+	"github.com/beevik/guid"
+	"go.uber.org/zap"
+)
+
+var ErrTimeZoneNotSet = errors.New("warehouse time zone is not set")
+
+// Clock returns the current instant. Tests replace it to freeze time.
+type Clock func() time.Time
+
+// LocalTime is an instant seen from the time zone of a warehouse.
+type LocalTime struct {
+	Warehouse *core.Warehouse
+	Time      time.Time
+}
+
+type CutOff struct {
+	Before bool
+	Now    *LocalTime
+	CutOff *LocalTime
+}
+
+type TimeService struct {
+	graphContext *graph2.GraphContext
+	zones        atomic.Pointer[map[string]*time.Location]
+	clock        Clock
+}
+
+func NewTimeService(graphContext *graph2.GraphContext, clock Clock) *TimeService {
+	return &TimeService{graphContext: graphContext, clock: clock}
+}
+
+// UpdateZones loads the IANA zone of every warehouse in the current graph.
+// Warehouses with an unknown zone are logged and left without one.
+func (ts *TimeService) UpdateZones(ctx context.Context) error {
+	logger := logging.Logger(ctx)
+	logger.Info("start to update warehouse time zones")
+	gr, err := ts.graphContext.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if gr == nil {
+		return ErrGraphNotLoaded
+	}
+	zones := make(map[string]*time.Location)
+	cache := make(map[string]*time.Location)
+	for node := range gr.Nodes() {
+		w, ok := node.Value.(*core.Warehouse)
+		if !ok || w.Info == nil || w.Info.TimeZone == nil || w.Info.TimeZone.Code == "" {
+			continue
+		}
+		code := w.Info.TimeZone.Code
+		loc, ok := cache[code]
+		if !ok {
+			loc, err = time.LoadLocation(code)
+			if err != nil {
+				logger.Warn("unknown time zone", zap.String("code", code), zap.String("node", w.Name))
+			}
+			cache[code] = loc
+		}
+		if loc != nil {
+			zones[node.ID] = loc
+		}
+	}
+	ts.zones.Store(&zones)
+	logger.Info("warehouse time zones updated successfully", zap.Int("zones", len(cache)), zap.Int("located", len(zones)))
+	return nil
+}
+
+func (ts *TimeService) LocalNow(ctx context.Context, id *guid.Guid) (*LocalTime, error) {
+	return ts.In(ctx, id, ts.clock())
+}
+
+// In returns t in the time zone of the warehouse.
+func (ts *TimeService) In(ctx context.Context, id *guid.Guid, t time.Time) (*LocalTime, error) {
+	w, loc, err := ts.location(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &LocalTime{Warehouse: w, Time: t.In(loc)}, nil
+}
+
+// IsBeforeCutOff compares the local time of the warehouse with the cut-off
+// given as a wall clock time of day, so it stays put on days the clocks
+// change.
+func (ts *TimeService) IsBeforeCutOff(ctx context.Context, id *guid.Guid, cutOff time.Duration) (*CutOff, error) {
+	now, err := ts.LocalNow(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	deadline := atTimeOfDay(now.Time, cutOff)
+	return &CutOff{
+		Before: now.Time.Before(deadline),
+		Now:    now,
+		CutOff: &LocalTime{Warehouse: now.Warehouse, Time: deadline},
+	}, nil
+}
+
+// NextBusinessDay returns local midnight of the first working day after the
+// local date of from. Saturdays and Sundays are days off.
+func (ts *TimeService) NextBusinessDay(ctx context.Context, id *guid.Guid, from time.Time) (*LocalTime, error) {
+	local, err := ts.In(ctx, id, from)
+	if err != nil {
+		return nil, err
+	}
+	day := startOfDay(local.Time).AddDate(0, 0, 1)
+	for day.Weekday() == time.Saturday || day.Weekday() == time.Sunday {
+		day = day.AddDate(0, 0, 1)
+	}
+	return &LocalTime{Warehouse: local.Warehouse, Time: day}, nil
+}
+
+// Convert reads wall clock as the local time of the source warehouse and
+// returns the same instant in both time zones.
+func (ts *TimeService) Convert(ctx context.Context, fromID, toID *guid.Guid, wall time.Time) (*LocalTime, *LocalTime, error) {
+	fromWarehouse, fromLoc, err := ts.location(ctx, fromID)
+	if err != nil {
+		return nil, nil, err
+	}
+	instant := time.Date(wall.Year(), wall.Month(), wall.Day(), wall.Hour(), wall.Minute(), wall.Second(),
+		wall.Nanosecond(), fromLoc)
+	to, err := ts.In(ctx, toID, instant)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &LocalTime{Warehouse: fromWarehouse, Time: instant}, to, nil
+}
+
+func (ts *TimeService) location(ctx context.Context, id *guid.Guid) (*core.Warehouse, *time.Location, error) {
+	gr, err := ts.graphContext.Get(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if gr == nil {
+		return nil, nil, ErrGraphNotLoaded
+	}
+	node, ok := gr.Find(id.String())
+	if !ok {
+		return nil, nil, daltyerrors.New(6, &daltyerrors.EntityError{ID: id.String(), EntityName: "warehouse"})
+	}
+	w := node.Value.(*core.Warehouse)
+	zones := ts.zones.Load()
+	if zones == nil {
+		return nil, nil, ErrTimeZoneNotSet
+	}
+	loc, ok := (*zones)[node.ID]
+	if !ok {
+		return nil, nil, ErrTimeZoneNotSet
+	}
+	return w, loc, nil
+}
+
+func startOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+// atTimeOfDay returns the wall clock time offset from midnight on the date of t.
+func atTimeOfDay(t time.Time, offset time.Duration) time.Time {
+	hours := int(offset / time.Hour)
+	minutes := int(offset % time.Hour / time.Minute)
+	seconds := int(offset % time.Minute / time.Second)
+	return time.Date(t.Year(), t.Month(), t.Day(), hours, minutes, seconds, 0, t.Location())
+}
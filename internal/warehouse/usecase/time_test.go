@@ -0,0 +1,66 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DimKa163/dalty/internal/logging"
+	"github.com/DimKa163/dalty/internal/warehouse/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimeService(t *testing.T) {
+	ctx := context.Background()
+	assert.NoError(t, logging.InitializeLogging(&logging.LogConfiguration{}))
+	newZonedWarehouse := func(name, zone string) *core.Warehouse {
+		w := newTestWarehouse(name, core.NodeMall)
+		w.Info = &core.WarehouseInfo{TimeZone: &core.TimeZone{Code: zone}}
+		return w
+	}
+	moscow := newZonedWarehouse("moscow", "Europe/Moscow")
+	vladivostok := newZonedWarehouse("vladivostok", "Asia/Vladivostok")
+	unknown := newZonedWarehouse("unknown", "Mars/Olympus")
+	pathService := newTestPathService([]*core.Warehouse{moscow, vladivostok, unknown}, nil)
+	// Friday 2026-10-16 15:30 in Moscow, 22:30 in Vladivostok
+	now := time.Date(2026, 10, 16, 12, 30, 0, 0, time.UTC)
+	sut := NewTimeService(pathService.graphContext, func() time.Time { return now })
+	assert.NoError(t, sut.UpdateZones(ctx))
+
+	local, err := sut.LocalNow(ctx, &vladivostok.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, 22, local.Time.Hour())
+
+	cutOff, err := sut.IsBeforeCutOff(ctx, &moscow.ID, 16*time.Hour)
+	assert.NoError(t, err)
+	assert.True(t, cutOff.Before)
+	cutOff, err = sut.IsBeforeCutOff(ctx, &vladivostok.ID, 16*time.Hour)
+	assert.NoError(t, err)
+	assert.False(t, cutOff.Before)
+
+	day, err := sut.NextBusinessDay(ctx, &moscow.ID, now)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Monday, day.Time.Weekday())
+	assert.Equal(t, 19, day.Time.Day())
+
+	from, to, err := sut.Convert(ctx, &moscow.ID, &vladivostok.ID, time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC))
+	assert.NoError(t, err)
+	assert.Equal(t, 9, from.Time.Hour())
+	assert.Equal(t, 16, to.Time.Hour())
+	assert.True(t, from.Time.Equal(to.Time))
+
+	_, err = sut.LocalNow(ctx, &unknown.ID)
+	assert.ErrorIs(t, err, ErrTimeZoneNotSet)
+
+	// the clocks go forward at 02:00 in Berlin on 2026-03-29, the cut-off
+	// stays at 16:00 local
+	berlin := newZonedWarehouse("berlin", "Europe/Berlin")
+	dstNow := time.Date(2026, 3, 29, 14, 30, 0, 0, time.UTC)
+	dstService := NewTimeService(newTestPathService([]*core.Warehouse{berlin}, nil).graphContext,
+		func() time.Time { return dstNow })
+	assert.NoError(t, dstService.UpdateZones(ctx))
+	cutOff, err = dstService.IsBeforeCutOff(ctx, &berlin.ID, 16*time.Hour)
+	assert.NoError(t, err)
+	assert.False(t, cutOff.Before)
+	assert.Equal(t, 16, cutOff.CutOff.Time.Hour())
+}
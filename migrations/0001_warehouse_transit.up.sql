@@ -0,0 +1,9 @@
+-- Transit lanes between sub-warehouses. Lanes without a row get zero time
+-- and cost in the warehouse graph.
+CREATE TABLE IF NOT EXISTS public.warehouse_transit (
+    sender_id     uuid NOT NULL REFERENCES public.nrb_sub_warehouse (id) ON DELETE CASCADE,
+    recipient_id  uuid NOT NULL REFERENCES public.nrb_sub_warehouse (id) ON DELETE CASCADE,
+    transit_hours double precision,
+    transit_cost  double precision,
+    PRIMARY KEY (sender_id, recipient_id)
+);
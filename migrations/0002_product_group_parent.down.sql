@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS public.smr_product_group_flag_parent_id_idx;
+ALTER TABLE public.smr_product_group_flag DROP COLUMN IF EXISTS parent_id;
@@ -0,0 +1,6 @@
+-- Parent of a product group, NULL for the roots of the group taxonomy.
+ALTER TABLE public.smr_product_group_flag
+    ADD COLUMN IF NOT EXISTS parent_id uuid REFERENCES public.smr_product_group_flag (id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS smr_product_group_flag_parent_id_idx
+    ON public.smr_product_group_flag (parent_id);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS public.product_updated_at_id_idx;
+DROP TRIGGER IF EXISTS product_set_updated_at ON public.product;
+DROP FUNCTION IF EXISTS public.product_set_updated_at();
+ALTER TABLE public.product DROP COLUMN IF EXISTS updated_at;
@@ -0,0 +1,17 @@
+-- Last time a product row was written, read by the WatchProducts change feed.
+ALTER TABLE public.product
+    ADD COLUMN IF NOT EXISTS updated_at timestamptz NOT NULL DEFAULT clock_timestamp();
+
+CREATE OR REPLACE FUNCTION public.product_set_updated_at() RETURNS trigger AS $$
+BEGIN
+    NEW.updated_at := clock_timestamp();
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS product_set_updated_at ON public.product;
+CREATE TRIGGER product_set_updated_at
+    BEFORE INSERT OR UPDATE ON public.product
+    FOR EACH ROW EXECUTE FUNCTION public.product_set_updated_at();
+
+CREATE INDEX IF NOT EXISTS product_updated_at_id_idx ON public.product (updated_at, id);
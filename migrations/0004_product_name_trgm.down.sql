@@ -0,0 +1 @@
+DROP INDEX IF EXISTS public.product_name_trgm_idx;
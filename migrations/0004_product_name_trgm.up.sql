@@ -0,0 +1,5 @@
+-- Trigram index for SearchProductsByName, which matches names with the <%
+-- operator of pg_trgm.
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX IF NOT EXISTS product_name_trgm_idx ON public.product USING gin (name gin_trgm_ops);
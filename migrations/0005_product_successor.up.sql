@@ -0,0 +1,9 @@
+-- Products that replace an archived product, best first by rank.
+CREATE TABLE IF NOT EXISTS public.product_successor (
+    product_id   uuid    NOT NULL REFERENCES public.product (id) ON DELETE CASCADE,
+    successor_id uuid    NOT NULL REFERENCES public.product (id) ON DELETE CASCADE,
+    rank         integer NOT NULL DEFAULT 0,
+    PRIMARY KEY (product_id, successor_id)
+);
+
+CREATE INDEX IF NOT EXISTS product_successor_product_id_idx ON public.product_successor (product_id);
@@ -0,0 +1,4 @@
+DROP TRIGGER IF EXISTS related_product_notify_changed ON public.nrb_related_product;
+DROP FUNCTION IF EXISTS public.related_product_notify_changed();
+DROP TRIGGER IF EXISTS product_notify_changed ON public.product;
+DROP FUNCTION IF EXISTS public.product_notify_changed();
@@ -0,0 +1,39 @@
+-- Notifies product_changed with the id of every product that was written or
+-- whose relations were, so that product caches are invalidated and product
+-- watchers wake up. Notifications with the same id are sent once per
+-- transaction.
+CREATE OR REPLACE FUNCTION public.product_notify_changed() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP <> 'INSERT' THEN
+        PERFORM pg_notify('product_changed', OLD.id::text);
+    END IF;
+    IF TG_OP <> 'DELETE' THEN
+        PERFORM pg_notify('product_changed', NEW.id::text);
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS product_notify_changed ON public.product;
+CREATE TRIGGER product_notify_changed
+    AFTER INSERT OR UPDATE OR DELETE ON public.product
+    FOR EACH ROW EXECUTE FUNCTION public.product_notify_changed();
+
+CREATE OR REPLACE FUNCTION public.related_product_notify_changed() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP <> 'INSERT' THEN
+        PERFORM pg_notify('product_changed', OLD.nrb_product_sku_id::text);
+        PERFORM pg_notify('product_changed', OLD.nrb_product_mv_id::text);
+    END IF;
+    IF TG_OP <> 'DELETE' THEN
+        PERFORM pg_notify('product_changed', NEW.nrb_product_sku_id::text);
+        PERFORM pg_notify('product_changed', NEW.nrb_product_mv_id::text);
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS related_product_notify_changed ON public.nrb_related_product;
+CREATE TRIGGER related_product_notify_changed
+    AFTER INSERT OR UPDATE OR DELETE ON public.nrb_related_product
+    FOR EACH ROW EXECUTE FUNCTION public.related_product_notify_changed();
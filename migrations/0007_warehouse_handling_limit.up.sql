@@ -0,0 +1,10 @@
+-- Largest item a sub-warehouse can handle. Sub-warehouses without a row, and
+-- limits left NULL, take anything.
+CREATE TABLE IF NOT EXISTS public.warehouse_handling_limit (
+    sub_warehouse_id uuid PRIMARY KEY REFERENCES public.nrb_sub_warehouse (id) ON DELETE CASCADE,
+    max_length       double precision,
+    max_width        double precision,
+    max_height       double precision,
+    max_weight       double precision,
+    max_volume       double precision
+);
@@ -0,0 +1,3 @@
+ALTER TABLE public.nrb_warehouse
+    DROP COLUMN IF EXISTS nrb_latitude,
+    DROP COLUMN IF EXISTS nrb_longitude;
@@ -0,0 +1,4 @@
+-- Coordinates of a warehouse in degrees, NULL when it is not located.
+ALTER TABLE public.nrb_warehouse
+    ADD COLUMN IF NOT EXISTS nrb_latitude  double precision,
+    ADD COLUMN IF NOT EXISTS nrb_longitude double precision;
@@ -11,9 +11,14 @@ const (
 	DaltyErrorTypeBusinessError                   = 9
 )
 
+// EntityError points at the entity an error is about. Field names the
+// identifier ID holds when an entity can be looked up in several ways.
+// Replacements are ids of entities that can be used instead.
 type EntityError struct {
-	ID         string
-	EntityName string
+	ID           string
+	EntityName   string
+	Field        string
+	Replacements []string
 }
 type DaltyError struct {
 	Code         int
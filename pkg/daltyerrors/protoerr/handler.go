@@ -61,17 +61,25 @@ func Handle(err *daltyerrors.DaltyError) error {
 	default:
 		st = status.New(codes.Internal, err.Error())
 	}
+	st, _ = st.WithDetails(Detail(err))
+	return st.Err()
+}
+
+// Detail converts err to the ErrorDetail attached to gRPC statuses, for
+// responses that report errors inline.
+func Detail(err *daltyerrors.DaltyError) *proto.ErrorDetail {
 	entErros := make([]*proto.EntityError, len(err.EntityErrors))
 	for i, entErr := range err.EntityErrors {
 		var itemError proto.EntityError
 		itemError.SetId(entErr.ID)
 		itemError.SetEntityName(entErr.EntityName)
+		itemError.SetField(entErr.Field)
+		itemError.SetReplacementIds(entErr.Replacements)
 		entErros[i] = &itemError
 	}
 	var detail proto.ErrorDetail
 	detail.SetCode(int32(err.Code))
 	detail.SetMessage(err.Message)
 	detail.SetEntityErrors(entErros)
-	st, _ = st.WithDetails(&detail)
-	return st.Err()
+	return &detail
 }
@@ -0,0 +1,77 @@
+package daltymodel
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+)
+
+//go:embed groups.json
+var groupsJSON []byte
+
+// GroupInfo describes a product group: its id in the catalog, the name of
+// the matching proto enum value and a display name.
+type GroupInfo struct {
+	ID   ProductGroup `json:"id"`
+	Enum string       `json:"enum"`
+	Name string       `json:"name"`
+}
+
+// GroupRegistry is the single list of known product groups.
+type GroupRegistry struct {
+	groups []*GroupInfo
+	byID   map[ProductGroup]*GroupInfo
+	byEnum map[string]*GroupInfo
+}
+
+// NewGroupRegistry builds a registry and rejects empty fields and groups that
+// share an id or an enum name.
+func NewGroupRegistry(groups []*GroupInfo) (*GroupRegistry, error) {
+	registry := &GroupRegistry{
+		groups: groups,
+		byID:   make(map[ProductGroup]*GroupInfo, len(groups)),
+		byEnum: make(map[string]*GroupInfo, len(groups)),
+	}
+	for i, g := range groups {
+		if g.ID == "" || g.Enum == "" || g.Name == "" {
+			return nil, fmt.Errorf("product group %d: id, enum and name are required", i)
+		}
+		if _, ok := registry.byID[g.ID]; ok {
+			return nil, fmt.Errorf("product group %s: duplicate id", g.ID)
+		}
+		if _, ok := registry.byEnum[g.Enum]; ok {
+			return nil, fmt.Errorf("product group %s: duplicate enum %s", g.ID, g.Enum)
+		}
+		registry.byID[g.ID] = g
+		registry.byEnum[g.Enum] = g
+	}
+	return registry, nil
+}
+
+// LoadGroupRegistry reads a registry from a json array of groups.
+func LoadGroupRegistry(data []byte) (*GroupRegistry, error) {
+	var groups []*GroupInfo
+	if err := json.Unmarshal(data, &groups); err != nil {
+		return nil, fmt.Errorf("product groups: %w", err)
+	}
+	return NewGroupRegistry(groups)
+}
+
+// DefaultGroupRegistry is the registry embedded into the binary.
+func DefaultGroupRegistry() (*GroupRegistry, error) {
+	return LoadGroupRegistry(groupsJSON)
+}
+
+func (r *GroupRegistry) Groups() []*GroupInfo {
+	return r.groups
+}
+
+func (r *GroupRegistry) ByID(id ProductGroup) (*GroupInfo, bool) {
+	g, ok := r.byID[id]
+	return g, ok
+}
+
+func (r *GroupRegistry) ByEnum(enum string) (*GroupInfo, bool) {
+	g, ok := r.byEnum[enum]
+	return g, ok
+}
@@ -0,0 +1,57 @@
+package daltymodel
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// productGroupConstants reads the ProductGroup constants declared in
+// specification.go, ProductGroupUnknown aside, by name.
+func productGroupConstants(t *testing.T) map[string]ProductGroup {
+	file, err := parser.ParseFile(token.NewFileSet(), "specification.go", nil, 0)
+	require.NoError(t, err)
+	constants := make(map[string]ProductGroup)
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.CONST {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			value := spec.(*ast.ValueSpec)
+			if ident, ok := value.Type.(*ast.Ident); !ok || ident.Name != "ProductGroup" {
+				continue
+			}
+			literal, ok := value.Values[0].(*ast.BasicLit)
+			require.True(t, ok, value.Names[0].Name)
+			id, err := strconv.Unquote(literal.Value)
+			require.NoError(t, err)
+			if ProductGroup(id) != ProductGroupUnknown {
+				constants[value.Names[0].Name] = ProductGroup(id)
+			}
+		}
+	}
+	return constants
+}
+
+func TestProductGroupConstantsMatchRegistry(t *testing.T) {
+	registry, err := DefaultGroupRegistry()
+	require.NoError(t, err)
+	constants := productGroupConstants(t)
+	require.NotEmpty(t, constants)
+
+	declared := make(map[ProductGroup]bool, len(constants))
+	for name, id := range constants {
+		declared[id] = true
+		_, ok := registry.ByID(id)
+		assert.True(t, ok, "%s is not in groups.json", name)
+	}
+	for _, g := range registry.Groups() {
+		assert.True(t, declared[g.ID], "%s from groups.json has no constant", g.Enum)
+	}
+}
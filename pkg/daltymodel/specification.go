@@ -15,10 +15,19 @@ type (
 		Type          SpecificationType `json:"type"`
 		Strategy      PickupStrategy    `json:"strategy"`
 		ChildProducts []*Line           `json:"child_products"`
+		Totals        Totals            `json:"totals"`
 	}
 	SpecResponse struct {
 		Specifications []*Specification `json:"specifications"`
 	}
+	// Totals describe what a shipment takes: the number of packages, their
+	// summed volume and weight and the longest side of any single package.
+	Totals struct {
+		Packages     int32   `json:"packages"`
+		Volume       float64 `json:"volume"`
+		Weight       float64 `json:"weight"`
+		MaxDimension float64 `json:"max_dimension"`
+	}
 )
 
 const (
@@ -168,28 +177,79 @@ func NewLine(product *Product, quantity int32, strategy PickupStrategy) *Line {
 }
 
 func NewDefaultSpecification(line *Line, strategy PickupStrategy) *Specification {
-	return &Specification{
+	return withTotals(&Specification{
 		Product:       line,
 		Type:          SpecificationTypeDefault,
 		Strategy:      strategy,
 		ChildProducts: make([]*Line, 0),
-	}
+	})
 }
 
 func NewDirectSpecification(line *Line, strategy PickupStrategy, subProducts []*Line) *Specification {
-	return &Specification{
+	return withTotals(&Specification{
 		Product:       line,
 		Type:          SpecificationTypeDirect,
 		Strategy:      strategy,
 		ChildProducts: subProducts,
-	}
+	})
 }
 
 func NewReverseSpecification(line *Line, strategy PickupStrategy, subProducts []*Line) *Specification {
-	return &Specification{
+	return withTotals(&Specification{
 		Product:       line,
 		Type:          SpecificationTypeReverse,
 		Strategy:      strategy,
 		ChildProducts: subProducts,
+	})
+}
+
+// ShippingLines are the lines that leave the warehouse: the child lines when
+// the specification has them, the product line otherwise. Services don't
+// ship.
+func (s *Specification) ShippingLines() []*Line {
+	lines := s.ChildProducts
+	if len(lines) == 0 {
+		lines = []*Line{s.Product}
+	}
+	shipping := make([]*Line, 0, len(lines))
+	for _, line := range lines {
+		if line.Product.IsService {
+			continue
+		}
+		shipping = append(shipping, line)
+	}
+	return shipping
+}
+
+// Add counts every unit of the line as a package.
+func (t *Totals) Add(line *Line) {
+	p := line.Product
+	t.Packages += line.Quantity
+	t.Volume += p.Volume * float64(line.Quantity)
+	t.Weight += p.Weight * float64(line.Quantity)
+	t.MaxDimension = max(t.MaxDimension, p.Length, p.Width, p.Height)
+}
+
+// Merge adds the totals of another shipment.
+func (t *Totals) Merge(other Totals) {
+	t.Packages += other.Packages
+	t.Volume += other.Volume
+	t.Weight += other.Weight
+	t.MaxDimension = max(t.MaxDimension, other.MaxDimension)
+}
+
+// TotalsOf sums the totals of several specifications.
+func TotalsOf(specs []*Specification) Totals {
+	var totals Totals
+	for _, spec := range specs {
+		totals.Merge(spec.Totals)
+	}
+	return totals
+}
+
+func withTotals(spec *Specification) *Specification {
+	for _, line := range spec.ShippingLines() {
+		spec.Totals.Add(line)
 	}
+	return spec
 }
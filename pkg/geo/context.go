@@ -0,0 +1,25 @@
+package geo
+
+import (
+	"context"
+	"sync/atomic"
+)
+
+type IndexContext struct {
+	index atomic.Pointer[KDTree]
+}
+
+func NewIndexContext() *IndexContext {
+	return &IndexContext{}
+}
+
+func (ic *IndexContext) Get(ctx context.Context) (*KDTree, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return ic.index.Load(), nil
+}
+
+func (ic *IndexContext) Update(index *KDTree) {
+	ic.index.Store(index)
+}
@@ -0,0 +1,164 @@
+package geo
+
+import (
+	"container/heap"
+	"math"
+	"sort"
+)
+
+type Item struct {
+	ID       string
+	Location Point
+	Value    any
+}
+
+func Cast[T any](it *Item) (T, bool) {
+	v, ok := it.Value.(T)
+	return v, ok
+}
+
+type Neighbor struct {
+	*Item
+	Distance float64
+}
+
+type kdNode struct {
+	item  *Item
+	coord [3]float64
+	axis  int
+	left  *kdNode
+	right *kdNode
+}
+
+// KDTree is an immutable spatial index over points on the Earth surface.
+type KDTree struct {
+	root *kdNode
+	size int
+}
+
+func NewKDTree(items []*Item) *KDTree {
+	nodes := make([]*kdNode, 0, len(items))
+	for _, it := range items {
+		if !it.Location.Valid() {
+			continue
+		}
+		nodes = append(nodes, &kdNode{item: it, coord: it.Location.cartesian()})
+	}
+	return &KDTree{
+		root: build(nodes, 0),
+		size: len(nodes),
+	}
+}
+
+func build(nodes []*kdNode, depth int) *kdNode {
+	if len(nodes) == 0 {
+		return nil
+	}
+	axis := depth % 3
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].coord[axis] < nodes[j].coord[axis]
+	})
+	median := len(nodes) / 2
+	n := nodes[median]
+	n.axis = axis
+	n.left = build(nodes[:median], depth+1)
+	n.right = build(nodes[median+1:], depth+1)
+	return n
+}
+
+func (t *KDTree) Len() int {
+	return t.size
+}
+
+// Nearest returns up to k items closest to p ordered by distance. Items
+// farther than maxDistance kilometers are skipped unless maxDistance is zero.
+// Items rejected by filter are not counted towards k.
+func (t *KDTree) Nearest(p Point, k int, maxDistance float64, filter func(it *Item) bool) []*Neighbor {
+	if k <= 0 || t.root == nil {
+		return make([]*Neighbor, 0)
+	}
+	limit := math.Inf(1)
+	if maxDistance > 0 {
+		limit = chordFor(maxDistance)
+		limit *= limit
+	}
+	s := &search{
+		target: p.cartesian(),
+		k:      k,
+		limit:  limit,
+		filter: filter,
+	}
+	s.visit(t.root)
+	result := make([]*Neighbor, s.best.Len())
+	for i := len(result) - 1; i >= 0; i-- {
+		c := heap.Pop(&s.best).(candidate)
+		result[i] = &Neighbor{Item: c.node.item, Distance: Haversine(p, c.node.item.Location)}
+	}
+	return result
+}
+
+type search struct {
+	target [3]float64
+	k      int
+	limit  float64
+	filter func(it *Item) bool
+	best   candidates
+}
+
+func (s *search) bound() float64 {
+	if s.best.Len() < s.k {
+		return s.limit
+	}
+	return math.Min(s.limit, s.best[0].dist)
+}
+
+func (s *search) visit(n *kdNode) {
+	if n == nil {
+		return
+	}
+	d := squaredDistance(s.target, n.coord)
+	if d <= s.bound() && (s.filter == nil || s.filter(n.item)) {
+		heap.Push(&s.best, candidate{node: n, dist: d})
+		if s.best.Len() > s.k {
+			heap.Pop(&s.best)
+		}
+	}
+	diff := s.target[n.axis] - n.coord[n.axis]
+	near, far := n.left, n.right
+	if diff > 0 {
+		near, far = n.right, n.left
+	}
+	s.visit(near)
+	if diff*diff <= s.bound() {
+		s.visit(far)
+	}
+}
+
+func squaredDistance(a, b [3]float64) float64 {
+	var sum float64
+	for i := range a {
+		d := a[i] - b[i]
+		sum += d * d
+	}
+	return sum
+}
+
+type candidate struct {
+	node *kdNode
+	dist float64
+}
+
+// candidates is a max-heap, the farthest of the best k is on top.
+type candidates []candidate
+
+func (c candidates) Len() int           { return len(c) }
+func (c candidates) Less(i, j int) bool { return c[i].dist > c[j].dist }
+func (c candidates) Swap(i, j int)      { c[i], c[j] = c[j], c[i] }
+func (c *candidates) Push(x any)        { *c = append(*c, x.(candidate)) }
+func (c *candidates) Pop() any {
+	old := *c
+	n := len(old)
+	it := old[n-1]
+	*c = old[:n-1]
+	return it
+}
@@ -0,0 +1,72 @@
+package geo
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHaversine(t *testing.T) {
+	moscow := NewPoint(55.7558, 37.6173)
+	spb := NewPoint(59.9343, 30.3351)
+
+	assert.InDelta(t, 634, Haversine(moscow, spb), 2)
+	assert.Equal(t, 0.0, Haversine(moscow, moscow))
+}
+
+func TestKDTreeNearest(t *testing.T) {
+	rnd := rand.New(rand.NewSource(42))
+	items := make([]*Item, 500)
+	for i := range items {
+		items[i] = &Item{
+			ID:       fmt.Sprintf("%d", i),
+			Location: NewPoint(rnd.Float64()*180-90, rnd.Float64()*360-180),
+			Value:    i,
+		}
+	}
+	tree := NewKDTree(items)
+	assert.Equal(t, len(items), tree.Len())
+
+	target := NewPoint(55.7558, 37.6173)
+	even := func(it *Item) bool {
+		v, _ := Cast[int](it)
+		return v%2 == 0
+	}
+
+	got := tree.Nearest(target, 10, 0, even)
+
+	expected := make([]*Item, 0)
+	for _, it := range items {
+		if even(it) {
+			expected = append(expected, it)
+		}
+	}
+	sort.Slice(expected, func(i, j int) bool {
+		return Haversine(target, expected[i].Location) < Haversine(target, expected[j].Location)
+	})
+	assert.Equal(t, 10, len(got))
+	for i, n := range got {
+		assert.Equal(t, expected[i].ID, n.ID)
+		assert.InDelta(t, Haversine(target, expected[i].Location), n.Distance, 1e-9)
+	}
+}
+
+func TestKDTreeNearestMaxDistance(t *testing.T) {
+	items := []*Item{
+		{ID: "moscow", Location: NewPoint(55.7558, 37.6173)},
+		{ID: "tver", Location: NewPoint(56.8587, 35.9176)},
+		{ID: "spb", Location: NewPoint(59.9343, 30.3351)},
+		{ID: "invalid", Location: NewPoint(120, 0)},
+	}
+	tree := NewKDTree(items)
+	assert.Equal(t, 3, tree.Len())
+
+	got := tree.Nearest(NewPoint(55.7558, 37.6173), 5, 200, nil)
+
+	assert.Equal(t, 2, len(got))
+	assert.Equal(t, "moscow", got[0].ID)
+	assert.Equal(t, "tver", got[1].ID)
+}
@@ -0,0 +1,56 @@
+package geo
+
+import "math"
+
+// EarthRadius is the mean Earth radius in kilometers.
+const EarthRadius = 6371.0088
+
+type Point struct {
+	Latitude  float64
+	Longitude float64
+}
+
+func NewPoint(latitude, longitude float64) Point {
+	return Point{Latitude: latitude, Longitude: longitude}
+}
+
+func (p Point) Valid() bool {
+	if math.IsNaN(p.Latitude) || math.IsNaN(p.Longitude) {
+		return false
+	}
+	return p.Latitude >= -90 && p.Latitude <= 90 && p.Longitude >= -180 && p.Longitude <= 180
+}
+
+// Haversine returns the great-circle distance between a and b in kilometers.
+func Haversine(a, b Point) float64 {
+	lat1 := radians(a.Latitude)
+	lat2 := radians(b.Latitude)
+	dLat := lat2 - lat1
+	dLon := radians(b.Longitude - a.Longitude)
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * EarthRadius * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+// cartesian projects the point onto the unit sphere. Straight-line (chord)
+// distance between projections grows monotonically with the great-circle
+// distance, which lets the k-d tree prune with plain euclidean bounds.
+func (p Point) cartesian() [3]float64 {
+	lat := radians(p.Latitude)
+	lon := radians(p.Longitude)
+	return [3]float64{
+		math.Cos(lat) * math.Cos(lon),
+		math.Cos(lat) * math.Sin(lon),
+		math.Sin(lat),
+	}
+}
+
+func chordFor(distance float64) float64 {
+	if distance >= math.Pi*EarthRadius {
+		return 2
+	}
+	return 2 * math.Sin(distance/(2*EarthRadius))
+}
+
+func radians(deg float64) float64 {
+	return deg * math.Pi / 180
+}
@@ -2,34 +2,51 @@ package graph
 
 import (
 	"context"
-	"sync"
+	"sync/atomic"
 )
 
+// GraphContext publishes immutable graph snapshots. Update swaps the snapshot
+// atomically, so readers never block and never see a partially built graph.
 type GraphContext struct {
-	graph *Graph
-	mutex *sync.RWMutex
+	graph atomic.Pointer[Graph]
+}
+
+type snapshotKey struct {
+	gc *GraphContext
 }
 
 func NewGraphContext() *GraphContext {
-	return &GraphContext{
-		mutex: &sync.RWMutex{},
-	}
+	return &GraphContext{}
 }
 
+// Get returns the snapshot pinned to ctx by Pin or, if there is none, the
+// latest published graph.
 func (gc *GraphContext) Get(ctx context.Context) (*Graph, error) {
-	select {
-	case <-ctx.Done():
-		return nil, ctx.Err()
-	default:
-		gc.mutex.RLock()
-		defer gc.mutex.RUnlock()
-		return gc.graph, nil
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if g, ok := ctx.Value(snapshotKey{gc}).(*Graph); ok {
+		return g, nil
 	}
+	return gc.graph.Load(), nil
+}
 
+// Pin binds the current snapshot to the returned context. Every Get made with
+// that context observes the same graph even if Update lands in the meantime.
+func (gc *GraphContext) Pin(ctx context.Context) (context.Context, *Graph, error) {
+	g, err := gc.Get(ctx)
+	if err != nil {
+		return ctx, nil, err
+	}
+	if _, ok := ctx.Value(snapshotKey{gc}).(*Graph); ok || g == nil {
+		return ctx, g, nil
+	}
+	return context.WithValue(ctx, snapshotKey{gc}, g), g, nil
 }
 
 func (gc *GraphContext) Update(graph *Graph) {
-	gc.mutex.Lock()
-	defer gc.mutex.Unlock()
-	gc.graph = graph
+	if graph != nil {
+		graph.Compact()
+	}
+	gc.graph.Store(graph)
 }
@@ -0,0 +1,155 @@
+package graph
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGraphContextPin(t *testing.T) {
+	gc := NewGraphContext()
+	first := NewGraph()
+	first.AddNode(&Node{ID: "A"})
+	gc.Update(first)
+
+	ctx, pinned, err := gc.Pin(context.Background())
+	assert.NoError(t, err)
+	assert.Same(t, first, pinned)
+
+	second := NewGraph()
+	gc.Update(second)
+
+	g, err := gc.Get(ctx)
+	assert.NoError(t, err)
+	assert.Same(t, first, g)
+
+	g, err = gc.Get(context.Background())
+	assert.NoError(t, err)
+	assert.Same(t, second, g)
+
+	ctx, repinned, err := gc.Pin(ctx)
+	assert.NoError(t, err)
+	assert.Same(t, first, repinned)
+}
+
+func TestGraphContextCanceled(t *testing.T) {
+	gc := NewGraphContext()
+	gc.Update(NewGraph())
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	g, err := gc.Get(ctx)
+
+	assert.Nil(t, g)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+// rwMutexContext is the previous lock-based GraphContext, kept to compare
+// against in benchmarks.
+type rwMutexContext struct {
+	graph *Graph
+	mutex sync.RWMutex
+}
+
+func (c *rwMutexContext) Get(ctx context.Context) (*Graph, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	return c.graph, nil
+}
+
+func (c *rwMutexContext) Update(graph *Graph) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.graph = graph
+}
+
+type graphSource interface {
+	Get(ctx context.Context) (*Graph, error)
+	Update(graph *Graph)
+}
+
+func benchmarkGraph(size int) *Graph {
+	g := NewGraph()
+	var prev *Node
+	for i := 0; i < size; i++ {
+		n := &Node{ID: strconv.Itoa(i)}
+		g.AddNode(n)
+		if prev != nil {
+			g.AddEdge(prev, n, 0)
+		}
+		prev = n
+	}
+	return g
+}
+
+func benchmarkGet(b *testing.B, source graphSource, refresh bool) {
+	ctx := context.Background()
+	graphs := []*Graph{benchmarkGraph(64), benchmarkGraph(64)}
+	source.Update(graphs[0])
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	if refresh {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; ; i++ {
+				select {
+				case <-done:
+					return
+				default:
+					source.Update(graphs[i%2])
+				}
+			}
+		}()
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			g, err := source.Get(ctx)
+			if err != nil || g == nil {
+				b.Fatal("graph is not available")
+			}
+		}
+	})
+	b.StopTimer()
+	close(done)
+	wg.Wait()
+}
+
+func BenchmarkGraphContextGet(b *testing.B) {
+	benchmarkGet(b, NewGraphContext(), false)
+}
+
+func BenchmarkGraphContextGetConcurrentRefresh(b *testing.B) {
+	benchmarkGet(b, NewGraphContext(), true)
+}
+
+func BenchmarkRWMutexContextGet(b *testing.B) {
+	benchmarkGet(b, &rwMutexContext{}, false)
+}
+
+func BenchmarkRWMutexContextGetConcurrentRefresh(b *testing.B) {
+	benchmarkGet(b, &rwMutexContext{}, true)
+}
+
+func BenchmarkGraphContextPinnedGet(b *testing.B) {
+	gc := NewGraphContext()
+	gc.Update(benchmarkGraph(64))
+	ctx, _, _ := gc.Pin(context.Background())
+	b.ReportAllocs()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			if _, err := gc.Get(ctx); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
@@ -0,0 +1,182 @@
+package graph
+
+// DominatorTree answers which nodes every path from the roots to a node must
+// pass through. Node a dominates b when removing a cuts b off from all roots.
+// Nodes unreachable from the roots are not part of the tree.
+type DominatorTree struct {
+	nodes    []*Node
+	index    map[string]int
+	idom     []int
+	children [][]int
+}
+
+// Dominators builds the dominator tree of view for paths along outgoing edges
+// that start at any of roots. It uses the iterative algorithm of Cooper,
+// Harvey and Kennedy over a virtual entry connected to every root.
+func Dominators(view View, roots []*Node) *DominatorTree {
+	// index 0 is the virtual entry, the rest are reachable nodes in reverse
+	// postorder
+	order := postorder(view, roots)
+	size := len(order) + 1
+	t := &DominatorTree{
+		nodes:    make([]*Node, size),
+		index:    make(map[string]int, size),
+		idom:     make([]int, size),
+		children: make([][]int, size),
+	}
+	for i, n := range order {
+		rpo := size - 1 - i
+		t.nodes[rpo] = n
+		t.index[n.ID] = rpo
+	}
+	isRoot := make(map[string]bool, len(roots))
+	for _, r := range roots {
+		isRoot[r.ID] = true
+	}
+	preds := make([][]int, size)
+	for i := 1; i < size; i++ {
+		n := t.nodes[i]
+		if isRoot[n.ID] {
+			preds[i] = append(preds[i], 0)
+		}
+		for e := range view.Incoming(n) {
+			if p, ok := t.index[e.From.ID]; ok {
+				preds[i] = append(preds[i], p)
+			}
+		}
+	}
+	const undefined = -1
+	for i := range t.idom {
+		t.idom[i] = undefined
+	}
+	t.idom[0] = 0
+	for changed := true; changed; {
+		changed = false
+		for i := 1; i < size; i++ {
+			newIdom := undefined
+			for _, p := range preds[i] {
+				if t.idom[p] == undefined {
+					continue
+				}
+				if newIdom == undefined {
+					newIdom = p
+					continue
+				}
+				newIdom = t.intersect(p, newIdom)
+			}
+			if newIdom != t.idom[i] {
+				t.idom[i] = newIdom
+				changed = true
+			}
+		}
+	}
+	for i := 1; i < size; i++ {
+		t.children[t.idom[i]] = append(t.children[t.idom[i]], i)
+	}
+	return t
+}
+
+// intersect walks both nodes up the tree until they meet. Reverse postorder
+// numbers decrease towards the entry.
+func (t *DominatorTree) intersect(a, b int) int {
+	for a != b {
+		for a > b {
+			a = t.idom[a]
+		}
+		for b > a {
+			b = t.idom[b]
+		}
+	}
+	return a
+}
+
+func (t *DominatorTree) Reachable(nodeID string) bool {
+	_, ok := t.index[nodeID]
+	return ok
+}
+
+// ImmediateDominator returns the closest strict dominator of n. It returns
+// false for roots and unreachable nodes.
+func (t *DominatorTree) ImmediateDominator(n *Node) (*Node, bool) {
+	i, ok := t.index[n.ID]
+	if !ok || t.idom[i] == 0 {
+		return nil, false
+	}
+	return t.nodes[t.idom[i]], true
+}
+
+// Dominates reports whether every path from the roots to b passes through a.
+// A node dominates itself.
+func (t *DominatorTree) Dominates(a, b *Node) bool {
+	ai, ok := t.index[a.ID]
+	if !ok {
+		return false
+	}
+	bi, ok := t.index[b.ID]
+	if !ok {
+		return false
+	}
+	for bi > ai {
+		bi = t.idom[bi]
+	}
+	return ai == bi
+}
+
+// Dominated returns the nodes strictly dominated by n, i.e. every node that
+// loses all paths from the roots when n is removed.
+func (t *DominatorTree) Dominated(n *Node) []*Node {
+	result := make([]*Node, 0)
+	i, ok := t.index[n.ID]
+	if !ok {
+		return result
+	}
+	stack := append(make([]int, 0), t.children[i]...)
+	for len(stack) > 0 {
+		c := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		result = append(result, t.nodes[c])
+		stack = append(stack, t.children[c]...)
+	}
+	return result
+}
+
+// postorder lists nodes reachable from roots in DFS postorder.
+func postorder(view View, roots []*Node) []*Node {
+	type frame struct {
+		node  *Node
+		edges []*Edge
+	}
+	visited := make(map[string]bool)
+	order := make([]*Node, 0)
+	for _, root := range roots {
+		if visited[root.ID] {
+			continue
+		}
+		visited[root.ID] = true
+		stack := []*frame{{node: root, edges: collectEdges(view, root)}}
+		for len(stack) > 0 {
+			top := stack[len(stack)-1]
+			if len(top.edges) == 0 {
+				order = append(order, top.node)
+				stack = stack[:len(stack)-1]
+				continue
+			}
+			next := top.edges[0].To
+			top.edges = top.edges[1:]
+			if visited[next.ID] {
+				continue
+			}
+			visited[next.ID] = true
+			stack = append(stack, &frame{node: next, edges: collectEdges(view, next)})
+		}
+	}
+	return order
+}
+
+func collectEdges(view View, n *Node) []*Edge {
+	edges := make([]*Edge, 0)
+	for e := range view.Outgoing(n) {
+		edges = append(edges, e)
+	}
+	return edges
+}
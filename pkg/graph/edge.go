@@ -1,44 +1,114 @@
 package graph
 
+import "iter"
+
 type Edge struct {
 	From   *Node
 	To     *Node
 	Weight int
+	Cost   Cost
+	from   int32
+	to     int32
 }
 
-type EdgeList map[string][]*Edge
+// Cost is what it takes to move goods along an edge.
+type Cost struct {
+	Time  float64
+	Money float64
+}
 
-func (el EdgeList) AddEdge(from, to *Node, weight int) {
-	edge := &Edge{from, to, weight}
-	el.addIncomeAdd(edge)
-	el.addOutcomeAdd(edge)
+func (c Cost) Add(other Cost) Cost {
+	return Cost{Time: c.Time + other.Time, Money: c.Money + other.Money}
 }
 
-func (el EdgeList) AllIncomeTo(to *Node) []*Edge {
-	result := make([]*Edge, 0)
-	edges, ok := el[to.ID]
-	if !ok {
-		return result
+// adjacency keeps edges in two CSR layouts. Edges leaving node i are
+// out[outStart[i]:outStart[i+1]], edges entering it are
+// in[inStart[i]:inStart[i+1]].
+type adjacency struct {
+	outStart []int32
+	out      []*Edge
+	inStart  []int32
+	in       []*Edge
+}
+
+func newAdjacency(size int, edges []*Edge) *adjacency {
+	adj := &adjacency{
+		outStart: make([]int32, size+1),
+		out:      make([]*Edge, len(edges)),
+		inStart:  make([]int32, size+1),
+		in:       make([]*Edge, len(edges)),
 	}
-	for _, edge := range edges {
-		if edge.To.ID != to.ID {
-			continue
-		}
-		result = append(result, edge)
+	for _, e := range edges {
+		adj.outStart[e.from+1]++
+		adj.inStart[e.to+1]++
 	}
-	return result
+	for i := 0; i < size; i++ {
+		adj.outStart[i+1] += adj.outStart[i]
+		adj.inStart[i+1] += adj.inStart[i]
+	}
+	outPos := make([]int32, size)
+	inPos := make([]int32, size)
+	copy(outPos, adj.outStart[:size])
+	copy(inPos, adj.inStart[:size])
+	for _, e := range edges {
+		adj.out[outPos[e.from]] = e
+		outPos[e.from]++
+		adj.in[inPos[e.to]] = e
+		inPos[e.to]++
+	}
+	return adj
+}
+
+func (adj *adjacency) outgoing(i int32) []*Edge {
+	start, end := adj.outStart[i], adj.outStart[i+1]
+	return adj.out[start:end:end]
+}
+
+func (adj *adjacency) incoming(i int32) []*Edge {
+	start, end := adj.inStart[i], adj.inStart[i+1]
+	return adj.in[start:end:end]
+}
+
+// AddEdge connects from and to. Nodes missing from the graph are added, and
+// the edge always references the nodes stored in the graph.
+func (g *Graph) AddEdge(from, to *Node, weight int) {
+	g.AddEdgeWithCost(from, to, weight, Cost{})
+}
+
+// AddEdgeWithCost is AddEdge for edges that carry transit time and money.
+func (g *Graph) AddEdgeWithCost(from, to *Node, weight int, cost Cost) {
+	fi := g.addNode(from)
+	ti := g.addNode(to)
+	g.edges = append(g.edges, &Edge{
+		From:   g.nodes[fi],
+		To:     g.nodes[ti],
+		Weight: weight,
+		Cost:   cost,
+		from:   fi,
+		to:     ti,
+	})
+	g.invalidate()
+}
+
+// Incoming iterates over edges that end in n.
+func (g *Graph) Incoming(n *Node) iter.Seq[*Edge] {
+	return seq(g.incoming(n))
+}
+
+// Outgoing iterates over edges that start in n.
+func (g *Graph) Outgoing(n *Node) iter.Seq[*Edge] {
+	return seq(g.outgoing(n))
 }
 
-func (el EdgeList) AllIncomeToWhere(to *Node, filter func(n *Node) bool) []*Edge {
+// AllIncomeTo returns edges that end in to. The result shares memory with the
+// graph and must not be modified.
+func (g *Graph) AllIncomeTo(to *Node) []*Edge {
+	return g.incoming(to)
+}
+
+func (g *Graph) AllIncomeToWhere(to *Node, filter func(n *Node) bool) []*Edge {
 	result := make([]*Edge, 0)
-	edges, ok := el[to.ID]
-	if !ok {
-		return result
-	}
-	for _, edge := range edges {
-		if edge.To.ID != to.ID {
-			continue
-		}
+	for _, edge := range g.incoming(to) {
 		if filter(edge.From) {
 			result = append(result, edge)
 		}
@@ -46,31 +116,15 @@ func (el EdgeList) AllIncomeToWhere(to *Node, filter func(n *Node) bool) []*Edge
 	return result
 }
 
-func (el EdgeList) AllOutcomeFrom(from *Node) []*Edge {
-	result := make([]*Edge, 0)
-	edges, ok := el[from.ID]
-	if !ok {
-		return result
-	}
-	for _, edge := range edges {
-		if edge.From.ID != from.ID {
-			continue
-		}
-		result = append(result, edge)
-	}
-	return result
+// AllOutcomeFrom returns edges that start in from. The result shares memory
+// with the graph and must not be modified.
+func (g *Graph) AllOutcomeFrom(from *Node) []*Edge {
+	return g.outgoing(from)
 }
 
-func (el EdgeList) AllOutcomeFromWhere(from *Node, filter func(n *Node) bool) []*Edge {
+func (g *Graph) AllOutcomeFromWhere(from *Node, filter func(n *Node) bool) []*Edge {
 	result := make([]*Edge, 0)
-	edges, ok := el[from.ID]
-	if !ok {
-		return result
-	}
-	for _, edge := range edges {
-		if edge.From.ID != from.ID {
-			continue
-		}
+	for _, edge := range g.outgoing(from) {
 		if filter(edge.To) {
 			result = append(result, edge)
 		}
@@ -78,24 +132,45 @@ func (el EdgeList) AllOutcomeFromWhere(from *Node, filter func(n *Node) bool) []
 	return result
 }
 
-func (el EdgeList) Edges(n *Node) ([]*Edge, bool) {
-	edges, ok := el[n.ID]
+// Edges returns both incoming and outgoing edges of n.
+func (g *Graph) Edges(n *Node) ([]*Edge, bool) {
+	i, ok := g.index[n.ID]
 	if !ok {
 		return nil, false
 	}
-	return edges, true
+	adj := g.compacted()
+	in := adj.incoming(i)
+	out := adj.outgoing(i)
+	if len(in)+len(out) == 0 {
+		return nil, false
+	}
+	edges := make([]*Edge, 0, len(in)+len(out))
+	edges = append(edges, in...)
+	return append(edges, out...), true
 }
-func (el EdgeList) addOutcomeAdd(edge *Edge) {
-	_, ok := el[edge.From.ID]
+
+func (g *Graph) incoming(n *Node) []*Edge {
+	i, ok := g.index[n.ID]
 	if !ok {
-		el[edge.From.ID] = make([]*Edge, 0)
+		return nil
 	}
-	el[edge.From.ID] = append(el[edge.From.ID], edge)
+	return g.compacted().incoming(i)
 }
-func (el EdgeList) addIncomeAdd(edge *Edge) {
-	_, ok := el[edge.To.ID]
+
+func (g *Graph) outgoing(n *Node) []*Edge {
+	i, ok := g.index[n.ID]
 	if !ok {
-		el[edge.To.ID] = make([]*Edge, 0)
+		return nil
+	}
+	return g.compacted().outgoing(i)
+}
+
+func seq(edges []*Edge) iter.Seq[*Edge] {
+	return func(yield func(*Edge) bool) {
+		for _, e := range edges {
+			if !yield(e) {
+				return
+			}
+		}
 	}
-	el[edge.To.ID] = append(el[edge.To.ID], edge)
 }
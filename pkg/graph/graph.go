@@ -1,26 +1,85 @@
 package graph
 
+import (
+	"iter"
+	"sync"
+)
+
+// Graph stores nodes under dense integer indices. Edges are collected as they
+// are added and compacted on first read into CSR-style incoming and outgoing
+// arrays, so adjacency lookups are a slice window instead of a scan. The
+// string-ID methods resolve the index and read from those arrays.
 type Graph struct {
-	nodes map[string]*Node
-	EdgeList
+	nodes     []*Node
+	index     map[string]int32
+	edges     []*Edge
+	adjacency *adjacency
+	once      *sync.Once
 }
 
 func NewGraph() *Graph {
 	return &Graph{
-		nodes:    make(map[string]*Node),
-		EdgeList: make(EdgeList),
+		nodes: make([]*Node, 0),
+		index: make(map[string]int32),
+		edges: make([]*Edge, 0),
+		once:  &sync.Once{},
 	}
 }
 
 func (g *Graph) Find(nodeID string) (*Node, bool) {
-	n, ok := g.nodes[nodeID]
-	return n, ok
+	i, ok := g.index[nodeID]
+	if !ok {
+		return nil, false
+	}
+	return g.nodes[i], true
 }
 
 func (g *Graph) AddNode(n *Node) {
-	_, ok := g.nodes[n.ID]
+	g.addNode(n)
+}
+
+func (g *Graph) Len() int {
+	return len(g.nodes)
+}
+
+func (g *Graph) Nodes() iter.Seq[*Node] {
+	return func(yield func(*Node) bool) {
+		for _, n := range g.nodes {
+			if !yield(n) {
+				return
+			}
+		}
+	}
+}
+
+// Compact builds the adjacency arrays up front. It is optional, the first read
+// after a mutation compacts the graph anyway.
+func (g *Graph) Compact() {
+	g.once.Do(func() {
+		g.adjacency = newAdjacency(len(g.nodes), g.edges)
+	})
+}
+
+func (g *Graph) addNode(n *Node) int32 {
+	i, ok := g.index[n.ID]
 	if ok {
-		return
+		return i
+	}
+	i = int32(len(g.nodes))
+	g.index[n.ID] = i
+	g.nodes = append(g.nodes, n)
+	g.invalidate()
+	return i
+}
+
+func (g *Graph) invalidate() {
+	if g.adjacency != nil {
+		g.adjacency = nil
+		g.once = &sync.Once{}
 	}
-	g.nodes[n.ID] = n
+}
+
+func (g *Graph) compacted() *adjacency {
+	g.Compact()
+	return g.adjacency
 }
@@ -1,6 +1,8 @@
 package graph
 
 import (
+	"iter"
+	"strconv"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -56,3 +58,264 @@ func TestGraph(t *testing.T) {
 	nodes = graph.AllOutcomeFrom(n)
 	assert.Equal(t, 4, len(nodes))
 }
+
+func TestGraphAdjacency(t *testing.T) {
+	graph := NewGraph()
+	nodeA := &Node{ID: "A"}
+	nodeB := &Node{ID: "B"}
+	nodeC := &Node{ID: "C"}
+	graph.AddNode(nodeA)
+	graph.AddEdge(nodeA, nodeB, 1)
+	graph.AddEdge(nodeB, nodeC, 2)
+
+	// another instance with the same ID resolves to the stored node
+	graph.AddEdge(&Node{ID: "A"}, nodeC, 3)
+
+	assert.Equal(t, 3, graph.Len())
+
+	incoming := make([]string, 0)
+	for edge := range graph.Incoming(nodeC) {
+		assert.Same(t, nodeC, edge.To)
+		incoming = append(incoming, edge.From.ID)
+	}
+	assert.ElementsMatch(t, []string{"B", "A"}, incoming)
+
+	outgoing := graph.AllOutcomeFrom(nodeA)
+	assert.Equal(t, 2, len(outgoing))
+	for _, edge := range outgoing {
+		assert.Same(t, nodeA, edge.From)
+	}
+
+	filtered := graph.AllOutcomeFromWhere(nodeA, func(n *Node) bool { return n.ID == "C" })
+	assert.Equal(t, 1, len(filtered))
+	assert.Equal(t, 3, filtered[0].Weight)
+
+	edges, ok := graph.Edges(nodeB)
+	assert.True(t, ok)
+	assert.Equal(t, 2, len(edges))
+
+	_, ok = graph.Edges(&Node{ID: "Z"})
+	assert.False(t, ok)
+	assert.Empty(t, graph.AllIncomeTo(&Node{ID: "Z"}))
+
+	// mutation after a read rebuilds the adjacency
+	nodeD := &Node{ID: "D"}
+	graph.AddEdge(nodeC, nodeD, 4)
+	assert.Equal(t, 1, len(graph.AllIncomeTo(nodeD)))
+	assert.Equal(t, 1, len(graph.AllOutcomeFrom(nodeC)))
+}
+
+func BenchmarkAllIncomeTo(b *testing.B) {
+	graph := NewGraph()
+	root := &Node{ID: "root"}
+	for i := 0; i < 1000; i++ {
+		n := &Node{ID: strconv.Itoa(i)}
+		graph.AddEdge(root, n, 0)
+		graph.AddEdge(n, root, 0)
+	}
+	graph.Compact()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for range graph.Incoming(root) {
+		}
+	}
+}
+
+func TestOverlay(t *testing.T) {
+	graph := NewGraph()
+	central := &Node{ID: "central"}
+	transit := &Node{ID: "transit"}
+	store := &Node{ID: "store"}
+	graph.AddEdge(central, transit, 0)
+	graph.AddEdge(transit, store, 0)
+
+	overlay := NewOverlay(graph)
+	overlay.MaskNode(transit.ID)
+	overlay.AddEdge(&Node{ID: "central"}, &Node{ID: "new"}, 0)
+	overlay.AddEdge(&Node{ID: "new"}, store, 0)
+
+	_, ok := overlay.Find(transit.ID)
+	assert.False(t, ok)
+	n, ok := overlay.Find("new")
+	assert.True(t, ok)
+	assert.Equal(t, "new", n.ID)
+
+	incoming := make([]string, 0)
+	for edge := range overlay.Incoming(store) {
+		incoming = append(incoming, edge.From.ID)
+	}
+	assert.Equal(t, []string{"new"}, incoming)
+
+	outgoing := make([]*Edge, 0)
+	for edge := range overlay.Outgoing(central) {
+		outgoing = append(outgoing, edge)
+	}
+	assert.Equal(t, 1, len(outgoing))
+	assert.Same(t, central, outgoing[0].From)
+
+	nodes := make([]string, 0)
+	for n := range overlay.Nodes() {
+		nodes = append(nodes, n.ID)
+	}
+	assert.ElementsMatch(t, []string{"central", "store", "new"}, nodes)
+
+	overlay = NewOverlay(graph)
+	overlay.RemoveEdge(transit.ID, store.ID)
+	assert.Empty(t, collect(overlay.Incoming(store)))
+	assert.Equal(t, 1, len(collect(overlay.Incoming(transit))))
+
+	// the base graph is untouched
+	assert.Equal(t, 1, len(graph.AllIncomeTo(store)))
+}
+
+func TestOverlayRemoveAddedEdge(t *testing.T) {
+	graph := NewGraph()
+	central := &Node{ID: "central"}
+	store := &Node{ID: "store"}
+	graph.AddEdge(central, store, 0)
+
+	overlay := NewOverlay(graph)
+	overlay.AddEdge(central, &Node{ID: "new"}, 0)
+	overlay.RemoveEdge(central.ID, "new")
+
+	outgoing := make([]string, 0)
+	for edge := range overlay.Outgoing(central) {
+		outgoing = append(outgoing, edge.To.ID)
+	}
+	assert.Equal(t, []string{"store"}, outgoing)
+	incoming := 0
+	for range overlay.Incoming(&Node{ID: "new"}) {
+		incoming++
+	}
+	assert.Equal(t, 0, incoming)
+}
+
+func TestOverlayAddAfterRemove(t *testing.T) {
+	graph := NewGraph()
+	central := &Node{ID: "central"}
+	store := &Node{ID: "store"}
+	graph.AddEdge(central, store, 0)
+
+	overlay := NewOverlay(graph)
+	overlay.RemoveEdge(central.ID, store.ID)
+	overlay.AddEdge(central, store, 5)
+
+	edges := collect(overlay.Outgoing(central))
+	assert.Equal(t, 1, len(edges))
+	assert.Equal(t, 5, edges[0].Weight)
+	assert.Equal(t, 1, len(collect(overlay.Incoming(store))))
+}
+
+func collect(seq iter.Seq[*Edge]) []*Edge {
+	result := make([]*Edge, 0)
+	for e := range seq {
+		result = append(result, e)
+	}
+	return result
+}
+
+func TestDominators(t *testing.T) {
+	// central1 -> transit -> mallA
+	//                     -> mallB
+	// central1 -> hub -> mallC
+	// central2 -> hub
+	graph := NewGraph()
+	nodes := make(map[string]*Node)
+	for _, id := range []string{"central1", "central2", "transit", "hub", "mallA", "mallB", "mallC", "orphan"} {
+		nodes[id] = &Node{ID: id}
+		graph.AddNode(nodes[id])
+	}
+	graph.AddEdge(nodes["central1"], nodes["transit"], 0)
+	graph.AddEdge(nodes["transit"], nodes["mallA"], 0)
+	graph.AddEdge(nodes["transit"], nodes["mallB"], 0)
+	graph.AddEdge(nodes["central1"], nodes["hub"], 0)
+	graph.AddEdge(nodes["central2"], nodes["hub"], 0)
+	graph.AddEdge(nodes["hub"], nodes["mallC"], 0)
+
+	tree := Dominators(graph, []*Node{nodes["central1"], nodes["central2"]})
+
+	ids := func(ns []*Node) []string {
+		result := make([]string, len(ns))
+		for i, n := range ns {
+			result[i] = n.ID
+		}
+		return result
+	}
+	assert.ElementsMatch(t, []string{"mallA", "mallB"}, ids(tree.Dominated(nodes["transit"])))
+	assert.ElementsMatch(t, []string{"transit", "mallA", "mallB"}, ids(tree.Dominated(nodes["central1"])))
+	assert.ElementsMatch(t, []string{"mallC"}, ids(tree.Dominated(nodes["hub"])))
+	assert.Empty(t, tree.Dominated(nodes["central2"]))
+
+	assert.True(t, tree.Dominates(nodes["transit"], nodes["mallA"]))
+	assert.False(t, tree.Dominates(nodes["central1"], nodes["mallC"]))
+	assert.False(t, tree.Reachable("orphan"))
+
+	idom, ok := tree.ImmediateDominator(nodes["mallB"])
+	assert.True(t, ok)
+	assert.Equal(t, "transit", idom.ID)
+	_, ok = tree.ImmediateDominator(nodes["hub"])
+	assert.False(t, ok)
+}
+
+func TestParetoPaths(t *testing.T) {
+	// central -> air -> mall: fast and expensive
+	// central -> truck -> mall: slow and cheap
+	// central -> rail -> mall: slower and pricier than truck, dominated
+	// central -> mall: middle ground in one hop
+	graph := NewGraph()
+	nodes := make(map[string]*Node)
+	for _, id := range []string{"central", "air", "truck", "rail", "mall"} {
+		nodes[id] = &Node{ID: id}
+		graph.AddNode(nodes[id])
+	}
+	graph.AddEdgeWithCost(nodes["central"], nodes["air"], 0, Cost{Time: 2, Money: 100})
+	graph.AddEdgeWithCost(nodes["air"], nodes["mall"], 0, Cost{Time: 2, Money: 100})
+	graph.AddEdgeWithCost(nodes["central"], nodes["truck"], 0, Cost{Time: 24, Money: 10})
+	graph.AddEdgeWithCost(nodes["truck"], nodes["mall"], 0, Cost{Time: 24, Money: 10})
+	graph.AddEdgeWithCost(nodes["central"], nodes["rail"], 0, Cost{Time: 30, Money: 20})
+	graph.AddEdgeWithCost(nodes["rail"], nodes["mall"], 0, Cost{Time: 30, Money: 20})
+	graph.AddEdgeWithCost(nodes["central"], nodes["mall"], 0, Cost{Time: 10, Money: 150})
+	graph.AddEdgeWithCost(nodes["mall"], nodes["central"], 0, Cost{})
+
+	front := ParetoPaths(graph, nodes["central"], nodes["mall"])
+
+	assert.Equal(t, 3, len(front))
+	assert.Equal(t, Cost{Time: 4, Money: 200}, front[0].Cost)
+	assert.Equal(t, Cost{Time: 10, Money: 150}, front[1].Cost)
+	assert.Equal(t, 1, front[1].Hops())
+	assert.Equal(t, Cost{Time: 48, Money: 20}, front[2].Cost)
+	route := front[2].Nodes(nodes["central"])
+	assert.Equal(t, []*Node{nodes["central"], nodes["truck"], nodes["mall"]}, route)
+
+	orphan := &Node{ID: "orphan"}
+	graph.AddNode(orphan)
+	assert.Empty(t, ParetoPaths(graph, nodes["central"], orphan))
+}
+
+func TestAncestorsAndDescendants(t *testing.T) {
+	graph := NewGraph()
+	nodes := make(map[string]*Node)
+	for _, id := range []string{"root", "a", "b", "a1", "a2"} {
+		nodes[id] = &Node{ID: id}
+		graph.AddNode(nodes[id])
+	}
+	graph.AddEdge(nodes["root"], nodes["a"], 1)
+	graph.AddEdge(nodes["root"], nodes["b"], 1)
+	graph.AddEdge(nodes["a"], nodes["a1"], 1)
+	graph.AddEdge(nodes["a"], nodes["a2"], 1)
+
+	ids := func(ns []*Node) []string {
+		result := make([]string, len(ns))
+		for i, n := range ns {
+			result[i] = n.ID
+		}
+		return result
+	}
+
+	assert.Equal(t, []string{"a", "b", "a1", "a2"}, ids(Descendants(graph, nodes["root"])))
+	assert.Equal(t, []string{"a1", "a2"}, ids(Descendants(graph, nodes["a"])))
+	assert.Empty(t, Descendants(graph, nodes["b"]))
+	assert.Equal(t, []string{"a", "root"}, ids(Ancestors(graph, nodes["a2"])))
+	assert.Empty(t, Ancestors(graph, nodes["root"]))
+}
@@ -0,0 +1,155 @@
+package graph
+
+import "iter"
+
+var (
+	_ View = (*Graph)(nil)
+	_ View = (*Overlay)(nil)
+)
+
+type edgeKey struct {
+	from string
+	to   string
+}
+
+// Overlay masks nodes and adds or removes edges on top of a base view without
+// touching it. Edge changes take effect in the order they are made, so an
+// edge added after a removal of the same pair is visible. The base must stay
+// immutable while the overlay is in use.
+type Overlay struct {
+	base    View
+	masked  map[string]bool
+	removed map[edgeKey]bool
+	nodes   map[string]*Node
+	order   []*Node
+	in      map[string][]*Edge
+	out     map[string][]*Edge
+}
+
+func NewOverlay(base View) *Overlay {
+	return &Overlay{
+		base:    base,
+		masked:  make(map[string]bool),
+		removed: make(map[edgeKey]bool),
+		nodes:   make(map[string]*Node),
+		order:   make([]*Node, 0),
+		in:      make(map[string][]*Edge),
+		out:     make(map[string][]*Edge),
+	}
+}
+
+// MaskNode hides the node and every edge touching it.
+func (o *Overlay) MaskNode(nodeID string) {
+	o.masked[nodeID] = true
+}
+
+// RemoveEdge hides every edge of the base and drops every edge added so far
+// going from fromID to toID.
+func (o *Overlay) RemoveEdge(fromID, toID string) {
+	o.removed[edgeKey{fromID, toID}] = true
+	o.out[fromID] = withoutEdges(o.out[fromID], fromID, toID)
+	o.in[toID] = withoutEdges(o.in[toID], fromID, toID)
+}
+
+func withoutEdges(edges []*Edge, fromID, toID string) []*Edge {
+	kept := make([]*Edge, 0, len(edges))
+	for _, e := range edges {
+		if e.From.ID != fromID || e.To.ID != toID {
+			kept = append(kept, e)
+		}
+	}
+	return kept
+}
+
+// AddEdge adds an edge visible only through the overlay. Nodes known to the
+// base are reused, unknown ones are added to the overlay.
+func (o *Overlay) AddEdge(from, to *Node, weight int) {
+	from = o.resolve(from)
+	to = o.resolve(to)
+	edge := &Edge{From: from, To: to, Weight: weight}
+	o.out[from.ID] = append(o.out[from.ID], edge)
+	o.in[to.ID] = append(o.in[to.ID], edge)
+}
+
+func (o *Overlay) Find(nodeID string) (*Node, bool) {
+	if o.masked[nodeID] {
+		return nil, false
+	}
+	if n, ok := o.base.Find(nodeID); ok {
+		return n, true
+	}
+	n, ok := o.nodes[nodeID]
+	return n, ok
+}
+
+func (o *Overlay) Nodes() iter.Seq[*Node] {
+	return func(yield func(*Node) bool) {
+		for n := range o.base.Nodes() {
+			if o.masked[n.ID] {
+				continue
+			}
+			if !yield(n) {
+				return
+			}
+		}
+		for _, n := range o.order {
+			if o.masked[n.ID] {
+				continue
+			}
+			if !yield(n) {
+				return
+			}
+		}
+	}
+}
+
+func (o *Overlay) Incoming(n *Node) iter.Seq[*Edge] {
+	return o.edges(n, o.base.Incoming, o.in)
+}
+
+func (o *Overlay) Outgoing(n *Node) iter.Seq[*Edge] {
+	return o.edges(n, o.base.Outgoing, o.out)
+}
+
+func (o *Overlay) edges(n *Node, base func(*Node) iter.Seq[*Edge], added map[string][]*Edge) iter.Seq[*Edge] {
+	return func(yield func(*Edge) bool) {
+		if o.masked[n.ID] {
+			return
+		}
+		for e := range base(n) {
+			if !o.visible(e) {
+				continue
+			}
+			if !yield(e) {
+				return
+			}
+		}
+		for _, e := range added[n.ID] {
+			if o.masked[e.From.ID] || o.masked[e.To.ID] {
+				continue
+			}
+			if !yield(e) {
+				return
+			}
+		}
+	}
+}
+
+func (o *Overlay) visible(e *Edge) bool {
+	if o.masked[e.From.ID] || o.masked[e.To.ID] {
+		return false
+	}
+	return !o.removed[edgeKey{e.From.ID, e.To.ID}]
+}
+
+func (o *Overlay) resolve(n *Node) *Node {
+	if existing, ok := o.base.Find(n.ID); ok {
+		return existing
+	}
+	if existing, ok := o.nodes[n.ID]; ok {
+		return existing
+	}
+	o.nodes[n.ID] = n
+	o.order = append(o.order, n)
+	return n
+}
@@ -0,0 +1,145 @@
+package graph
+
+import (
+	"container/heap"
+	"sort"
+)
+
+// ParetoPath is a path with its total cost. Edges run from the source to the
+// target.
+type ParetoPath struct {
+	Edges []*Edge
+	Cost  Cost
+}
+
+func (p *ParetoPath) Hops() int {
+	return len(p.Edges)
+}
+
+// Nodes returns the nodes of the path from the source to the target.
+func (p *ParetoPath) Nodes(source *Node) []*Node {
+	nodes := make([]*Node, 0, len(p.Edges)+1)
+	nodes = append(nodes, source)
+	for _, e := range p.Edges {
+		nodes = append(nodes, e.To)
+	}
+	return nodes
+}
+
+// ParetoPaths returns every path from source to target along outgoing edges
+// that no other path beats on time, money and hop count at once. Hop count is
+// a criterion too, so the front always contains the shortest chain. The
+// result is ordered by time, then money, then hops.
+func ParetoPaths(view View, source, target *Node) []*ParetoPath {
+	labels := make(map[string][]*label)
+	queue := &labelQueue{}
+	start := &label{node: source}
+	labels[source.ID] = []*label{start}
+	heap.Push(queue, start)
+	for queue.Len() > 0 {
+		l := heap.Pop(queue).(*label)
+		if l.dominated || l.node.ID == target.ID {
+			continue
+		}
+		for e := range view.Outgoing(l.node) {
+			next := &label{
+				node: e.To,
+				cost: l.cost.Add(e.Cost),
+				hops: l.hops + 1,
+				prev: l,
+				edge: e,
+			}
+			if !insertLabel(labels, next) {
+				continue
+			}
+			heap.Push(queue, next)
+		}
+	}
+	front := labels[target.ID]
+	result := make([]*ParetoPath, 0, len(front))
+	if source.ID == target.ID {
+		return append(result, &ParetoPath{Edges: make([]*Edge, 0)})
+	}
+	for _, l := range front {
+		result = append(result, l.path())
+	}
+	sort.Slice(result, func(i, j int) bool {
+		a, b := result[i], result[j]
+		if a.Cost.Time != b.Cost.Time {
+			return a.Cost.Time < b.Cost.Time
+		}
+		if a.Cost.Money != b.Cost.Money {
+			return a.Cost.Money < b.Cost.Money
+		}
+		return a.Hops() < b.Hops()
+	})
+	return result
+}
+
+type label struct {
+	node      *Node
+	cost      Cost
+	hops      int
+	prev      *label
+	edge      *Edge
+	dominated bool
+}
+
+// dominates reports whether l is at least as good as other on every criterion.
+// Equal labels dominate each other, so only the first of them is kept.
+func (l *label) dominates(other *label) bool {
+	return l.cost.Time <= other.cost.Time && l.cost.Money <= other.cost.Money && l.hops <= other.hops
+}
+
+func (l *label) path() *ParetoPath {
+	edges := make([]*Edge, l.hops)
+	for it := l; it.edge != nil; it = it.prev {
+		edges[it.hops-1] = it.edge
+	}
+	return &ParetoPath{Edges: edges, Cost: l.cost}
+}
+
+// insertLabel adds l to the labels of its node unless one of them dominates
+// it. Labels dominated by l are dropped and marked so the queue skips them.
+func insertLabel(labels map[string][]*label, l *label) bool {
+	current := labels[l.node.ID]
+	for _, other := range current {
+		if other.dominates(l) {
+			return false
+		}
+	}
+	kept := current[:0]
+	for _, other := range current {
+		if l.dominates(other) {
+			other.dominated = true
+			continue
+		}
+		kept = append(kept, other)
+	}
+	labels[l.node.ID] = append(kept, l)
+	return true
+}
+
+// labelQueue pops labels in lexicographic order of time, money and hops.
+type labelQueue []*label
+
+func (q labelQueue) Len() int { return len(q) }
+func (q labelQueue) Less(i, j int) bool {
+	a, b := q[i], q[j]
+	if a.cost.Time != b.cost.Time {
+		return a.cost.Time < b.cost.Time
+	}
+	if a.cost.Money != b.cost.Money {
+		return a.cost.Money < b.cost.Money
+	}
+	return a.hops < b.hops
+}
+func (q labelQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+func (q *labelQueue) Push(x any)   { *q = append(*q, x.(*label)) }
+func (q *labelQueue) Pop() any {
+	old := *q
+	n := len(old)
+	it := old[n-1]
+	*q = old[:n-1]
+	return it
+}
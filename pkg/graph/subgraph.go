@@ -0,0 +1,61 @@
+package graph
+
+import "iter"
+
+var _ View = (*Subgraph)(nil)
+
+// Subgraph is the part of a base view induced by the nodes keep accepts.
+// Edges are visible only when both of their ends are kept.
+type Subgraph struct {
+	base View
+	keep func(n *Node) bool
+}
+
+func NewSubgraph(base View, keep func(n *Node) bool) *Subgraph {
+	return &Subgraph{base: base, keep: keep}
+}
+
+func (s *Subgraph) Find(nodeID string) (*Node, bool) {
+	n, ok := s.base.Find(nodeID)
+	if !ok || !s.keep(n) {
+		return nil, false
+	}
+	return n, true
+}
+
+func (s *Subgraph) Nodes() iter.Seq[*Node] {
+	return func(yield func(*Node) bool) {
+		for n := range s.base.Nodes() {
+			if !s.keep(n) {
+				continue
+			}
+			if !yield(n) {
+				return
+			}
+		}
+	}
+}
+
+func (s *Subgraph) Incoming(n *Node) iter.Seq[*Edge] {
+	return s.edges(n, s.base.Incoming)
+}
+
+func (s *Subgraph) Outgoing(n *Node) iter.Seq[*Edge] {
+	return s.edges(n, s.base.Outgoing)
+}
+
+func (s *Subgraph) edges(n *Node, base func(*Node) iter.Seq[*Edge]) iter.Seq[*Edge] {
+	return func(yield func(*Edge) bool) {
+		if !s.keep(n) {
+			return
+		}
+		for e := range base(n) {
+			if !s.keep(e.From) || !s.keep(e.To) {
+				continue
+			}
+			if !yield(e) {
+				return
+			}
+		}
+	}
+}
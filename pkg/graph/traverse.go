@@ -0,0 +1,35 @@
+package graph
+
+import "iter"
+
+// Descendants lists the nodes reachable from n along outgoing edges in
+// breadth-first order, n itself excluded.
+func Descendants(view View, n *Node) []*Node {
+	return walk(n, view.Outgoing, func(e *Edge) *Node { return e.To })
+}
+
+// Ancestors lists the nodes n is reachable from, nearest first, n itself
+// excluded.
+func Ancestors(view View, n *Node) []*Node {
+	return walk(n, view.Incoming, func(e *Edge) *Node { return e.From })
+}
+
+func walk(start *Node, edges func(*Node) iter.Seq[*Edge], next func(*Edge) *Node) []*Node {
+	visited := map[string]bool{start.ID: true}
+	result := make([]*Node, 0)
+	queue := []*Node{start}
+	for len(queue) > 0 {
+		n := queue[0]
+		queue = queue[1:]
+		for e := range edges(n) {
+			m := next(e)
+			if visited[m.ID] {
+				continue
+			}
+			visited[m.ID] = true
+			result = append(result, m)
+			queue = append(queue, m)
+		}
+	}
+	return result
+}
@@ -0,0 +1,12 @@
+package graph
+
+import "iter"
+
+// View is a read-only graph. Graph and Overlay both implement it, so
+// traversals can run on the live snapshot or on a what-if copy of it.
+type View interface {
+	Find(nodeID string) (*Node, bool)
+	Nodes() iter.Seq[*Node]
+	Incoming(n *Node) iter.Seq[*Edge]
+	Outgoing(n *Node) iter.Seq[*Edge]
+}
@@ -0,0 +1,97 @@
+// Package trigram scores how alike two strings are the way the pg_trgm
+// Postgres extension does, so that name matching can be reproduced in
+// memory.
+package trigram
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Trigrams returns the distinct trigrams of s in sorted order. Like pg_trgm,
+// s is lowercased and split into words of letters and digits, and every word
+// is padded with two spaces in front and one behind.
+func Trigrams(s string) []string {
+	set := make(map[string]bool)
+	for _, t := range sequence(s) {
+		set[t] = true
+	}
+	result := make([]string, 0, len(set))
+	for t := range set {
+		result = append(result, t)
+	}
+	sort.Strings(result)
+	return result
+}
+
+// Similarity is the share of trigrams a and b have in common, from 0 for
+// nothing in common to 1 for the same trigrams, like pg_trgm similarity.
+func Similarity(a, b string) float64 {
+	return jaccard(set(sequence(a)), set(sequence(b)))
+}
+
+// WordSimilarity is the greatest Similarity between the trigrams of a and
+// any continuous run of the trigrams of b, like pg_trgm word_similarity. It
+// scores a short query against a long name by the part of the name that
+// matches best.
+func WordSimilarity(a, b string) float64 {
+	query := set(sequence(a))
+	if len(query) == 0 {
+		return 0
+	}
+	target := sequence(b)
+	best := 0.0
+	for i := range target {
+		extent := make(map[string]int)
+		shared := 0
+		for j := i; j < len(target); j++ {
+			t := target[j]
+			extent[t]++
+			if extent[t] == 1 && query[t] {
+				shared++
+			}
+			if score := float64(shared) / float64(len(query)+len(extent)-shared); score > best {
+				best = score
+			}
+		}
+	}
+	return best
+}
+
+// sequence lists the trigrams of every word of s in order, repeats included.
+func sequence(s string) []string {
+	words := strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	result := make([]string, 0)
+	for _, word := range words {
+		padded := []rune("  " + word + " ")
+		for i := 0; i+3 <= len(padded); i++ {
+			result = append(result, string(padded[i:i+3]))
+		}
+	}
+	return result
+}
+
+func set(trigrams []string) map[string]bool {
+	result := make(map[string]bool, len(trigrams))
+	for _, t := range trigrams {
+		result[t] = true
+	}
+	return result
+}
+
+func jaccard(a, b map[string]bool) float64 {
+	shared := 0
+	for t := range a {
+		if b[t] {
+			shared++
+		}
+	}
+	union := len(a) + len(b) - shared
+	if union == 0 {
+		return 0
+	}
+	return float64(shared) / float64(union)
+}
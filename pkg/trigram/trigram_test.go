@@ -0,0 +1,27 @@
+package trigram
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Expected values are the ones pg_trgm documents for the same input.
+func TestTrigrams(t *testing.T) {
+	assert.Equal(t, []string{"  c", " ca", "at ", "cat"}, Trigrams("Cat"))
+	assert.Equal(t, []string{"  a", "  b", " a ", " b "}, Trigrams("a, b"))
+	assert.Empty(t, Trigrams(" ,. "))
+}
+
+func TestSimilarity(t *testing.T) {
+	assert.InDelta(t, 0.36363637, Similarity("word", "two words"), 1e-6)
+	assert.Equal(t, 1.0, Similarity("Матрас", "матрас"))
+	assert.Equal(t, 0.0, Similarity("", ""))
+}
+
+func TestWordSimilarity(t *testing.T) {
+	assert.InDelta(t, 0.8, WordSimilarity("word", "two words"), 1e-6)
+	assert.Equal(t, 1.0, WordSimilarity("ортопед", "Матрас Ортопед Комфорт"))
+	assert.Greater(t, WordSimilarity("матрац", "Матрас Ортопед"), WordSimilarity("матрац", "Подушка"))
+	assert.Equal(t, 0.0, WordSimilarity("", "two words"))
+}